@@ -1,20 +1,39 @@
 package control
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/mr-tron/base58"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"github.com/spf13/cobra"
 )
 
+const (
+	evacuationStatusFlag = "status"
+	evacuationStopFlag   = "stop"
+	evacuationAwaitFlag  = "await"
+)
+
+// evacuationAwaitInterval is an interval between status requests when
+// evacuation is awaited.
+const evacuationAwaitInterval = time.Second
+
 var evacuateShardCmd = &cobra.Command{
 	Use:   "evacuate",
 	Short: "Evacuate objects from shard",
-	Long:  "Evacuate objects from shard to other shards",
-	Args:  cobra.NoArgs,
-	RunE:  evacuateShard,
+	Long: `Evacuate objects from shard to other shards in background.
+Only one evacuation can run at a time, use --status to check its progress and
+--stop to stop it. Stopped or failed evacuation of the same shards is
+continued from the saved position by the next call.`,
+	Args: cobra.NoArgs,
+	RunE: evacuateShard,
 }
 
 func evacuateShard(cmd *cobra.Command, _ []string) error {
@@ -23,7 +42,45 @@ func evacuateShard(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	req := &control.EvacuateShardRequest{Body: new(control.EvacuateShardRequest_Body)}
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	cli, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if st, _ := cmd.Flags().GetBool(evacuationStatusFlag); st {
+		body, err := getEvacuationStatus(ctx, pk, cli)
+		if err != nil {
+			return err
+		}
+		printEvacuationStatus(cmd, body)
+		return nil
+	}
+
+	if stop, _ := cmd.Flags().GetBool(evacuationStopFlag); stop {
+		req := &control.StopShardEvacuationRequest{Body: new(control.StopShardEvacuationRequest_Body)}
+		err = signRequest(pk, req)
+		if err != nil {
+			return err
+		}
+
+		resp, err := cli.StopShardEvacuation(ctx, req)
+		if err != nil {
+			return fmt.Errorf("rpc error: %w", err)
+		}
+
+		err = verifyResponse(resp.GetSignature(), resp.GetBody())
+		if err != nil {
+			return err
+		}
+
+		cmd.Println("Evacuation has been stopped.")
+		return nil
+	}
+
+	req := &control.StartShardEvacuationRequest{Body: new(control.StartShardEvacuationRequest_Body)}
 	req.Body.Shard_ID, err = getShardIDList(cmd)
 	if err != nil {
 		return err
@@ -35,28 +92,107 @@ func evacuateShard(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	ctx, cancel := commonflags.GetCommandContext(cmd)
-	defer cancel()
+	resp, err := cli.StartShardEvacuation(ctx, req)
+	if err != nil {
+		return fmt.Errorf("rpc error: %w", err)
+	}
 
-	cli, err := getClient(ctx)
+	err = verifyResponse(resp.GetSignature(), resp.GetBody())
 	if err != nil {
 		return err
 	}
 
-	resp, err := cli.EvacuateShard(ctx, req)
+	cmd.Printf("Evacuation %s has been started.\n", resp.GetBody().GetId())
+
+	if await, _ := cmd.Flags().GetBool(evacuationAwaitFlag); !await {
+		return nil
+	}
+
+	t := time.NewTicker(evacuationAwaitInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("evacuation is still in progress: %w", ctx.Err())
+		case <-t.C:
+		}
+
+		body, err := getEvacuationStatus(ctx, pk, cli)
+		if err != nil {
+			return err
+		}
+
+		if body.GetStatus() != control.EvacuationStatus_EVACUATION_RUNNING {
+			printEvacuationStatus(cmd, body)
+
+			if body.GetStatus() != control.EvacuationStatus_EVACUATION_COMPLETED {
+				return errors.New("evacuation has not been completed")
+			}
+			cmd.Println("Shard has successfully been evacuated.")
+			return nil
+		}
+
+		cmd.Printf("Objects moved: %d\n", body.GetEvacuated())
+	}
+}
+
+func getEvacuationStatus(ctx context.Context, pk *ecdsa.PrivateKey, cli control.ControlServiceClient) (*control.GetShardEvacuationStatusResponse_Body, error) {
+	req := &control.GetShardEvacuationStatusRequest{Body: new(control.GetShardEvacuationStatusRequest_Body)}
+	err := signRequest(pk, req)
 	if err != nil {
-		return fmt.Errorf("rpc error: %w", err)
+		return nil, err
 	}
 
-	cmd.Printf("Objects moved: %d\n", resp.GetBody().GetCount())
+	resp, err := cli.GetShardEvacuationStatus(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("rpc error: %w", err)
+	}
 
 	err = verifyResponse(resp.GetSignature(), resp.GetBody())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	cmd.Println("Shard has successfully been evacuated.")
-	return nil
+	return resp.GetBody(), nil
+}
+
+func printEvacuationStatus(cmd *cobra.Command, body *control.GetShardEvacuationStatusResponse_Body) {
+	if body.GetStatus() == control.EvacuationStatus_EVACUATION_STATUS_UNDEFINED {
+		cmd.Println("No evacuation has been started.")
+		return
+	}
+
+	var status string
+	switch body.GetStatus() {
+	case control.EvacuationStatus_EVACUATION_RUNNING:
+		status = "running"
+	case control.EvacuationStatus_EVACUATION_COMPLETED:
+		status = "completed"
+	case control.EvacuationStatus_EVACUATION_STOPPED:
+		status = "stopped"
+	case control.EvacuationStatus_EVACUATION_FAILED:
+		status = "failed"
+	default:
+		status = fmt.Sprintf("unknown (%d)", body.GetStatus())
+	}
+
+	shards := make([]string, 0, len(body.GetShard_ID()))
+	for _, id := range body.GetShard_ID() {
+		shards = append(shards, base58.Encode(id))
+	}
+
+	cmd.Printf("Evacuation %s: %s\n", body.GetId(), status)
+	cmd.Printf("Shards: %s\n", strings.Join(shards, ", "))
+	cmd.Printf("Objects moved: %d\n", body.GetEvacuated())
+	cmd.Printf("Objects skipped: %d\n", body.GetSkipped())
+	cmd.Printf("Started at: %s\n", time.Unix(body.GetStartedAt(), 0).Format(time.RFC3339))
+	if body.GetFinishedAt() != 0 {
+		cmd.Printf("Finished at: %s\n", time.Unix(body.GetFinishedAt(), 0).Format(time.RFC3339))
+	}
+	if body.GetError() != "" {
+		cmd.Printf("Error: %s\n", body.GetError())
+	}
 }
 
 func initControlEvacuateShardCmd() {
@@ -66,6 +202,15 @@ func initControlEvacuateShardCmd() {
 	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
 	flags.Bool(shardAllFlag, false, "Process all shards")
 	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.Bool(evacuationAwaitFlag, false, "Wait for the evacuation to finish (see --timeout)")
+	flags.Bool(evacuationStatusFlag, false, "Print status of the last evacuation")
+	flags.Bool(evacuationStopFlag, false, "Stop running evacuation")
 
-	evacuateShardCmd.MarkFlagsOneRequired(shardIDFlag, shardAllFlag)
+	evacuateShardCmd.MarkFlagsOneRequired(shardIDFlag, shardAllFlag, evacuationStatusFlag, evacuationStopFlag)
+	for _, f := range []string{shardIDFlag, shardAllFlag, evacuationStatusFlag} {
+		evacuateShardCmd.MarkFlagsMutuallyExclusive(f, evacuationStopFlag)
+	}
+	for _, f := range []string{shardIDFlag, shardAllFlag, dumpIgnoreErrorsFlag, evacuationAwaitFlag} {
+		evacuateShardCmd.MarkFlagsMutuallyExclusive(f, evacuationStatusFlag)
+	}
 }
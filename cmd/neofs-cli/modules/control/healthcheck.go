@@ -74,6 +74,14 @@ func healthCheck(cmd *cobra.Command, _ []string) error {
 	cmd.Printf("Network status: %s\n", resp.GetBody().GetNetmapStatus())
 	cmd.Printf("Health status: %s\n", healthStatus)
 
+	if fsChain := resp.GetBody().GetFsChainStatus(); fsChain != nil {
+		if fsChain.GetConnected() {
+			cmd.Printf("FS chain: connected to %s, block %d\n", fsChain.GetEndpoint(), fsChain.GetBlockHeight())
+		} else {
+			cmd.Printf("FS chain: disconnected, last seen block %d\n", fsChain.GetBlockHeight())
+		}
+	}
+
 	if healthStatus != control.HealthStatus_READY {
 		os.Exit(1)
 	}
@@ -0,0 +1,74 @@
+package control
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	ircontrol "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+	ircontrolsrv "github.com/nspcc-dev/neofs-node/pkg/services/control/ir/server"
+	"github.com/spf13/cobra"
+)
+
+var irQueuesCmd = &cobra.Command{
+	Use:   "ir-queues",
+	Short: "Get states of the Inner Ring processor event queues",
+	Long: "Get states of the Inner Ring processor event queues: worker pool capacity, busy workers, " +
+		"received, handled and dropped events, sent and failed notary requests and the time of the last handled event",
+	Args: cobra.NoArgs,
+	RunE: irQueues,
+}
+
+func initControlIRQueuesCmd() {
+	initControlFlags(irQueuesCmd)
+}
+
+func irQueues(cmd *cobra.Command, _ []string) error {
+	pk, err := key.Get(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	cli, err := getIRClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := new(ircontrol.ProcessorQueuesRequest)
+
+	req.SetBody(new(ircontrol.ProcessorQueuesRequest_Body))
+
+	err = ircontrolsrv.SignMessage(pk, req)
+	if err != nil {
+		return fmt.Errorf("could not sign request: %w", err)
+	}
+
+	resp, err := cli.ProcessorQueues(ctx, req)
+	if err != nil {
+		return fmt.Errorf("rpc error: %w", err)
+	}
+
+	err = verifyResponse(resp.GetSignature(), resp.GetBody())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROCESSOR\tCAPACITY\tBUSY\tRECEIVED\tHANDLED\tDROPPED\tNOTARY SENT\tNOTARY FAILED\tLAST HANDLED")
+	for _, q := range resp.GetBody().GetQueues() {
+		lastHandled := "never"
+		if q.GetLastHandled() != 0 {
+			lastHandled = time.Unix(int64(q.GetLastHandled()), 0).Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n",
+			q.GetName(), q.GetCapacity(), q.GetBusyWorkers(),
+			q.GetReceivedEvents(), q.GetHandledEvents(), q.GetDroppedEvents(),
+			q.GetNotaryRequestsSent(), q.GetNotaryRequestsFailed(), lastHandled)
+	}
+	return w.Flush()
+}
@@ -34,6 +34,7 @@ func init() {
 		shardsCmd,
 		objectCmd,
 		notaryCmd,
+		irQueuesCmd,
 	)
 
 	initControlHealthCheckCmd()
@@ -42,4 +43,5 @@ func init() {
 	initControlShardsCmd()
 	initControlObjectsCmd()
 	initControlNotaryCmd()
+	initControlIRQueuesCmd()
 }
@@ -16,12 +16,22 @@ func initControlShardsCmd() {
 	shardsCmd.AddCommand(dumpShardCmd)
 	shardsCmd.AddCommand(restoreShardCmd)
 	shardsCmd.AddCommand(evacuateShardCmd)
+	shardsCmd.AddCommand(rebalanceShardsCmd)
 	shardsCmd.AddCommand(flushCacheCmd)
+	shardsCmd.AddCommand(shardsGCCmd)
+	shardsCmd.AddCommand(shardsScrubCmd)
+	shardsCmd.AddCommand(addShardCmd)
+	shardsCmd.AddCommand(detachShardCmd)
 
 	initControlShardsListCmd()
 	initControlSetShardModeCmd()
 	initControlDumpShardCmd()
 	initControlRestoreShardCmd()
 	initControlEvacuateShardCmd()
+	initControlRebalanceShardsCmd()
 	initControlFlushCacheCmd()
+	initControlShardsGCCmd()
+	initControlShardsScrubCmd()
+	initControlAddShardCmd()
+	initControlDetachShardCmd()
 }
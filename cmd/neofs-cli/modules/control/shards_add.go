@@ -0,0 +1,79 @@
+package control
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const shardConfigFlag = "config"
+
+var addShardCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Attach new shard",
+	Long: `Attach new shard to the storage engine without node restart. Shard
+configuration is read from the YAML file having the same format as an item of
+the "storage.shards" list of the node configuration, omitted values are taken
+from "storage.shard_defaults". Attached shard is kept after node restart.`,
+	Args: cobra.NoArgs,
+	RunE: addShard,
+}
+
+func addShard(cmd *cobra.Command, _ []string) error {
+	pk, err := key.Get(cmd)
+	if err != nil {
+		return err
+	}
+
+	p, _ := cmd.Flags().GetString(shardConfigFlag)
+	cfg, err := os.ReadFile(p)
+	if err != nil {
+		return fmt.Errorf("can't read shard configuration: %w", err)
+	}
+
+	req := &control.AttachShardRequest{
+		Body: &control.AttachShardRequest_Body{
+			Config: cfg,
+		},
+	}
+
+	err = signRequest(pk, req)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	cli, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cli.AttachShard(ctx, req)
+	if err != nil {
+		return fmt.Errorf("rpc error: %w", err)
+	}
+
+	err = verifyResponse(resp.GetSignature(), resp.GetBody())
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("Shard %s has been attached successfully.\n", base58.Encode(resp.GetBody().GetShard_ID()))
+	return nil
+}
+
+func initControlAddShardCmd() {
+	initControlFlags(addShardCmd)
+
+	ff := addShardCmd.Flags()
+	ff.String(shardConfigFlag, "", "Path to the YAML file with shard configuration")
+
+	_ = addShardCmd.MarkFlagRequired(shardConfigFlag)
+}
@@ -0,0 +1,79 @@
+package control
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const shardEvacuateFlag = "evacuate"
+
+var detachShardCmd = &cobra.Command{
+	Use:   "detach",
+	Short: "Detach shard",
+	Long: `Detach shard from the storage engine without node restart. With --evacuate
+the shard is moved to read-only mode and its objects are moved to other shards
+(or replicated to other nodes if there are no other shards) first, otherwise
+objects of the shard become unavailable. Detached shard is not opened after
+node restart.`,
+	Args: cobra.NoArgs,
+	RunE: detachShard,
+}
+
+func detachShard(cmd *cobra.Command, _ []string) error {
+	pk, err := key.Get(cmd)
+	if err != nil {
+		return err
+	}
+
+	body := new(control.DetachShardRequest_Body)
+	body.Shard_ID, err = getShardID(cmd)
+	if err != nil {
+		return err
+	}
+	body.Evacuate, _ = cmd.Flags().GetBool(shardEvacuateFlag)
+
+	req := &control.DetachShardRequest{Body: body}
+
+	err = signRequest(pk, req)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	cli, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cli.DetachShard(ctx, req)
+	if err != nil {
+		return fmt.Errorf("rpc error: %w", err)
+	}
+
+	err = verifyResponse(resp.GetSignature(), resp.GetBody())
+	if err != nil {
+		return err
+	}
+
+	if body.Evacuate {
+		cmd.Printf("Objects moved: %d\n", resp.GetBody().GetEvacuated())
+	}
+	cmd.Println("Shard has been detached successfully.")
+	return nil
+}
+
+func initControlDetachShardCmd() {
+	initControlFlags(detachShardCmd)
+
+	ff := detachShardCmd.Flags()
+	ff.String(shardIDFlag, "", "Shard ID in base58 encoding")
+	ff.Bool(shardEvacuateFlag, false, "Evacuate objects of the shard before detaching")
+
+	_ = detachShardCmd.MarkFlagRequired(shardIDFlag)
+}
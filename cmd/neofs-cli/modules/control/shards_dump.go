@@ -2,24 +2,34 @@ package control
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
 	"github.com/spf13/cobra"
 )
 
 const (
 	dumpFilepathFlag     = "path"
 	dumpIgnoreErrorsFlag = "no-errors"
+	dumpFormatFlag       = "format-version"
+
+	objectFilterContainerFlag = "cid"
+	objectFilterTypeFlag      = "type"
+	objectFilterFromEpochFlag = "from-epoch"
+	objectFilterToEpochFlag   = "to-epoch"
 )
 
 var dumpShardCmd = &cobra.Command{
 	Use:   "dump",
 	Short: "Dump objects from shard",
-	Long:  "Dump objects from shard to a file",
-	Args:  cobra.NoArgs,
-	RunE:  dumpShard,
+	Long: `Dump objects from shard to a file. Version 2 format (default) is
+compressed and has checksums for every object, version 1 is the legacy one.`,
+	Args: cobra.NoArgs,
+	RunE: dumpShard,
 }
 
 func dumpShard(cmd *cobra.Command, _ []string) error {
@@ -41,6 +51,15 @@ func dumpShard(cmd *cobra.Command, _ []string) error {
 	ignore, _ := cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
 	body.SetIgnoreErrors(ignore)
 
+	v, _ := cmd.Flags().GetUint32(dumpFormatFlag)
+	body.SetFormatVersion(v)
+
+	flt, err := getObjectFilter(cmd)
+	if err != nil {
+		return err
+	}
+	body.SetFilter(flt)
+
 	req := new(control.DumpShardRequest)
 	req.SetBody(body)
 
@@ -77,8 +96,57 @@ func initControlDumpShardCmd() {
 	flags.String(shardIDFlag, "", "Shard ID in base58 encoding")
 	flags.String(dumpFilepathFlag, "", "File to write objects to")
 	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.Uint32(dumpFormatFlag, 0, "Dump format version (1 or 2), 0 means the latest one")
+	addObjectFilterFlags(dumpShardCmd)
 
 	_ = dumpShardCmd.MarkFlagRequired(shardIDFlag)
 	_ = dumpShardCmd.MarkFlagRequired(dumpFilepathFlag)
 	_ = dumpShardCmd.MarkFlagRequired(controlRPC)
 }
+
+func addObjectFilterFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringSlice(objectFilterContainerFlag, nil, "Process only objects from these containers")
+	flags.StringSlice(objectFilterTypeFlag, nil, "Process only objects of these types (REGULAR, TOMBSTONE, LOCK, LINK)")
+	flags.Uint64(objectFilterFromEpochFlag, 0, "Process only objects created at this epoch or later")
+	flags.Uint64(objectFilterToEpochFlag, 0, "Process only objects created at this epoch or earlier, 0 means no limit")
+}
+
+// getObjectFilter returns object filter from the command flags, nil if no
+// filter flags are set.
+func getObjectFilter(cmd *cobra.Command) (*control.ObjectFilter, error) {
+	var (
+		flags = cmd.Flags()
+		res   control.ObjectFilter
+	)
+
+	cnrs, _ := flags.GetStringSlice(objectFilterContainerFlag)
+	for _, s := range cnrs {
+		id, err := cid.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid container ID %q: %w", s, err)
+		}
+		res.Container_ID = append(res.Container_ID, id[:])
+	}
+
+	types, _ := flags.GetStringSlice(objectFilterTypeFlag)
+	for _, s := range types {
+		var t object.Type
+		if !t.DecodeString(strings.ToUpper(s)) {
+			return nil, fmt.Errorf("invalid object type %q", s)
+		}
+		res.ObjectType = append(res.ObjectType, uint32(t))
+	}
+
+	res.FromEpoch, _ = flags.GetUint64(objectFilterFromEpochFlag)
+	res.ToEpoch, _ = flags.GetUint64(objectFilterToEpochFlag)
+	if res.ToEpoch != 0 && res.FromEpoch > res.ToEpoch {
+		return nil, fmt.Errorf("--%s is greater than --%s", objectFilterFromEpochFlag, objectFilterToEpochFlag)
+	}
+
+	if len(res.Container_ID) == 0 && len(res.ObjectType) == 0 && res.FromEpoch == 0 && res.ToEpoch == 0 {
+		return nil, nil
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,76 @@
+package control
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var shardsGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Run garbage collection on shards",
+	Long: `Run garbage collection cycle on shards right away and print its statistics.
+All the objects marked as garbage are removed, expired objects, locks and
+tombstones are collected for the latest epoch handled by the shard GC.`,
+	Args: cobra.NoArgs,
+	RunE: shardsGC,
+}
+
+func shardsGC(cmd *cobra.Command, _ []string) error {
+	pk, err := key.Get(cmd)
+	if err != nil {
+		return err
+	}
+
+	req := &control.RunShardGCRequest{Body: new(control.RunShardGCRequest_Body)}
+	req.Body.Shard_ID, err = getShardIDList(cmd)
+	if err != nil {
+		return err
+	}
+
+	err = signRequest(pk, req)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	cli, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cli.RunShardGC(ctx, req)
+	if err != nil {
+		return fmt.Errorf("rpc error: %w", err)
+	}
+
+	err = verifyResponse(resp.GetSignature(), resp.GetBody())
+	if err != nil {
+		return err
+	}
+
+	for _, sh := range resp.GetBody().GetShards() {
+		cmd.Printf("Shard %s: removed garbage %d, expired objects %d, expired locks %d, dropped tombstones %d, took %s\n",
+			base58.Encode(sh.GetShard_ID()), sh.GetRemovedGarbage(), sh.GetExpiredObjects(), sh.GetExpiredLocks(),
+			sh.GetDroppedTombstones(), time.Duration(sh.GetDurationMs())*time.Millisecond)
+	}
+
+	return nil
+}
+
+func initControlShardsGCCmd() {
+	initControlFlags(shardsGCCmd)
+
+	ff := shardsGCCmd.Flags()
+	ff.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	ff.Bool(shardAllFlag, false, "Process all shards")
+
+	shardsGCCmd.MarkFlagsOneRequired(shardIDFlag, shardAllFlag)
+}
@@ -74,14 +74,22 @@ func listShards(cmd *cobra.Command, _ []string) error {
 func prettyPrintShardsJSON(cmd *cobra.Command, ii []*control.ShardInfo) error {
 	out := make([]map[string]any, 0, len(ii))
 	for _, i := range ii {
-		out = append(out, map[string]any{
+		m := map[string]any{
 			"shard_id":    base58.Encode(i.Shard_ID),
 			"mode":        shardModeToString(i.GetMode()),
 			"metabase":    i.GetMetabasePath(),
 			"blobstor":    i.GetBlobstor(),
 			"writecache":  i.GetWritecachePath(),
 			"error_count": i.GetErrorCount(),
-		})
+		}
+		if r := i.GetResync(); r.GetInProgress() {
+			m["resync"] = map[string]any{
+				"done_partitions":  r.GetDonePartitions(),
+				"total_partitions": r.GetTotalPartitions(),
+				"objects":          r.GetObjects(),
+			}
+		}
+		out = append(out, m)
 	}
 
 	buf := bytes.NewBuffer(nil)
@@ -114,13 +122,23 @@ func prettyPrintShards(cmd *cobra.Command, ii []*control.ShardInfo) {
 			pathPrinter("Metabase", i.GetMetabasePath())+
 			sb.String()+
 			pathPrinter("Write-cache", i.GetWritecachePath())+
-			fmt.Sprintf("Error count: %d\n", i.GetErrorCount()),
+			fmt.Sprintf("Error count: %d\n", i.GetErrorCount())+
+			resyncPrinter(i.GetResync()),
 			base58.Encode(i.Shard_ID),
 			shardModeToString(i.GetMode()),
 		)
 	}
 }
 
+func resyncPrinter(r *control.ResyncInfo) string {
+	if !r.GetInProgress() {
+		return ""
+	}
+
+	return fmt.Sprintf("Metabase resync: %d/%d partitions, %d objects\n",
+		r.GetDonePartitions(), r.GetTotalPartitions(), r.GetObjects())
+}
+
 func shardModeToString(m control.ShardMode) string {
 	strMode, ok := lookUpShardModeString(m)
 	if ok {
@@ -0,0 +1,91 @@
+package control
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const rebalanceRateLimitFlag = "rate-limit"
+
+var rebalanceShardsCmd = &cobra.Command{
+	Use:   "rebalance",
+	Short: "Rebalance objects between shards",
+	Long: `Move objects between shards so that each object is stored in the shard
+with the highest weight for its address (the one new objects are put into).
+This makes newly added shards take their part of the existing objects.
+Rebalance interrupted by timeout or node restart is continued from the
+same position by the next call.`,
+	Args: cobra.NoArgs,
+	RunE: rebalanceShards,
+}
+
+func rebalanceShards(cmd *cobra.Command, _ []string) error {
+	pk, err := key.Get(cmd)
+	if err != nil {
+		return err
+	}
+
+	req := &control.RebalanceShardsRequest{Body: new(control.RebalanceShardsRequest_Body)}
+	req.Body.Shard_ID, err = getShardIDList(cmd)
+	if err != nil {
+		return err
+	}
+	req.Body.RateLimit, _ = cmd.Flags().GetUint32(rebalanceRateLimitFlag)
+
+	err = signRequest(pk, req)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	cli, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	stream, err := cli.RebalanceShards(ctx, req)
+	if err != nil {
+		return fmt.Errorf("rpc error: %w", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Errorf("rpc error: %w", err)
+		}
+
+		body := resp.GetBody()
+		if err := verifyResponse(resp.GetSignature(), body); err != nil {
+			return err
+		}
+
+		cmd.Printf("Shard %s: processed %d, moved %d, failed %d\n",
+			base58.Encode(body.GetShard_ID()), body.GetProcessed(), body.GetMoved(), body.GetFailed())
+	}
+
+	cmd.Println("Shards have successfully been rebalanced.")
+	return nil
+}
+
+func initControlRebalanceShardsCmd() {
+	initControlFlags(rebalanceShardsCmd)
+
+	flags := rebalanceShardsCmd.Flags()
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding to move objects from")
+	flags.Bool(shardAllFlag, false, "Process all shards")
+	flags.Uint32(rebalanceRateLimitFlag, 0, "Maximum number of objects moved per second, 0 means no limit")
+
+	rebalanceShardsCmd.MarkFlagsOneRequired(shardIDFlag, shardAllFlag)
+}
@@ -17,9 +17,10 @@ const (
 var restoreShardCmd = &cobra.Command{
 	Use:   "restore",
 	Short: "Restore objects from shard",
-	Long:  "Restore objects from shard to a file",
-	Args:  cobra.NoArgs,
-	RunE:  restoreShard,
+	Long: `Restore objects to shard from a file. Dump format version is detected
+automatically.`,
+	Args: cobra.NoArgs,
+	RunE: restoreShard,
 }
 
 func restoreShard(cmd *cobra.Command, _ []string) error {
@@ -41,6 +42,12 @@ func restoreShard(cmd *cobra.Command, _ []string) error {
 	ignore, _ := cmd.Flags().GetBool(restoreIgnoreErrorsFlag)
 	body.SetIgnoreErrors(ignore)
 
+	flt, err := getObjectFilter(cmd)
+	if err != nil {
+		return err
+	}
+	body.SetFilter(flt)
+
 	req := new(control.RestoreShardRequest)
 	req.SetBody(body)
 
@@ -78,6 +85,7 @@ func initControlRestoreShardCmd() {
 	flags.String(shardIDFlag, "", "Shard ID in base58 encoding")
 	flags.String(restoreFilepathFlag, "", "File to read objects from")
 	flags.Bool(restoreIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	addObjectFilterFlags(restoreShardCmd)
 
 	_ = restoreShardCmd.MarkFlagRequired(shardIDFlag)
 	_ = restoreShardCmd.MarkFlagRequired(restoreFilepathFlag)
@@ -0,0 +1,78 @@
+package control
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var shardsScrubCmd = &cobra.Command{
+	Use:   "scrub",
+	Short: "Check and repair shard metabases",
+	Long: `Check shard metabases against the stored objects right away, repair found
+problems and print scrub statistics. Metabase records of the objects missing in
+the storage are dropped, stored objects without metabase records are resynced,
+wrong object counters and container size estimations are recalculated.`,
+	Args: cobra.NoArgs,
+	RunE: shardsScrub,
+}
+
+func shardsScrub(cmd *cobra.Command, _ []string) error {
+	pk, err := key.Get(cmd)
+	if err != nil {
+		return err
+	}
+
+	req := &control.RunShardScrubRequest{Body: new(control.RunShardScrubRequest_Body)}
+	req.Body.Shard_ID, err = getShardIDList(cmd)
+	if err != nil {
+		return err
+	}
+
+	err = signRequest(pk, req)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	cli, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cli.RunShardScrub(ctx, req)
+	if err != nil {
+		return fmt.Errorf("rpc error: %w", err)
+	}
+
+	err = verifyResponse(resp.GetSignature(), resp.GetBody())
+	if err != nil {
+		return err
+	}
+
+	for _, sh := range resp.GetBody().GetShards() {
+		cmd.Printf("Shard %s: checked objects %d, checked blobs %d, missing blobs %d, missing records %d, wrong counters %t, wrong container sizes %d, took %s\n",
+			base58.Encode(sh.GetShard_ID()), sh.GetCheckedObjects(), sh.GetCheckedBlobs(), sh.GetMissingBlobs(),
+			sh.GetMissingRecords(), sh.GetWrongCounters(), sh.GetWrongContainerSizes(),
+			time.Duration(sh.GetDurationMs())*time.Millisecond)
+	}
+
+	return nil
+}
+
+func initControlShardsScrubCmd() {
+	initControlFlags(shardsScrubCmd)
+
+	ff := shardsScrubCmd.Flags()
+	ff.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	ff.Bool(shardAllFlag, false, "Process all shards")
+
+	shardsScrubCmd.MarkFlagsOneRequired(shardIDFlag, shardAllFlag)
+}
@@ -0,0 +1,66 @@
+package dump
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/spf13/cobra"
+)
+
+var listCMD = &cobra.Command{
+	Use:   "list",
+	Short: "Object listing",
+	Long: `List all objects stored in a shard dump with their sizes.
+Objects with checksum mismatch are marked as corrupted.`,
+	Args: cobra.NoArgs,
+	RunE: listFunc,
+}
+
+func init() {
+	common.AddDumpFileFlag(listCMD, &vPath)
+}
+
+func listFunc(cmd *cobra.Command, _ []string) error {
+	r, closeDump, err := openDump()
+	if err != nil {
+		return err
+	}
+	defer closeDump()
+
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		var corrupted bool
+		if err != nil {
+			if !errors.Is(err, dump.ErrChecksumMismatch) {
+				return fmt.Errorf("dump read failure: %w", err)
+			}
+			corrupted = true
+		}
+
+		addr := rec.Address
+		if r.Version() == dump.V1 {
+			// V1 records have no address, so it is taken from the object
+			var obj object.Object
+			if err := obj.Unmarshal(rec.Data); err != nil {
+				cmd.Printf("<invalid object: %v> %d\n", err, len(rec.Data))
+				continue
+			}
+			addr = objectcore.AddressOf(&obj)
+		}
+
+		if corrupted {
+			cmd.Printf("%s %d corrupted\n", addr, len(rec.Data))
+			continue
+		}
+		cmd.Printf("%s %d\n", addr, len(rec.Data))
+	}
+}
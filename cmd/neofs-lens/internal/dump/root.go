@@ -0,0 +1,44 @@
+package dump
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
+	"github.com/spf13/cobra"
+)
+
+var (
+	vPath string
+)
+
+// Root defines root command for operations with shard dumps.
+var Root = &cobra.Command{
+	Use:   "dump",
+	Short: "Operations with shard dumps",
+}
+
+func init() {
+	Root.AddCommand(listCMD)
+	Root.AddCommand(verifyCMD)
+}
+
+// openDump opens dump file located in vPath. Returned function must be
+// called to release resources.
+func openDump() (*dump.Reader, func(), error) {
+	f, err := os.Open(vPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not open dump: %w", err)
+	}
+
+	r, err := dump.NewReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, nil, fmt.Errorf("could not read dump header: %w", err)
+	}
+
+	return r, func() {
+		r.Close()
+		_ = f.Close()
+	}, nil
+}
@@ -0,0 +1,81 @@
+package dump
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/spf13/cobra"
+)
+
+var verifyCMD = &cobra.Command{
+	Use:   "verify",
+	Short: "Dump integrity check",
+	Long: `Check that every object in a shard dump can be decoded and matches its
+checksum and address, and that the dump is complete. Version 1 dumps have
+neither checksums nor trailer, so only objects are checked for them.`,
+	Args: cobra.NoArgs,
+	RunE: verifyFunc,
+}
+
+func init() {
+	common.AddDumpFileFlag(verifyCMD, &vPath)
+}
+
+func verifyFunc(cmd *cobra.Command, _ []string) error {
+	r, closeDump, err := openDump()
+	if err != nil {
+		return err
+	}
+	defer closeDump()
+
+	var objects, invalid uint64
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if !errors.Is(err, dump.ErrChecksumMismatch) {
+				return fmt.Errorf("dump read failure after %d objects: %w", objects, err)
+			}
+
+			objects++
+			invalid++
+			cmd.Printf("%s: %v\n", rec.Address, err)
+			continue
+		}
+
+		objects++
+
+		var obj object.Object
+		if err := obj.Unmarshal(rec.Data); err != nil {
+			invalid++
+			cmd.Printf("object #%d: invalid object: %v\n", objects, err)
+			continue
+		}
+
+		if !rec.Address.Object().IsZero() {
+			if addr := objectcore.AddressOf(&obj); addr != rec.Address {
+				invalid++
+				cmd.Printf("%s: object address %s differs from the record one\n", rec.Address, addr)
+			}
+		}
+	}
+
+	cmd.Printf("Version: %d\n", r.Version())
+	cmd.Printf("Objects: %d\n", objects)
+	if t, ok := r.Trailer(); ok {
+		cmd.Printf("Size: %d\n", t.Size)
+	}
+	cmd.Printf("Invalid: %d\n", invalid)
+
+	if invalid != 0 {
+		return fmt.Errorf("%d invalid objects found", invalid)
+	}
+	return nil
+}
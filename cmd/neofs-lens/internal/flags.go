@@ -55,3 +55,12 @@ func AddInputPathFile(cmd *cobra.Command, v *string) {
 	_ = cmd.MarkFlagFilename(flagInFile)
 	_ = cmd.MarkFlagRequired(flagInFile)
 }
+
+// AddDumpFileFlag adds the path-to-dump flag to the passed cobra command.
+func AddDumpFileFlag(cmd *cobra.Command, v *string) {
+	cmd.Flags().StringVar(v, flagEnginePath, "",
+		"Path to shard dump file",
+	)
+	_ = cmd.MarkFlagFilename(flagEnginePath)
+	_ = cmd.MarkFlagRequired(flagEnginePath)
+}
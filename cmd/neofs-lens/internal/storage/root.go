@@ -7,6 +7,8 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/peapod"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/router"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
@@ -87,11 +89,19 @@ func openEngine() (*engine.StorageEngine, error) {
 			// should never happen, that has already
 			// been handled: when the config was read
 		}
+		if sRead.SmallObjects.Enabled() {
+			s = router.New(peapod.New(
+				peapod.WithPath(sRead.SmallObjects.Path),
+				peapod.WithPerm(sRead.Perm),
+				peapod.WithNoSync(*sRead.NoSync),
+				peapod.WithFlushInterval(sRead.FlushInterval),
+			), s, uint64(sRead.SmallObjects.MaxSize))
+		}
 
 		var writeCacheOpts []writecache.Option
 		if wcRead := shCfg.WriteCache; *wcRead.Enabled {
 			writeCacheOpts = append(writeCacheOpts,
-				writecache.WithPath(wcRead.Path),
+				writecache.WithPaths(wcRead.AllPaths()...),
 				writecache.WithMaxCacheSize(uint64(wcRead.Capacity)),
 				writecache.WithNoSync(*wcRead.NoSync),
 				writecache.WithMaxFlushBatchSize(wcMaxBatchSize),
@@ -15,6 +15,8 @@ import (
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	commonb "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/peapod"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/router"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
@@ -36,8 +38,8 @@ func init() {
 }
 
 type storageShard struct {
-	m   *meta.DB
-	fsT *fstree.FSTree
+	m    *meta.DB
+	blob commonb.Storage
 }
 
 func sanityCheck(cmd *cobra.Command, _ []string) error {
@@ -45,8 +47,8 @@ func sanityCheck(cmd *cobra.Command, _ []string) error {
 	defer func() {
 		for _, sh := range shards {
 			_ = sh.m.Close()
-			if sh.fsT != nil {
-				_ = sh.fsT.Close()
+			if sh.blob != nil {
+				_ = sh.blob.Close()
 			}
 		}
 	}()
@@ -75,20 +77,26 @@ func sanityCheck(cmd *cobra.Command, _ []string) error {
 		default:
 			return fmt.Errorf("unsupported sub-storage type '%s'", subCfg.Type)
 		case fstree.Type:
-			sh.fsT = fstree.New(
+			sh.blob = fstree.New(
 				fstree.WithPath(subCfg.Path),
 				fstree.WithPerm(subCfg.Perm),
 				fstree.WithDepth(subCfg.Depth),
 				fstree.WithNoSync(*subCfg.NoSync),
 			)
 		}
+		if subCfg.SmallObjects.Enabled() {
+			sh.blob = router.New(peapod.New(
+				peapod.WithPath(subCfg.SmallObjects.Path),
+				peapod.WithPerm(subCfg.Perm),
+			), sh.blob, uint64(subCfg.SmallObjects.MaxSize))
+		}
 
 		if err := sh.m.Open(true); err != nil {
 			return fmt.Errorf("open metabase: %w", err)
 		}
-		if sh.fsT != nil {
-			if err := sh.fsT.Open(true); err != nil {
-				return fmt.Errorf("open fstree: %w", err)
+		if sh.blob != nil {
+			if err := sh.blob.Open(true); err != nil {
+				return fmt.Errorf("open %s: %w", sh.blob.Type(), err)
 			}
 		}
 
@@ -100,9 +108,9 @@ func sanityCheck(cmd *cobra.Command, _ []string) error {
 		if err := sh.m.Init(); err != nil {
 			return fmt.Errorf("init metabase: %w", err)
 		}
-		if sh.fsT != nil {
-			if err := sh.fsT.Init(); err != nil {
-				return fmt.Errorf("init fstree: %w", err)
+		if sh.blob != nil {
+			if err := sh.blob.Init(); err != nil {
+				return fmt.Errorf("init %s: %w", sh.blob.Type(), err)
 			}
 		}
 
@@ -173,8 +181,8 @@ func checkShard(cmd *cobra.Command, sh storageShard) (int, error) {
 			}
 
 			var checkErr error
-			if sh.fsT != nil {
-				checkErr = checkObject(*header, sh.fsT)
+			if sh.blob != nil {
+				checkErr = checkObject(*header, sh.blob)
 			}
 
 			if checkErr != nil {
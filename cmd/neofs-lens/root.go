@@ -4,6 +4,7 @@ import (
 	"os"
 
 	"github.com/nspcc-dev/neofs-node/cmd/internal/cmderr"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/dump"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/fstree"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/meta"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/object"
@@ -44,6 +45,7 @@ func init() {
 		storage.Root,
 		object.Root,
 		fstree.Root,
+		dump.Root,
 		gendoc.Command(command),
 	)
 }
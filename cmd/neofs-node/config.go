@@ -279,6 +279,10 @@ type cfgObject struct {
 
 type cfgLocalStorage struct {
 	localStorage *engine.StorageEngine
+
+	// shardsMtx serializes changes of the shard set made through the
+	// control service and configuration reloads.
+	shardsMtx sync.Mutex
 }
 
 type cfgObjectRoutines struct {
@@ -306,6 +310,7 @@ type cfgReputation struct {
 var (
 	persistateFSChainLastBlockKey             = []byte("fs_chain_last_processed_block")
 	persistateDeprecatedSidechainLastBlockKey = []byte("side_chain_last_processed_block")
+	persistateRuntimeShardsKey                = []byte("runtime_shards")
 )
 
 func initCfg(appCfg *config.Config) *cfg {
@@ -314,11 +319,18 @@ func initCfg(appCfg *config.Config) *cfg {
 
 	c.appCfg = appCfg
 
+	persistate, err := state.NewPersistentStorage(appCfg.Node.PersistentState.Path)
+	fatalOnErr(err)
+
+	// shards attached and detached through the control service
+	err = applyRuntimeShards(&appCfg.Storage, persistate)
+	fatalOnErr(err)
+
 	c.cfgNodeInfo.localInfoLock.Lock()
 	// filling system attributes; do not move it anywhere
 	// below applying the other attributes since a user
 	// should be able to overwrite it.
-	err := writeSystemAttributes(c)
+	err = writeSystemAttributes(c)
 	c.cfgNodeInfo.localInfoLock.Unlock()
 	fatalOnErr(err)
 
@@ -331,9 +343,6 @@ func initCfg(appCfg *config.Config) *cfg {
 		netAddr = appCfg.Node.BootstrapAddresses()
 	}
 
-	persistate, err := state.NewPersistentStorage(appCfg.Node.PersistentState.Path)
-	fatalOnErr(err)
-
 	containerWorkerPool, err := ants.NewPool(notificationHandlerPoolSize)
 	fatalOnErr(err)
 
@@ -379,6 +388,8 @@ func initCfg(appCfg *config.Config) *cfg {
 		return &b
 	}
 
+	c.metricsCollector = metrics.NewNodeMetrics(misc.Version)
+
 	basicSharedConfig := initBasics(c, key, persistate)
 	streamTimeout := appCfg.APIClient.StreamTimeout
 	minConnTimeout := appCfg.APIClient.MinConnectionTime
@@ -419,7 +430,6 @@ func initCfg(appCfg *config.Config) *cfg {
 
 	c.ownerIDFromKey = user.NewFromECDSAPublicKey(key.PrivateKey.PublicKey)
 
-	c.metricsCollector = metrics.NewNodeMetrics(misc.Version)
 	c.basics.networkState.metrics = c.metricsCollector
 
 	c.veryLastClosers = make(map[string]func())
@@ -478,6 +488,7 @@ func initBasics(c *cfg, key *keys.PrivateKey, stateStorage *state.PersistentStor
 			}
 		}),
 		client.WithMinRequiredBlockHeight(fromFSChainBlock),
+		client.WithMetrics(c.metricsCollector),
 	)
 	if err != nil {
 		c.log.Info("failed to create neo RPC client",
@@ -674,6 +685,14 @@ func (c *cfg) configWatcher(ctx context.Context) {
 
 			// Storage Engine
 
+			c.cfgObject.cfgLocalStorage.shardsMtx.Lock()
+			err = applyRuntimeShards(&c.appCfg.Storage, c.persistate)
+			if err != nil {
+				c.cfgObject.cfgLocalStorage.shardsMtx.Unlock()
+				c.log.Error("runtime shards configuration", zap.Error(err))
+				continue
+			}
+
 			var rcfg engine.ReConfiguration
 			for _, optsWithID := range c.shardOpts() {
 				rcfg.AddShard(optsWithID.configID, optsWithID.shOpts)
@@ -681,6 +700,7 @@ func (c *cfg) configWatcher(ctx context.Context) {
 			rcfg.SetShardPoolSize(uint32(c.appCfg.Storage.ShardPoolSize))
 
 			err = c.cfgObject.cfgLocalStorage.localStorage.Reload(rcfg)
+			c.cfgObject.cfgLocalStorage.shardsMtx.Unlock()
 			if err != nil {
 				c.log.Error("storage engine configuration update", zap.Error(err))
 				continue
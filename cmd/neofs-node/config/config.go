@@ -15,6 +15,7 @@ import (
 	policerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/policer"
 	replicatorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/replicator"
 	serviceconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/service"
+	tracingconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tracing"
 )
 
 // Config contains all configuration parameters of the node.
@@ -22,6 +23,7 @@ type Config struct {
 	Logger     loggerconfig.Logger         `mapstructure:"logger"`
 	Pprof      serviceconfig.Service       `mapstructure:"pprof"`
 	Prometheus serviceconfig.Service       `mapstructure:"prometheus"`
+	Tracing    tracingconfig.Tracing       `mapstructure:"tracing"`
 	Meta       metaconfig.Meta             `mapstructure:"metadata"`
 	Node       nodeconfig.Node             `mapstructure:"node"`
 	GRPC       []grpcconfig.GRPC           `mapstructure:"grpc"`
@@ -53,6 +55,7 @@ func (c *Config) Normalize() {
 		&c.Object,
 		&c.Policer,
 		&c.Replicator,
+		&c.Tracing,
 	}
 	for _, field := range fields {
 		field.Normalize()
@@ -8,6 +8,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	ioschedconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/iosched"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/internal"
 	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
@@ -36,6 +37,13 @@ func TestEngineSection(t *testing.T) {
 		require.EqualValues(t, engineconfig.ShardPoolSizeDefault, empty.Storage.ShardPoolSize)
 		require.EqualValues(t, mode.ReadWrite, empty.Storage.Default.Mode)
 		require.Zero(t, empty.Storage.PutRetryTimeout)
+		require.Empty(t, empty.Storage.Tiering.Hot)
+		require.Equal(t, engineconfig.TieringIntervalDefault, empty.Storage.Tiering.Interval)
+		require.Zero(t, empty.Storage.ObjectCache.Capacity)
+		require.EqualValues(t, engineconfig.ObjectCacheMaxObjectSizeDefault, empty.Storage.ObjectCache.MaxObjectSize)
+		require.Zero(t, empty.Storage.Space.NearFullWatermark)
+		require.Zero(t, empty.Storage.Space.ReadOnlyWatermark)
+		require.Equal(t, engineconfig.SpaceCheckIntervalDefault, empty.Storage.Space.CheckInterval)
 	})
 
 	const path = "../../../../config/example/node"
@@ -47,6 +55,16 @@ func TestEngineSection(t *testing.T) {
 		require.EqualValues(t, 15, c.Storage.ShardPoolSize)
 		require.EqualValues(t, 5*time.Second, c.Storage.PutRetryTimeout)
 		require.EqualValues(t, true, c.Storage.IgnoreUninitedShards)
+		require.Equal(t, "nvme", c.Storage.Tiering.Hot)
+		require.Equal(t, "hdd", c.Storage.Tiering.Cold)
+		require.Equal(t, 168*time.Hour, c.Storage.Tiering.DemoteAfter)
+		require.EqualValues(t, 5, c.Storage.Tiering.PromoteReads)
+		require.Equal(t, 30*time.Minute, c.Storage.Tiering.Interval)
+		require.EqualValues(t, 256<<20, c.Storage.ObjectCache.Capacity)
+		require.EqualValues(t, 32<<10, c.Storage.ObjectCache.MaxObjectSize)
+		require.Equal(t, 0.9, c.Storage.Space.NearFullWatermark)
+		require.Equal(t, 0.97, c.Storage.Space.ReadOnlyWatermark)
+		require.Equal(t, 30*time.Second, c.Storage.Space.CheckInterval)
 
 		err := engineconfig.IterateShards(&c.Storage, true, func(sc *shardconfig.ShardDetails) error {
 			defer func() {
@@ -64,40 +82,84 @@ func TestEngineSection(t *testing.T) {
 				require.True(t, *wc.NoSync)
 
 				require.Equal(t, "tmp/0/cache", wc.Path)
+				require.Empty(t, wc.Paths)
 				require.EqualValues(t, 3221225472, wc.Capacity)
+				require.Equal(t, 500*time.Millisecond, wc.MaxPutDelay)
+				require.Equal(t, 50*time.Millisecond, wc.FlushLatencyTarget)
 
 				require.Equal(t, "tmp/0/meta", meta.Path)
 				require.Equal(t, fs.FileMode(0644), meta.Perm)
 				require.Equal(t, internal.Size(100), meta.MaxBatchSize)
 				require.Equal(t, 10*time.Millisecond, meta.MaxBatchDelay)
+				require.Equal(t, 24*time.Hour, meta.ScrubInterval)
 
 				require.True(t, *sc.Compress)
 				require.Equal(t, []string{"audio/*", "video/*"}, sc.CompressionExcludeContentTypes)
+				require.Equal(t, "lz4", sc.CompressionCodec)
+				require.EqualValues(t, 8*1024, sc.CompressionSampleSize)
+				require.Equal(t, 0.1, sc.CompressionMinSavingRatio)
+				require.Equal(t, []shardconfig.CompressionRule{
+					{
+						Containers: []string{"AzctuprbDQScFe3ThkwvV8ACnNvzHtPZHuE1ws5Uvi4Q", "auEnEoDtSxCYNL8TU9iwF33ASjBPqE8iV9GnvFVtAUM"},
+						Codec:      "zstd-best",
+					},
+					{
+						Containers: []string{"9ycR84pdNr9HCp479WCnygeGN1p8nmFpz6KPuaopAm67"},
+						Codec:      "none",
+					},
+				}, sc.CompressionRules)
+
+				require.True(t, *sc.Encryption.Enabled)
+				require.Equal(t, "tmp/0/encryption.key", sc.Encryption.KeyFile)
+				require.Equal(t, []string{"tmp/0/encryption.key.old"}, sc.Encryption.OldKeyFiles)
 
 				require.Equal(t, "tmp/0/blob", ss.Path)
 				require.EqualValues(t, 0644, ss.Perm)
 				require.EqualValues(t, 5, ss.Depth)
 				require.False(t, *ss.NoSync)
+				require.False(t, ss.SmallObjects.Enabled())
+				require.Zero(t, ss.ScrubRate)
+				require.Empty(t, ss.QuarantinePath)
 
 				require.EqualValues(t, 150, gc.RemoverBatchSize)
 				require.Equal(t, 2*time.Minute, gc.RemoverSleepInterval)
 
+				require.Equal(t, 32, sc.IOScheduler.MaxConcurrentOps)
+				require.Equal(t, ioschedconfig.Class{Weight: 100}, sc.IOScheduler.Client)
+				require.Zero(t, sc.IOScheduler.Replication)
+				require.Equal(t, ioschedconfig.Class{Weight: 10, BandwidthLimit: 16 << 20}, sc.IOScheduler.GC)
+				require.Equal(t, ioschedconfig.Class{IOPSLimit: 100}, sc.IOScheduler.Scrub)
+
 				require.False(t, *sc.ResyncMetabase)
+				require.Equal(t, 8, sc.ResyncMetabaseWorkers)
 				require.Equal(t, mode.ReadOnly, sc.Mode)
+				require.Equal(t, "hdd", sc.Tier)
 			case 1:
 				require.True(t, *wc.Enabled)
 				require.False(t, *wc.NoSync)
 
+				require.Equal(t, "nvme", sc.Tier)
+
 				require.Equal(t, "tmp/1/cache", wc.Path)
+				require.Equal(t, []string{"tmp/1/cache2", "tmp/1/cache3"}, wc.Paths)
+				require.Equal(t, []string{"tmp/1/cache", "tmp/1/cache2", "tmp/1/cache3"}, wc.AllPaths())
 				require.EqualValues(t, 4294967296, wc.Capacity)
+				require.Zero(t, wc.MaxPutDelay)
+				require.Zero(t, wc.FlushLatencyTarget)
 
 				require.Equal(t, "tmp/1/meta", meta.Path)
 				require.Equal(t, fs.FileMode(0644), meta.Perm)
 				require.EqualValues(t, 200, meta.MaxBatchSize)
 				require.Equal(t, 20*time.Millisecond, meta.MaxBatchDelay)
+				require.Zero(t, meta.ScrubInterval)
 
 				require.False(t, *sc.Compress)
 				require.Equal(t, []string(nil), sc.CompressionExcludeContentTypes)
+				require.Empty(t, sc.CompressionRules)
+
+				require.False(t, *sc.Encryption.Enabled)
+				require.Empty(t, sc.Encryption.KeyFile)
+				require.Empty(t, sc.Encryption.OldKeyFiles)
 
 				require.Equal(t, "tmp/1/blob", ss.Path)
 				require.EqualValues(t, 0644, ss.Perm)
@@ -105,9 +167,17 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 5, ss.Depth)
 				require.True(t, *ss.NoSync)
 
+				require.True(t, ss.SmallObjects.Enabled())
+				require.EqualValues(t, 16*1024, ss.SmallObjects.MaxSize)
+				require.Equal(t, "tmp/1/peapod.db", ss.SmallObjects.Path)
+				require.EqualValues(t, 10*1024*1024, ss.ScrubRate)
+				require.Equal(t, "tmp/1/quarantine", ss.QuarantinePath)
+
 				require.EqualValues(t, 200, gc.RemoverBatchSize)
 				require.Equal(t, 5*time.Minute, gc.RemoverSleepInterval)
 
+				require.Zero(t, sc.IOScheduler)
+
 				require.True(t, *sc.ResyncMetabase)
 				require.Equal(t, mode.ReadWrite, sc.Mode)
 			}
@@ -35,6 +35,23 @@ type Blobstor struct {
 	CombinedCountLimit    int           `mapstructure:"combined_count_limit"`
 	CombinedSizeLimit     internal.Size `mapstructure:"combined_size_limit"`
 	CombinedSizeThreshold internal.Size `mapstructure:"combined_size_threshold"`
+	SmallObjects          SmallObjects  `mapstructure:"small_objects"`
+	ScrubRate             internal.Size `mapstructure:"scrub_rate"`
+	QuarantinePath        string        `mapstructure:"quarantine_path"`
+}
+
+// SmallObjects contains configuration for a separate peapod storage of small
+// objects.
+type SmallObjects struct {
+	// MaxSize is the maximum binary object size for it to be put into the
+	// peapod, zero disables the storage.
+	MaxSize internal.Size `mapstructure:"max_size"`
+	Path    string        `mapstructure:"path"`
+}
+
+// Enabled checks whether small objects must be stored in a separate peapod.
+func (s SmallObjects) Enabled() bool {
+	return s.MaxSize > 0
 }
 
 // Normalize fills in default values in Blobstor configuration if they are not set.
@@ -57,6 +74,12 @@ func (b *Blobstor) Normalize(def Blobstor) {
 		}
 	}
 	b.NoSync = internal.CheckPtrBool(b.NoSync, def.NoSync)
+	if b.SmallObjects.MaxSize <= 0 {
+		b.SmallObjects.MaxSize = def.SmallObjects.MaxSize
+	}
+	if b.ScrubRate <= 0 {
+		b.ScrubRate = def.ScrubRate
+	}
 	if b.Type == fstree.Type {
 		if b.Depth < 1 || b.Depth > fstree.MaxDepth {
 			if def.Depth < 1 || def.Depth > fstree.MaxDepth {
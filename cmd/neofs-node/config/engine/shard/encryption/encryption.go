@@ -0,0 +1,23 @@
+package encryptionconfig
+
+import (
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/internal"
+)
+
+// Encryption contains configuration for encryption of the stored objects.
+type Encryption struct {
+	Enabled     *bool    `mapstructure:"enabled"`
+	KeyFile     string   `mapstructure:"key_file"`
+	OldKeyFiles []string `mapstructure:"old_key_files"`
+}
+
+// Normalize sets default values for encryption fields if they are not set.
+func (e *Encryption) Normalize(def Encryption) {
+	e.Enabled = internal.CheckPtrBool(e.Enabled, def.Enabled)
+	if e.KeyFile == "" {
+		e.KeyFile = def.KeyFile
+	}
+	if e.OldKeyFiles == nil {
+		e.OldKeyFiles = def.OldKeyFiles
+	}
+}
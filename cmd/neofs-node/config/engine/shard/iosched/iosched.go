@@ -0,0 +1,46 @@
+package ioschedconfig
+
+import "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/internal"
+
+// IOScheduler contains configuration for the I/O scheduler of the shard.
+type IOScheduler struct {
+	MaxConcurrentOps int   `mapstructure:"max_concurrent_ops"`
+	Client           Class `mapstructure:"client"`
+	Replication      Class `mapstructure:"replication"`
+	Flush            Class `mapstructure:"flush"`
+	GC               Class `mapstructure:"gc"`
+	Scrub            Class `mapstructure:"scrub"`
+}
+
+// Class contains scheduling parameters of the operation class.
+type Class struct {
+	Weight         int           `mapstructure:"weight"`
+	IOPSLimit      int           `mapstructure:"iops_limit"`
+	BandwidthLimit internal.Size `mapstructure:"bandwidth_limit"`
+}
+
+// Normalize ensures that all fields of IOScheduler have valid values.
+// If some of fields are not set or have invalid values, they will be
+// set to default values.
+func (s *IOScheduler) Normalize(def IOScheduler) {
+	if s.MaxConcurrentOps <= 0 {
+		s.MaxConcurrentOps = max(def.MaxConcurrentOps, 0)
+	}
+	s.Client.normalize(def.Client)
+	s.Replication.normalize(def.Replication)
+	s.Flush.normalize(def.Flush)
+	s.GC.normalize(def.GC)
+	s.Scrub.normalize(def.Scrub)
+}
+
+func (c *Class) normalize(def Class) {
+	if c.Weight <= 0 {
+		c.Weight = max(def.Weight, 0)
+	}
+	if c.IOPSLimit <= 0 {
+		c.IOPSLimit = max(def.IOPSLimit, 0)
+	}
+	if c.BandwidthLimit == 0 {
+		c.BandwidthLimit = def.BandwidthLimit
+	}
+}
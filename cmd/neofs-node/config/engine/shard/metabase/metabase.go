@@ -16,6 +16,7 @@ type Metabase struct {
 	Perm          fs.FileMode   `mapstructure:"perm"`
 	MaxBatchSize  internal.Size `mapstructure:"max_batch_size"`
 	MaxBatchDelay time.Duration `mapstructure:"max_batch_delay"`
+	ScrubInterval time.Duration `mapstructure:"scrub_interval"`
 }
 
 // Normalize metabase configuration by filling in default values.
@@ -31,4 +32,7 @@ func (m *Metabase) Normalize(def Metabase) {
 	if m.MaxBatchDelay <= 0 && def.MaxBatchDelay > 0 {
 		m.MaxBatchDelay = def.MaxBatchDelay
 	}
+	if m.ScrubInterval <= 0 && def.ScrubInterval > 0 {
+		m.ScrubInterval = def.ScrubInterval
+	}
 }
@@ -1,15 +1,21 @@
 package shardconfig
 
 import (
+	"bytes"
+	"fmt"
 	"path/filepath"
-	"strings"
+
+	"github.com/mitchellh/mapstructure"
 
 	blobstorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor"
+	encryptionconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/encryption"
 	gcconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/gc"
+	ioschedconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/iosched"
 	metabaseconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/metabase"
 	writecacheconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/writecache"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/internal"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/spf13/viper"
 )
 
 // SmallSizeLimitDefault is the default limit of small objects payload in bytes.
@@ -17,35 +23,97 @@ const SmallSizeLimitDefault = 1 << 20
 
 // ShardDetails contains configuration for a single shard of a storage node.
 type ShardDetails struct {
-	Mode                           mode.Mode `mapstructure:"mode"`
-	ResyncMetabase                 *bool     `mapstructure:"resync_metabase"`
-	Compress                       *bool     `mapstructure:"compress"`
-	CompressionExcludeContentTypes []string  `mapstructure:"compression_exclude_content_types"`
-
-	WriteCache writecacheconfig.WriteCache `mapstructure:"writecache"`
-	Metabase   metabaseconfig.Metabase     `mapstructure:"metabase"`
-	Blobstor   blobstorconfig.Blobstor     `mapstructure:"blobstor"`
-	GC         gcconfig.GC                 `mapstructure:"gc"`
+	Mode                           mode.Mode         `mapstructure:"mode"`
+	Tier                           string            `mapstructure:"tier"`
+	ResyncMetabase                 *bool             `mapstructure:"resync_metabase"`
+	ResyncMetabaseWorkers          int               `mapstructure:"resync_metabase_workers"`
+	Compress                       *bool             `mapstructure:"compress"`
+	CompressionExcludeContentTypes []string          `mapstructure:"compression_exclude_content_types"`
+	CompressionCodec               string            `mapstructure:"compression_codec"`
+	CompressionRules               []CompressionRule `mapstructure:"compression_rules"`
+	CompressionSampleSize          internal.Size     `mapstructure:"compression_sample_size"`
+	CompressionMinSavingRatio      float64           `mapstructure:"compression_min_saving_ratio"`
+
+	WriteCache  writecacheconfig.WriteCache `mapstructure:"writecache"`
+	Metabase    metabaseconfig.Metabase     `mapstructure:"metabase"`
+	Blobstor    blobstorconfig.Blobstor     `mapstructure:"blobstor"`
+	GC          gcconfig.GC                 `mapstructure:"gc"`
+	Encryption  encryptionconfig.Encryption `mapstructure:"encryption"`
+	IOScheduler ioschedconfig.IOScheduler   `mapstructure:"io_scheduler"`
+}
+
+// CompressionRule overrides compression codec for objects of the listed
+// containers.
+type CompressionRule struct {
+	Containers []string `mapstructure:"containers"`
+	Codec      string   `mapstructure:"codec"`
 }
 
 // Normalize ensures that all fields of ShardDetails have valid values.
 // If some of fields are not set or have invalid values, they will be
 // set to default values.
 func (s *ShardDetails) Normalize(def ShardDetails) {
+	if s.Tier == "" {
+		s.Tier = def.Tier
+	}
 	s.ResyncMetabase = internal.CheckPtrBool(s.ResyncMetabase, def.ResyncMetabase)
+	if s.ResyncMetabaseWorkers <= 0 {
+		s.ResyncMetabaseWorkers = def.ResyncMetabaseWorkers
+	}
 	s.Compress = internal.CheckPtrBool(s.Compress, def.Compress)
+	if s.CompressionCodec == "" {
+		s.CompressionCodec = def.CompressionCodec
+	}
+	if s.CompressionSampleSize == 0 {
+		s.CompressionSampleSize = def.CompressionSampleSize
+	}
+	if s.CompressionMinSavingRatio == 0 {
+		s.CompressionMinSavingRatio = def.CompressionMinSavingRatio
+	}
 	s.Blobstor.Normalize(def.Blobstor)
 	s.WriteCache.Normalize(def.WriteCache)
 	s.Metabase.Normalize(def.Metabase)
 	s.GC.Normalize(def.GC)
+	s.Encryption.Normalize(def.Encryption)
+	s.IOScheduler.Normalize(def.IOScheduler)
 }
 
 // ID returns persistent id of a shard. It is different from the ID used in runtime
 // and is primarily used to identify shards in the configuration.
 func (c *ShardDetails) ID() string {
+	return ID(c.Blobstor.Path)
+}
+
+// ID returns persistent id of a shard with the given blobstor path, see
+// [ShardDetails.ID].
+func ID(blobstorPath string) string {
 	// This calculation should be kept in sync with
 	// pkg/local_object_storage/engine/control.go file.
-	var sb strings.Builder
-	sb.WriteString(filepath.Clean(c.Blobstor.Path))
-	return sb.String()
+	return filepath.Clean(blobstorPath)
+}
+
+// Decode reads configuration of a single shard from YAML data having the
+// same format as an item of the "storage.shards" list. Resulting
+// configuration is not normalized.
+func Decode(data []byte) (ShardDetails, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+
+	err := v.ReadConfig(bytes.NewReader(data))
+	if err != nil {
+		return ShardDetails{}, fmt.Errorf("failed to read shard config: %w", err)
+	}
+
+	var s ShardDetails
+	err = v.UnmarshalExact(&s, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(" "),
+		internal.SizeHook(),
+		internal.ModeHook(),
+	)))
+	if err != nil {
+		return ShardDetails{}, fmt.Errorf("failed shard config unmarshal: %w", err)
+	}
+
+	return s, nil
 }
@@ -1,6 +1,8 @@
 package writecacheconfig
 
 import (
+	"time"
+
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/internal"
 )
 
@@ -15,8 +17,18 @@ const (
 type WriteCache struct {
 	Enabled  *bool         `mapstructure:"enabled"`
 	Path     string        `mapstructure:"path"`
+	Paths    []string      `mapstructure:"paths"`
 	Capacity internal.Size `mapstructure:"capacity"`
 	NoSync   *bool         `mapstructure:"no_sync"`
+
+	MaxPutDelay        time.Duration `mapstructure:"max_put_delay"`
+	FlushLatencyTarget time.Duration `mapstructure:"flush_latency_target"`
+}
+
+// AllPaths returns paths to all write-cache devices: the main path followed by
+// the additional ones.
+func (wc *WriteCache) AllPaths() []string {
+	return append([]string{wc.Path}, wc.Paths...)
 }
 
 // Normalize sets default values for write cache fields if they are not set.
@@ -24,4 +36,10 @@ func (wc *WriteCache) Normalize(def WriteCache) {
 	wc.Enabled = internal.CheckPtrBool(wc.Enabled, def.Enabled)
 	wc.NoSync = internal.CheckPtrBool(wc.NoSync, def.NoSync)
 	wc.Capacity.Check(def.Capacity, SizeLimitDefault)
+	if wc.MaxPutDelay == 0 {
+		wc.MaxPutDelay = def.MaxPutDelay
+	}
+	if wc.FlushLatencyTarget <= 0 && def.FlushLatencyTarget > 0 {
+		wc.FlushLatencyTarget = def.FlushLatencyTarget
+	}
 }
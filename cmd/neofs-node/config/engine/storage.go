@@ -4,12 +4,22 @@ import (
 	"time"
 
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/internal"
 )
 
 const (
 	// ShardPoolSizeDefault is the default value of routine pool size per-shard to
 	// process object PUT operations in a storage engine.
 	ShardPoolSizeDefault = 20
+	// TieringIntervalDefault is the default period of the background mover
+	// migrating objects between storage tiers.
+	TieringIntervalDefault = time.Hour
+	// ObjectCacheMaxObjectSizeDefault is the default size of the biggest
+	// object cached with payload in the in-memory object cache.
+	ObjectCacheMaxObjectSizeDefault = 64 << 10
+	// SpaceCheckIntervalDefault is the default period of the shards free
+	// space checks.
+	SpaceCheckIntervalDefault = time.Minute
 )
 
 // Storage contains configuration for the storage engine.
@@ -18,10 +28,35 @@ type Storage struct {
 	ShardROErrorThreshold int                        `mapstructure:"shard_ro_error_threshold"`
 	PutRetryTimeout       time.Duration              `mapstructure:"put_retry_timeout"`
 	IgnoreUninitedShards  bool                       `mapstructure:"ignore_uninited_shards"`
+	Tiering               Tiering                    `mapstructure:"tiering"`
+	ObjectCache           ObjectCache                `mapstructure:"object_cache"`
+	Space                 Space                      `mapstructure:"space"`
 	Default               shardconfig.ShardDetails   `mapstructure:"shard_defaults"`
 	ShardList             []shardconfig.ShardDetails `mapstructure:"shards"`
 }
 
+// Tiering contains configuration of the storage tiers shards belong to.
+type Tiering struct {
+	Hot          string        `mapstructure:"hot"`
+	Cold         string        `mapstructure:"cold"`
+	DemoteAfter  time.Duration `mapstructure:"demote_after"`
+	PromoteReads int           `mapstructure:"promote_reads"`
+	Interval     time.Duration `mapstructure:"interval"`
+}
+
+// ObjectCache contains configuration of the in-memory object cache.
+type ObjectCache struct {
+	Capacity      internal.Size `mapstructure:"capacity"`
+	MaxObjectSize internal.Size `mapstructure:"max_object_size"`
+}
+
+// Space contains configuration of the shards free space monitoring.
+type Space struct {
+	NearFullWatermark float64       `mapstructure:"near_full_watermark"`
+	ReadOnlyWatermark float64       `mapstructure:"read_only_watermark"`
+	CheckInterval     time.Duration `mapstructure:"check_interval"`
+}
+
 // Normalize ensures that all fields of Storage have valid values.
 // If some of fields are not set or have invalid values, they will be
 // set to default values.
@@ -29,6 +64,15 @@ func (s *Storage) Normalize() {
 	if s.ShardPoolSize == 0 {
 		s.ShardPoolSize = ShardPoolSizeDefault
 	}
+	if s.Tiering.Interval <= 0 {
+		s.Tiering.Interval = TieringIntervalDefault
+	}
+	if s.ObjectCache.MaxObjectSize == 0 {
+		s.ObjectCache.MaxObjectSize = ObjectCacheMaxObjectSizeDefault
+	}
+	if s.Space.CheckInterval <= 0 {
+		s.Space.CheckInterval = SpaceCheckIntervalDefault
+	}
 	for i := range s.ShardList {
 		s.ShardList[i].Normalize(s.Default)
 	}
@@ -0,0 +1,36 @@
+package tracingconfig_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	tracingconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tracing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracingSection(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		empty := configtest.EmptyConfig()
+
+		require.False(t, empty.Tracing.Enabled)
+		require.Empty(t, empty.Tracing.Endpoint)
+		require.False(t, empty.Tracing.Insecure)
+		require.Equal(t, tracingconfig.SamplingRatioDefault, empty.Tracing.SamplingRatio)
+	})
+
+	const path = "../../../../config/example/node"
+
+	var fileConfigTest = func(c *config.Config) {
+		require.True(t, c.Tracing.Enabled)
+		require.Equal(t, "localhost:4317", c.Tracing.Endpoint)
+		require.True(t, c.Tracing.Insecure)
+		require.Equal(t, 0.1, c.Tracing.SamplingRatio)
+	}
+
+	configtest.ForEachFileType(path, fileConfigTest)
+
+	t.Run("ENV", func(t *testing.T) {
+		configtest.ForEnvFileType(path, fileConfigTest)
+	})
+}
@@ -0,0 +1,19 @@
+package tracingconfig
+
+// SamplingRatioDefault is the default fraction of the sampled requests.
+const SamplingRatioDefault = 1.0
+
+// Tracing contains configuration for OpenTelemetry tracing.
+type Tracing struct {
+	Enabled       bool    `mapstructure:"enabled"`
+	Endpoint      string  `mapstructure:"endpoint"`
+	Insecure      bool    `mapstructure:"insecure"`
+	SamplingRatio float64 `mapstructure:"sampling_ratio"`
+}
+
+// Normalize sets default values for Tracing fields if they are not set.
+func (t *Tracing) Normalize() {
+	if t.SamplingRatio <= 0 {
+		t.SamplingRatio = SamplingRatioDefault
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	containerCore "github.com/nspcc-dev/neofs-node/pkg/core/container"
 	netmapCore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
@@ -696,6 +697,18 @@ func (x *containersInChain) PutEACL(eACL eacl.Table, pub, sig []byte, st *sessio
 	return nil
 }
 
+// containerCompressionPolicy returns compression codec requested by the
+// container attribute.
+func containerCompressionPolicy(src containerCore.Source) func(cid.ID) string {
+	return func(id cid.ID) string {
+		cnr, err := src.Get(id)
+		if err != nil {
+			return ""
+		}
+		return cnr.Attribute(compression.ContainerAttribute)
+	}
+}
+
 type containerPresenceChecker struct{ src containerCore.Source }
 
 // Exists implements [meta.Containers].
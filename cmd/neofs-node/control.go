@@ -61,3 +61,13 @@ func (c *cfg) setHealthStatus(st control.HealthStatus) {
 func (c *cfg) HealthStatus() control.HealthStatus {
 	return control.HealthStatus(c.healthStatus.Load())
 }
+
+func (c *cfg) FSChainStatus() *control.FSChainStatus {
+	endpoint := c.cli.Endpoint()
+
+	return &control.FSChainStatus{
+		Connected:   endpoint != "",
+		Endpoint:    endpoint,
+		BlockHeight: c.networkState.CurrentBlock(),
+	}
+}
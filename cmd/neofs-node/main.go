@@ -60,6 +60,8 @@ func main() {
 
 	preRunAndLog(c, profilerName, initProfiler(c))
 
+	initTracing(c)
+
 	initApp(c)
 
 	err = c.setShardsCapacity()
@@ -149,6 +151,7 @@ func initApp(c *cfg) {
 		c.cnrSrc,
 		c.replicator,
 		c,
+		c,
 	)
 }
 
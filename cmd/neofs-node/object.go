@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/nspcc-dev/neofs-node/internal/ec"
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	containercore "github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
@@ -188,11 +189,22 @@ func initObjectService(c *cfg) {
 			c.appCfg.Replicator.PutTimeout,
 		),
 		replicator.WithLocalStorage(ls),
+		replicator.WithMetrics(c.metricsCollector),
 		replicator.WithRemoteSender(
 			putsvc.NewRemoteSender(keyStorage, (*coreClientConstructor)(clientConstructor)),
 		),
 	)
 
+	sGet := getsvc.New(c,
+		getsvc.WithLogger(c.log),
+		getsvc.WithLocalStorageEngine(ls),
+		getsvc.WithClientConstructor(coreConstructor),
+		getsvc.WithKeyStorage(keyStorage),
+		getsvc.WithContainerSource(c.cnrSrc),
+	)
+
+	*c.cfgObject.getSvc = *sGet // need smth better
+
 	c.shared.policer = policer.New(
 		policer.WithLogger(c.log),
 		policer.WithLocalStorage(ls),
@@ -220,19 +232,15 @@ func initObjectService(c *cfg) {
 		policer.WithNetwork(c),
 		policer.WithReplicationCooldown(c.appCfg.Policer.ReplicationCooldown),
 		policer.WithObjectBatchSize(c.appCfg.Policer.ObjectBatchSize),
+		policer.WithECPartSource(sGet),
+		policer.WithReplicaSource(sGet),
+		policer.WithSigner(user.NewAutoIDSigner(c.key.PrivateKey)),
+		policer.WithNetworkState(c.cfgNetmap.state),
+		policer.WithMetrics(c.metricsCollector),
 	)
 
 	c.workers = append(c.workers, c.shared.policer)
 
-	sGet := getsvc.New(c,
-		getsvc.WithLogger(c.log),
-		getsvc.WithLocalStorageEngine(ls),
-		getsvc.WithClientConstructor(coreConstructor),
-		getsvc.WithKeyStorage(keyStorage),
-	)
-
-	*c.cfgObject.getSvc = *sGet // need smth better
-
 	cnrNodes, err := newContainerNodes(c.cnrSrc, c.netMapSource)
 	fatalOnErr(err)
 	c.cfgObject.containerNodes = cnrNodes
@@ -254,7 +262,7 @@ func initObjectService(c *cfg) {
 		putsvc.WithClientConstructor(putConstructor),
 		putsvc.WithContainerClient(c.cCli),
 		putsvc.WithMaxSizeSource(newCachedMaxObjectSizeSource(c)),
-		putsvc.WithObjectStorage(storageEngine{engine: ls}),
+		putsvc.WithObjectStorage(storageEngine{engine: ls, cnrSrc: c.cnrSrc}),
 		putsvc.WithContainerSource(c.cnrSrc),
 		putsvc.WithNetworkMapSource(c.netMapSource),
 		putsvc.WithNetworkState(c.cfgNetmap.state),
@@ -262,6 +270,15 @@ func initObjectService(c *cfg) {
 		putsvc.WithLogger(c.log),
 		putsvc.WithSplitChainVerifier(split.NewVerifier(sGet)),
 		putsvc.WithTombstoneVerifier(tombstone.NewVerifier(os)),
+		putsvc.WithQuotaUsage(&quotaUsage{
+			engine:   ls,
+			cnrCli:   c.cCli,
+			cnrSrc:   c.cnrSrc,
+			cnrLst:   c.cnrLst,
+			netState: c.cfgNetmap.state,
+			localKey: c.key.PublicKey().Bytes(),
+		}),
+		putsvc.WithQuotaMetrics(c.metricsCollector),
 	)
 
 	sDelete := deletesvc.New(
@@ -455,6 +472,7 @@ func (c *reputationClientConstructor) Get(info coreclient.NodeInfo) (coreclient.
 
 type storageEngine struct {
 	engine *engine.StorageEngine
+	cnrSrc containercore.Source
 }
 
 func (e storageEngine) IsLocked(address oid.Address) (bool, error) {
@@ -462,6 +480,11 @@ func (e storageEngine) IsLocked(address oid.Address) (bool, error) {
 }
 
 func (e storageEngine) Delete(tombstone oid.Address, tombExpiration uint64, toDelete []oid.ID) error {
+	toDelete, err := e.withECParts(tombstone.Container(), toDelete)
+	if err != nil {
+		return err
+	}
+
 	addrs := make([]oid.Address, len(toDelete))
 	for i := range addrs {
 		addrs[i].SetContainer(tombstone.Container())
@@ -472,11 +495,51 @@ func (e storageEngine) Delete(tombstone oid.Address, tombExpiration uint64, toDe
 }
 
 func (e storageEngine) Lock(locker oid.Address, toLock []oid.ID) error {
+	toLock, err := e.withECParts(locker.Container(), toLock)
+	if err != nil {
+		return err
+	}
+
 	return e.engine.Lock(locker.Container(), locker.Object(), toLock)
 }
 
-func (e storageEngine) Put(o *objectSDK.Object, objBin []byte) error {
-	return e.engine.Put(o, objBin)
+// withECParts appends IDs of locally stored erasure-coded parts of the given
+// objects if the container has erasure coding rule. Parts are separate
+// objects, so they are deleted and locked along with their parents.
+func (e storageEngine) withECParts(cnrID cid.ID, ids []oid.ID) ([]oid.ID, error) {
+	if e.cnrSrc == nil {
+		return ids, nil
+	}
+
+	cnr, err := e.cnrSrc.Get(cnrID)
+	if err != nil {
+		return nil, fmt.Errorf("get container: %w", err)
+	}
+
+	if _, ok, err := ec.RuleFromContainer(cnr); err != nil || !ok {
+		return ids, nil
+	}
+
+	res := ids[:len(ids):len(ids)]
+	for i := range ids {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter(ec.AttributeParent, ids[i].EncodeToString(), objectSDK.MatchStringEqual)
+
+		parts, err := e.engine.Select(context.Background(), cnrID, fs)
+		if err != nil {
+			return nil, fmt.Errorf("select local erasure-coded parts of %s: %w", ids[i], err)
+		}
+
+		for j := range parts {
+			res = append(res, parts[j].Object())
+		}
+	}
+
+	return res, nil
+}
+
+func (e storageEngine) Put(ctx context.Context, o *objectSDK.Object, objBin []byte) error {
+	return e.engine.Put(ctx, o, objBin)
 }
 
 func cachedHeaderSource(getSvc *getsvc.Service, cacheSize int, l *zap.Logger) headerSource {
@@ -641,12 +704,12 @@ type storageForObjectService struct {
 }
 
 // SearchObjects implements [objectService.Storage] interface.
-func (x storageForObjectService) SearchObjects(cID cid.ID, fs []objectcore.SearchFilter, attrs []string, cursor *objectcore.SearchCursor, count uint16) ([]client.SearchResultItem, []byte, error) {
-	return x.local.Search(cID, fs, attrs, cursor, count)
+func (x storageForObjectService) SearchObjects(ctx context.Context, cID cid.ID, fs []objectcore.SearchFilter, attrs []string, cursor *objectcore.SearchCursor, count uint16) ([]client.SearchResultItem, []byte, error) {
+	return x.local.Search(ctx, cID, fs, attrs, cursor, count)
 }
 
-func (x storageForObjectService) VerifyAndStoreObjectLocally(obj objectSDK.Object) error {
-	return x.putSvc.ValidateAndStoreObjectLocally(obj)
+func (x storageForObjectService) VerifyAndStoreObjectLocally(ctx context.Context, obj objectSDK.Object) error {
+	return x.putSvc.ValidateAndStoreObjectLocally(ctx, obj)
 }
 
 func (x storageForObjectService) GetSessionPrivateKey(usr user.ID, uid uuid.UUID) (ecdsa.PrivateKey, error) {
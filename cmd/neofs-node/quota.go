@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/internal/quota"
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// quotaUsage provides storage space used by containers for quota checks. Usage
+// of the container is a sum of sizes announced by other nodes in the last
+// finished estimation epoch and the current local size from the metabase.
+type quotaUsage struct {
+	engine *engine.StorageEngine
+	cnrCli *cntClient.Client
+	cnrSrc container.Source
+	cnrLst interface {
+		List(*user.ID) ([]cid.ID, error)
+	}
+	netState netmap.State
+	localKey []byte
+
+	mtx       sync.Mutex
+	epoch     uint64
+	estimated map[cid.ID]uint64
+
+	ownersMtx   sync.Mutex
+	ownersEpoch uint64
+	owners      map[user.ID]ownerQuota
+}
+
+// ownerQuota is a quota of the container owner cached for the epoch.
+type ownerQuota struct {
+	limits quota.Limits
+	cnrs   []cid.ID
+	// remote is a space used by the owner containers on other nodes.
+	remote uint64
+}
+
+// ContainerUsage implements [putsvc.QuotaUsage].
+func (q *quotaUsage) ContainerUsage(cnr cid.ID) (uint64, error) {
+	estimated, err := q.remoteEstimations()
+	if err != nil {
+		return 0, err
+	}
+
+	local, err := q.engine.ContainerSize(cnr)
+	if err != nil {
+		return 0, fmt.Errorf("get local container size: %w", err)
+	}
+
+	return estimated[cnr] + local, nil
+}
+
+// OwnerQuota implements [putsvc.QuotaUsage]. Containers of the owner, their
+// quotas and usage on other nodes are read once per epoch, local usage is
+// always actual.
+func (q *quotaUsage) OwnerQuota(owner user.ID) (quota.Limits, uint64, error) {
+	oq, err := q.ownerQuota(owner)
+	if err != nil {
+		return quota.Limits{}, 0, err
+	}
+
+	res := oq.remote
+	for i := range oq.cnrs {
+		local, err := q.engine.ContainerSize(oq.cnrs[i])
+		if err != nil {
+			return quota.Limits{}, 0, fmt.Errorf("get local size of container %s: %w", oq.cnrs[i], err)
+		}
+		res += local
+	}
+
+	return oq.limits, res, nil
+}
+
+func (q *quotaUsage) ownerQuota(owner user.ID) (ownerQuota, error) {
+	estimated, err := q.remoteEstimations()
+	if err != nil {
+		return ownerQuota{}, err
+	}
+
+	epoch := q.netState.CurrentEpoch()
+
+	q.ownersMtx.Lock()
+	defer q.ownersMtx.Unlock()
+
+	if q.owners == nil || q.ownersEpoch != epoch {
+		q.owners = make(map[user.ID]ownerQuota)
+		q.ownersEpoch = epoch
+	} else if oq, ok := q.owners[owner]; ok {
+		return oq, nil
+	}
+
+	cnrs, err := q.cnrLst.List(&owner)
+	if err != nil {
+		return ownerQuota{}, fmt.Errorf("list owner containers: %w", err)
+	}
+
+	oq := ownerQuota{cnrs: cnrs}
+	for i := range cnrs {
+		cnr, err := q.cnrSrc.Get(cnrs[i])
+		if err != nil {
+			return ownerQuota{}, fmt.Errorf("get container %s: %w", cnrs[i], err)
+		}
+
+		_, limits, err := quota.FromContainer(cnr)
+		if err != nil {
+			return ownerQuota{}, fmt.Errorf("container %s: %w", cnrs[i], err)
+		}
+
+		oq.limits = oq.limits.Strictest(limits)
+		oq.remote += estimated[cnrs[i]]
+	}
+
+	q.owners[owner] = oq
+
+	return oq, nil
+}
+
+// remoteEstimations returns container sizes announced by other nodes for the
+// previous epoch. Estimations are read from the FS chain once per epoch.
+func (q *quotaUsage) remoteEstimations() (map[cid.ID]uint64, error) {
+	epoch := q.netState.CurrentEpoch()
+	if epoch == 0 {
+		return nil, nil
+	}
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if q.estimated != nil && q.epoch == epoch {
+		return q.estimated, nil
+	}
+
+	list, err := q.cnrCli.ListLoadEstimationsByEpoch(epoch - 1)
+	if err != nil {
+		return nil, fmt.Errorf("list container size estimations for epoch %d: %w", epoch-1, err)
+	}
+
+	estimated := make(map[cid.ID]uint64, len(list))
+	for id, ee := range list {
+		for i := range ee.Values {
+			if !bytes.Equal(ee.Values[i].Reporter, q.localKey) {
+				estimated[id] += ee.Values[i].Size
+			}
+		}
+	}
+
+	q.epoch = epoch
+	q.estimated = estimated
+
+	return estimated, nil
+}
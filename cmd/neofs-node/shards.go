@@ -0,0 +1,229 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
+	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/util/state"
+	"go.uber.org/zap"
+)
+
+// runtimeShards is a set of changes of the configured shards made through
+// the control service. It is kept in the persistent state of the node and
+// applied on top of the configuration at startup and on reload.
+type runtimeShards struct {
+	// Attached shards with their configuration in YAML format.
+	Attached []attachedShard `json:"attached,omitempty"`
+	// Detached are configuration IDs of the detached shards.
+	Detached []string `json:"detached,omitempty"`
+}
+
+type attachedShard struct {
+	ConfigID string `json:"config_id"`
+	Config   []byte `json:"config"`
+}
+
+func loadRuntimeShards(st *state.PersistentStorage) (runtimeShards, error) {
+	var rs runtimeShards
+
+	b, err := st.Bytes(persistateRuntimeShardsKey)
+	if err != nil {
+		return rs, fmt.Errorf("read runtime shards: %w", err)
+	}
+	if b == nil {
+		return rs, nil
+	}
+
+	err = json.Unmarshal(b, &rs)
+	if err != nil {
+		return rs, fmt.Errorf("decode runtime shards: %w", err)
+	}
+	return rs, nil
+}
+
+func saveRuntimeShards(st *state.PersistentStorage, rs runtimeShards) error {
+	if len(rs.Attached) == 0 && len(rs.Detached) == 0 {
+		return st.Delete(persistateRuntimeShardsKey)
+	}
+
+	b, err := json.Marshal(rs)
+	if err != nil {
+		return fmt.Errorf("encode runtime shards: %w", err)
+	}
+	return st.SetBytes(persistateRuntimeShardsKey, b)
+}
+
+// applyRuntimeShards removes shards detached through the control service from
+// the configured ones and adds the attached shards. Attached shard replaces
+// the configured one with the same ID. Resulting shard list is validated the
+// same way as the configured one, s is not changed if it is invalid.
+func applyRuntimeShards(s *engineconfig.Storage, st *state.PersistentStorage) error {
+	rs, err := loadRuntimeShards(st)
+	if err != nil {
+		return err
+	}
+
+	skip := make(map[string]struct{}, len(rs.Attached)+len(rs.Detached))
+	for _, id := range rs.Detached {
+		skip[id] = struct{}{}
+	}
+	for i := range rs.Attached {
+		skip[rs.Attached[i].ConfigID] = struct{}{}
+	}
+
+	list := make([]shardconfig.ShardDetails, 0, len(s.ShardList)+len(rs.Attached))
+	for i := range s.ShardList {
+		if _, ok := skip[s.ShardList[i].ID()]; !ok {
+			list = append(list, s.ShardList[i])
+		}
+	}
+	for i := range rs.Attached {
+		sc, err := shardconfig.Decode(rs.Attached[i].Config)
+		if err != nil {
+			return fmt.Errorf("attached shard %s: %w", rs.Attached[i].ConfigID, err)
+		}
+		sc.Normalize(s.Default)
+		list = append(list, sc)
+	}
+
+	var (
+		shardNum int
+		paths    = make(map[string]pathDescription)
+	)
+	for i := range list {
+		if list[i].Mode == mode.Disabled {
+			continue
+		}
+		err = validateShard(&list[i], shardNum, paths)
+		if err != nil {
+			return fmt.Errorf("invalid storage configuration with runtime shards: %w", err)
+		}
+		shardNum++
+	}
+
+	s.ShardList = list
+	return nil
+}
+
+// AttachShard adds a new shard described by the given YAML configuration to
+// the storage engine. The shard is kept after restart.
+func (c *cfg) AttachShard(config []byte) (*shard.ID, error) {
+	sc, err := shardconfig.Decode(config)
+	if err != nil {
+		return nil, err
+	}
+	if sc.Mode == mode.Disabled {
+		return nil, errors.New("shard is disabled")
+	}
+
+	c.cfgObject.cfgLocalStorage.shardsMtx.Lock()
+	defer c.cfgObject.cfgLocalStorage.shardsMtx.Unlock()
+
+	sc.Normalize(c.appCfg.Storage.Default)
+
+	var (
+		shardNum int
+		paths    = make(map[string]pathDescription)
+	)
+	err = engineconfig.IterateShards(&c.appCfg.Storage, false, func(details *shardconfig.ShardDetails) error {
+		err := validateShard(details, shardNum, paths)
+		shardNum++
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid storage configuration: %w", err)
+	}
+	err = validateShard(&sc, shardNum, paths)
+	if err != nil {
+		return nil, fmt.Errorf("invalid shard configuration: %w", err)
+	}
+
+	opts, err := c.shardOptsFromConfig(&sc)
+	if err != nil {
+		return nil, err
+	}
+
+	ls := c.cfgObject.cfgLocalStorage.localStorage
+	id, err := ls.AttachShard(opts.shOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := loadRuntimeShards(c.persistate)
+	if err == nil {
+		rs.Detached = slices.DeleteFunc(rs.Detached, func(s string) bool { return s == opts.configID })
+		rs.Attached = slices.DeleteFunc(rs.Attached, func(a attachedShard) bool { return a.ConfigID == opts.configID })
+		rs.Attached = append(rs.Attached, attachedShard{ConfigID: opts.configID, Config: config})
+		err = saveRuntimeShards(c.persistate, rs)
+	}
+	if err != nil {
+		if dErr := ls.DetachShard(id); dErr != nil {
+			c.log.Error("could not detach shard after failed state update", zap.Stringer("id", id), zap.Error(dErr))
+		}
+		return nil, err
+	}
+
+	c.appCfg.Storage.ShardList = append(slices.DeleteFunc(c.appCfg.Storage.ShardList, func(s shardconfig.ShardDetails) bool {
+		return s.ID() == opts.configID
+	}), sc)
+
+	return id, nil
+}
+
+// DetachShard removes the shard from the storage engine. The shard is not
+// opened after restart.
+func (c *cfg) DetachShard(id *shard.ID) error {
+	c.cfgObject.cfgLocalStorage.shardsMtx.Lock()
+	defer c.cfgObject.cfgLocalStorage.shardsMtx.Unlock()
+
+	var (
+		ls       = c.cfgObject.cfgLocalStorage.localStorage
+		shards   = ls.DumpInfo().Shards
+		configID string
+	)
+	for i := range shards {
+		if shards[i].ID.String() == id.String() {
+			configID = shardconfig.ID(shards[i].BlobStorInfo.Path)
+			break
+		}
+	}
+	if configID == "" {
+		return fmt.Errorf("shard %s: %w", id, engine.ErrShardNotFound)
+	}
+	if len(shards) == 1 {
+		return errors.New("can't detach the last shard")
+	}
+
+	rs, err := loadRuntimeShards(c.persistate)
+	if err != nil {
+		return err
+	}
+
+	err = ls.DetachShard(id)
+	if err != nil {
+		return err
+	}
+
+	c.appCfg.Storage.ShardList = slices.DeleteFunc(c.appCfg.Storage.ShardList, func(s shardconfig.ShardDetails) bool {
+		return s.ID() == configID
+	})
+
+	n := len(rs.Attached)
+	rs.Attached = slices.DeleteFunc(rs.Attached, func(a attachedShard) bool { return a.ConfigID == configID })
+	if len(rs.Attached) == n && !slices.Contains(rs.Detached, configID) {
+		rs.Detached = append(rs.Detached, configID)
+	}
+
+	err = saveRuntimeShards(c.persistate, rs)
+	if err != nil {
+		return fmt.Errorf("shard is detached but will be opened after restart: %w", err)
+	}
+	return nil
+}
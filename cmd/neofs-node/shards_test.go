@@ -0,0 +1,81 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
+	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/util/state"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyRuntimeShards(t *testing.T) {
+	st, err := state.NewPersistentStorage(filepath.Join(t.TempDir(), "state"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = st.Close() })
+
+	newStorage := func() *engineconfig.Storage {
+		var s engineconfig.Storage
+		s.Default.Blobstor.Depth = 4
+		s.Default.WriteCache.Enabled = new(bool)
+		for _, p := range []string{"/srv/0", "/srv/1", "/srv/2"} {
+			var sc shardconfig.ShardDetails
+			sc.Blobstor.Type = fstree.Type
+			sc.Blobstor.Path = p + "/blob"
+			sc.Metabase.Path = p + "/meta"
+			s.ShardList = append(s.ShardList, sc)
+		}
+		s.Normalize()
+		return &s
+	}
+	ids := func(s *engineconfig.Storage) []string {
+		var res []string
+		for i := range s.ShardList {
+			res = append(res, s.ShardList[i].ID())
+		}
+		return res
+	}
+
+	s := newStorage()
+	require.NoError(t, applyRuntimeShards(s, st))
+	require.Equal(t, []string{"/srv/0/blob", "/srv/1/blob", "/srv/2/blob"}, ids(s))
+
+	require.NoError(t, saveRuntimeShards(st, runtimeShards{
+		Attached: []attachedShard{
+			{ConfigID: "/srv/3/blob", Config: []byte("mode: read-only\nblobstor:\n  type: fstree\n  path: /srv/3/blob\nmetabase:\n  path: /srv/3/meta\n")},
+			{ConfigID: "/srv/2/blob", Config: []byte("blobstor:\n  type: fstree\n  path: /srv/2/blob\n  depth: 3\nmetabase:\n  path: /srv/2/meta\n")},
+		},
+		Detached: []string{"/srv/1/blob"},
+	}))
+
+	s = newStorage()
+	require.NoError(t, applyRuntimeShards(s, st))
+	require.Equal(t, []string{"/srv/0/blob", "/srv/3/blob", "/srv/2/blob"}, ids(s))
+	require.Equal(t, mode.ReadOnly, s.ShardList[1].Mode)
+	require.Equal(t, s.Default.Blobstor.Depth, s.ShardList[1].Blobstor.Depth)
+	require.Equal(t, uint64(3), s.ShardList[2].Blobstor.Depth)
+
+	require.NoError(t, saveRuntimeShards(st, runtimeShards{}))
+	s = newStorage()
+	require.NoError(t, applyRuntimeShards(s, st))
+	require.Len(t, s.ShardList, 3)
+
+	t.Run("invalid config", func(t *testing.T) {
+		require.NoError(t, saveRuntimeShards(st, runtimeShards{
+			Attached: []attachedShard{{ConfigID: "/srv/3/blob", Config: []byte("unknown_key: 1\n")}},
+		}))
+		require.Error(t, applyRuntimeShards(newStorage(), st))
+	})
+
+	t.Run("conflicting paths", func(t *testing.T) {
+		require.NoError(t, saveRuntimeShards(st, runtimeShards{
+			Attached: []attachedShard{{ConfigID: "/srv/3/blob", Config: []byte("blobstor:\n  type: fstree\n  path: /srv/3/blob\nmetabase:\n  path: /srv/0/meta\n")}},
+		}))
+		s := newStorage()
+		require.Error(t, applyRuntimeShards(s, st))
+		require.Len(t, s.ShardList, 3)
+	})
+}
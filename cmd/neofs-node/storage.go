@@ -1,14 +1,24 @@
 package main
 
 import (
+	"crypto/ecdsa"
+	"fmt"
 	"time"
 
 	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
+	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	encryptionconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/encryption"
+	ioschedconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/iosched"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/peapod"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/router"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event/netmap"
@@ -30,6 +40,20 @@ func initLocalStorage(c *cfg) {
 		engine.WithObjectPutRetryTimeout(c.appCfg.Storage.PutRetryTimeout),
 		engine.WithContainersSource(c.cnrSrc),
 		engine.WithMetrics(c.metricsCollector),
+		engine.WithStateStorage(c.persistate),
+		engine.WithTiering(engine.TieringPolicy{
+			HotTier:      c.appCfg.Storage.Tiering.Hot,
+			ColdTier:     c.appCfg.Storage.Tiering.Cold,
+			DemoteAfter:  c.appCfg.Storage.Tiering.DemoteAfter,
+			PromoteReads: uint32(c.appCfg.Storage.Tiering.PromoteReads),
+			Interval:     c.appCfg.Storage.Tiering.Interval,
+		}),
+		engine.WithObjectCache(uint64(c.appCfg.Storage.ObjectCache.Capacity), uint64(c.appCfg.Storage.ObjectCache.MaxObjectSize)),
+		engine.WithSpacePolicy(engine.SpacePolicy{
+			NearFull: c.appCfg.Storage.Space.NearFullWatermark,
+			ReadOnly: c.appCfg.Storage.Space.ReadOnlyWatermark,
+			Interval: c.appCfg.Storage.Space.CheckInterval,
+		}),
 	}...)
 
 	addNewEpochAsyncNotificationHandler(c, func(ev event.Event) {
@@ -92,6 +116,89 @@ func initLocalStorage(c *cfg) {
 	})
 }
 
+// containerCompressionCodecs converts compression rules from the config
+// to the codec mapping.
+func containerCompressionCodecs(rules []shardconfig.CompressionRule) (map[cid.ID]string, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	res := make(map[cid.ID]string)
+	for i, rule := range rules {
+		if !compression.IsKnownCodec(rule.Codec) {
+			return nil, fmt.Errorf("compression rule #%d: unknown codec %q, expected one of %v", i, rule.Codec, compression.Codecs())
+		}
+		if len(rule.Containers) == 0 {
+			return nil, fmt.Errorf("compression rule #%d: no containers", i)
+		}
+		for _, s := range rule.Containers {
+			var cnr cid.ID
+			if err := cnr.DecodeString(s); err != nil {
+				return nil, fmt.Errorf("compression rule #%d: invalid container ID %q: %w", i, s, err)
+			}
+			if _, ok := res[cnr]; ok {
+				return nil, fmt.Errorf("compression rule #%d: duplicated container %s", i, cnr)
+			}
+			res[cnr] = rule.Codec
+		}
+	}
+
+	return res, nil
+}
+
+// shardEncryption creates cipher of the shard objects according to the
+// config. Key derived from the node key is used if no key file is specified,
+// it is also always accepted for decryption along with the old keys.
+func shardEncryption(encCfg encryptionconfig.Encryption, nodeKey *ecdsa.PrivateKey) (*encryption.Cipher, error) {
+	nodeDerived := encryption.DeriveKey(nodeKey)
+	old := []encryption.Key{nodeDerived}
+	for _, p := range encCfg.OldKeyFiles {
+		k, err := encryption.ReadKeyFile(p)
+		if err != nil {
+			return nil, fmt.Errorf("old encryption key: %w", err)
+		}
+		old = append(old, k)
+	}
+
+	var active *encryption.Key
+	if *encCfg.Enabled {
+		active = &nodeDerived
+		if encCfg.KeyFile != "" {
+			k, err := encryption.ReadKeyFile(encCfg.KeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("encryption key: %w", err)
+			}
+			active = &k
+		}
+	}
+
+	return encryption.New(active, old...)
+}
+
+// shardIOScheduler converts I/O scheduler configuration of the shard.
+func shardIOScheduler(cfg ioschedconfig.IOScheduler) iosched.Config {
+	classes := map[iosched.Class]ioschedconfig.Class{
+		iosched.Client:      cfg.Client,
+		iosched.Replication: cfg.Replication,
+		iosched.Flush:       cfg.Flush,
+		iosched.GC:          cfg.GC,
+		iosched.Scrub:       cfg.Scrub,
+	}
+
+	res := iosched.Config{
+		MaxConcurrent: cfg.MaxConcurrentOps,
+		Classes:       make(map[iosched.Class]iosched.Limits, len(classes)),
+	}
+	for c, l := range classes {
+		res.Classes[c] = iosched.Limits{
+			Weight:    uint32(l.Weight),
+			IOPS:      uint64(l.IOPSLimit),
+			Bandwidth: uint64(l.BandwidthLimit),
+		}
+	}
+	return res
+}
+
 type shardOptsWithID struct {
 	configID string
 	shOpts   []shard.Option
@@ -100,84 +207,122 @@ type shardOptsWithID struct {
 func (c *cfg) shardOpts() []shardOptsWithID {
 	shards := make([]shardOptsWithID, 0, len(c.appCfg.Storage.ShardList))
 
-	for _, shCfg := range c.appCfg.Storage.ShardList {
-		var (
-			wcMaxBatchSize      uint64
-			wcMaxBatchCount     int
-			wcMaxBatchThreshold uint64
+	for i := range c.appCfg.Storage.ShardList {
+		sh, err := c.shardOptsFromConfig(&c.appCfg.Storage.ShardList[i])
+		fatalOnErr(err)
+
+		shards = append(shards, sh)
+	}
+
+	return shards
+}
+
+// shardOptsFromConfig builds options of the shard from its configuration.
+func (c *cfg) shardOptsFromConfig(shCfg *shardconfig.ShardDetails) (shardOptsWithID, error) {
+	var (
+		wcMaxBatchSize      uint64
+		wcMaxBatchCount     int
+		wcMaxBatchThreshold uint64
+	)
+	var s common.Storage
+	sRead := shCfg.Blobstor
+	switch sRead.Type {
+	case fstree.Type:
+		wcMaxBatchSize = uint64(sRead.CombinedSizeLimit)
+		wcMaxBatchCount = sRead.CombinedCountLimit
+		wcMaxBatchThreshold = uint64(sRead.CombinedSizeThreshold)
+		s = fstree.New(
+			fstree.WithPath(sRead.Path),
+			fstree.WithPerm(sRead.Perm),
+			fstree.WithDepth(sRead.Depth),
+			fstree.WithNoSync(*sRead.NoSync),
+			fstree.WithCombinedCountLimit(sRead.CombinedCountLimit),
+			fstree.WithCombinedSizeLimit(int(sRead.CombinedSizeLimit)),
+			fstree.WithCombinedSizeThreshold(int(sRead.CombinedSizeThreshold)),
+			fstree.WithCombinedWriteInterval(sRead.FlushInterval))
+	default:
+		// should never happen, that has already
+		// been handled: when the config was read
+	}
+	if sRead.SmallObjects.Enabled() {
+		s = router.New(peapod.New(
+			peapod.WithPath(sRead.SmallObjects.Path),
+			peapod.WithPerm(sRead.Perm),
+			peapod.WithNoSync(*sRead.NoSync),
+			peapod.WithFlushInterval(sRead.FlushInterval),
+		), s, uint64(sRead.SmallObjects.MaxSize))
+	}
+
+	var writeCacheOpts []writecache.Option
+	if wcRead := shCfg.WriteCache; *wcRead.Enabled {
+		writeCacheOpts = append(writeCacheOpts,
+			writecache.WithPaths(wcRead.AllPaths()...),
+			writecache.WithMaxCacheSize(uint64(wcRead.Capacity)),
+			writecache.WithNoSync(*wcRead.NoSync),
+			writecache.WithLogger(c.log),
+			writecache.WithMaxFlushBatchSize(wcMaxBatchSize),
+			writecache.WithMaxFlushBatchCount(wcMaxBatchCount),
+			writecache.WithMaxFlushBatchThreshold(wcMaxBatchThreshold),
+			writecache.WithMaxPutDelay(wcRead.MaxPutDelay),
+			writecache.WithFlushLatencyTarget(wcRead.FlushLatencyTarget),
+			writecache.WithMetrics(c.metricsCollector),
 		)
-		var s common.Storage
-		sRead := shCfg.Blobstor
-		switch sRead.Type {
-		case fstree.Type:
-			wcMaxBatchSize = uint64(sRead.CombinedSizeLimit)
-			wcMaxBatchCount = sRead.CombinedCountLimit
-			wcMaxBatchThreshold = uint64(sRead.CombinedSizeThreshold)
-			s = fstree.New(
-				fstree.WithPath(sRead.Path),
-				fstree.WithPerm(sRead.Perm),
-				fstree.WithDepth(sRead.Depth),
-				fstree.WithNoSync(*sRead.NoSync),
-				fstree.WithCombinedCountLimit(sRead.CombinedCountLimit),
-				fstree.WithCombinedSizeLimit(int(sRead.CombinedSizeLimit)),
-				fstree.WithCombinedSizeThreshold(int(sRead.CombinedSizeThreshold)),
-				fstree.WithCombinedWriteInterval(sRead.FlushInterval))
-		default:
-			// should never happen, that has already
-			// been handled: when the config was read
-		}
+	}
 
-		var writeCacheOpts []writecache.Option
-		if wcRead := shCfg.WriteCache; *wcRead.Enabled {
-			writeCacheOpts = append(writeCacheOpts,
-				writecache.WithPath(wcRead.Path),
-				writecache.WithMaxCacheSize(uint64(wcRead.Capacity)),
-				writecache.WithNoSync(*wcRead.NoSync),
-				writecache.WithLogger(c.log),
-				writecache.WithMaxFlushBatchSize(wcMaxBatchSize),
-				writecache.WithMaxFlushBatchCount(wcMaxBatchCount),
-				writecache.WithMaxFlushBatchThreshold(wcMaxBatchThreshold),
-				writecache.WithMetrics(c.metricsCollector),
-			)
-		}
+	// rules have already been checked when the config was read
+	cnrCodecs, _ := containerCompressionCodecs(shCfg.CompressionRules)
 
-		var sh shardOptsWithID
-		sh.configID = shCfg.ID()
-		sh.shOpts = []shard.Option{
-			shard.WithLogger(c.log),
-			shard.WithResyncMetabase(*shCfg.ResyncMetabase),
-			shard.WithMode(shCfg.Mode),
-			shard.WithCompressObjects(*shCfg.Compress),
-			shard.WithUncompressableContentTypes(shCfg.CompressionExcludeContentTypes),
-			shard.WithBlobstor(s),
-			shard.WithMetaBaseOptions(
-				meta.WithPath(shCfg.Metabase.Path),
-				meta.WithPermissions(shCfg.Metabase.Perm),
-				meta.WithMaxBatchSize(int(shCfg.Metabase.MaxBatchSize)),
-				meta.WithMaxBatchDelay(shCfg.Metabase.MaxBatchDelay),
-				meta.WithBoltDBOptions(&bbolt.Options{
-					Timeout: time.Second,
-				}),
-
-				meta.WithLogger(c.log),
-				meta.WithEpochState(c.cfgNetmap.state),
-				meta.WithContainers(containerPresenceChecker{src: c.cnrSrc}),
-				meta.WithInitContext(c.ctx),
-			),
-			shard.WithWriteCache(*shCfg.WriteCache.Enabled),
-			shard.WithWriteCacheOptions(writeCacheOpts...),
-			shard.WithRemoverBatchSize(int(shCfg.GC.RemoverBatchSize)),
-			shard.WithGCRemoverSleepInterval(shCfg.GC.RemoverSleepInterval),
-			shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
-				pool, err := ants.NewPool(sz)
-				fatalOnErr(err)
-
-				return pool
+	cipher, err := shardEncryption(shCfg.Encryption, &c.key.PrivateKey)
+	if err != nil {
+		return shardOptsWithID{}, err
+	}
+
+	var sh shardOptsWithID
+	sh.configID = shCfg.ID()
+	sh.shOpts = []shard.Option{
+		shard.WithLogger(c.log),
+		shard.WithResyncMetabase(*shCfg.ResyncMetabase),
+		shard.WithResyncMetabaseWorkers(shCfg.ResyncMetabaseWorkers),
+		shard.WithMode(shCfg.Mode),
+		shard.WithTier(shCfg.Tier),
+		shard.WithCompressObjects(*shCfg.Compress),
+		shard.WithUncompressableContentTypes(shCfg.CompressionExcludeContentTypes),
+		shard.WithCompressionCodec(shCfg.CompressionCodec),
+		shard.WithContainerCompressionCodecs(cnrCodecs),
+		shard.WithCompressionPolicy(containerCompressionPolicy(c.cnrSrc)),
+		shard.WithCompressionSampleSize(int(shCfg.CompressionSampleSize)),
+		shard.WithCompressionMinSavingRatio(shCfg.CompressionMinSavingRatio),
+		shard.WithEncryption(cipher),
+		shard.WithBlobstor(s),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(shCfg.Metabase.Path),
+			meta.WithPermissions(shCfg.Metabase.Perm),
+			meta.WithMaxBatchSize(int(shCfg.Metabase.MaxBatchSize)),
+			meta.WithMaxBatchDelay(shCfg.Metabase.MaxBatchDelay),
+			meta.WithBoltDBOptions(&bbolt.Options{
+				Timeout: time.Second,
 			}),
-		}
 
-		shards = append(shards, sh)
+			meta.WithLogger(c.log),
+			meta.WithEpochState(c.cfgNetmap.state),
+			meta.WithContainers(containerPresenceChecker{src: c.cnrSrc}),
+			meta.WithInitContext(c.ctx),
+		),
+		shard.WithScrubInterval(shCfg.Metabase.ScrubInterval),
+		shard.WithPayloadScrubRate(uint64(shCfg.Blobstor.ScrubRate)),
+		shard.WithQuarantinePath(shCfg.Blobstor.QuarantinePath),
+		shard.WithWriteCache(*shCfg.WriteCache.Enabled),
+		shard.WithWriteCacheOptions(writeCacheOpts...),
+		shard.WithRemoverBatchSize(int(shCfg.GC.RemoverBatchSize)),
+		shard.WithGCRemoverSleepInterval(shCfg.GC.RemoverSleepInterval),
+		shard.WithIOScheduler(shardIOScheduler(shCfg.IOScheduler)),
+		shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
+			pool, err := ants.NewPool(sz)
+			fatalOnErr(err)
+
+			return pool
+		}),
 	}
 
-	return shards
+	return sh, nil
 }
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/nspcc-dev/neofs-node/misc"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	"go.uber.org/zap"
+)
+
+const tracingName = "tracing"
+
+func initTracing(c *cfg) {
+	if !c.appCfg.Tracing.Enabled {
+		c.log.Info("tracing is disabled")
+		return
+	}
+
+	shutdown, err := tracing.Setup(c.ctx, tracing.Config{
+		Endpoint:      c.appCfg.Tracing.Endpoint,
+		Insecure:      c.appCfg.Tracing.Insecure,
+		SamplingRatio: c.appCfg.Tracing.SamplingRatio,
+		Service:       "neofs-node",
+		InstanceID:    hex.EncodeToString(c.binPublicKey),
+		Version:       misc.Version,
+	})
+	fatalOnErrDetails("init tracing", err)
+
+	c.log.Info("tracing is enabled", zap.String("endpoint", c.appCfg.Tracing.Endpoint))
+
+	c.veryLastClosers[tracingName] = func() {
+		// c.ctx is already canceled here
+		err := shutdown(context.Background())
+		if err != nil {
+			c.log.Debug("could not stop tracing", zap.Error(err))
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"go.uber.org/zap/zapcore"
 )
@@ -38,6 +39,17 @@ func validateConfig(c *config.Config) error {
 		return errors.New("no FS chain RPC endpoints, see `fschain.endpoints` section")
 	}
 
+	// tracing configuration validation
+
+	if c.Tracing.Enabled {
+		if c.Tracing.Endpoint == "" {
+			return errors.New("tracing is enabled, but no exporter endpoint is set, see `tracing.endpoint`")
+		}
+		if c.Tracing.SamplingRatio > 1 {
+			return fmt.Errorf("invalid tracing sampling ratio %v, must be in (0, 1]", c.Tracing.SamplingRatio)
+		}
+	}
+
 	// grpc configuration validation
 
 	if len(c.GRPC) == 0 {
@@ -62,43 +74,90 @@ func validateConfig(c *config.Config) error {
 	shardNum := 0
 	paths := make(map[string]pathDescription)
 	return engineconfig.IterateShards(&c.Storage, false, func(sc *shardconfig.ShardDetails) error {
-		if !sc.Mode.IsValid() {
-			return fmt.Errorf("unknown shard mode: %s (shard %d)", sc.Mode, shardNum)
+		err := validateShard(sc, shardNum, paths)
+		if err != nil {
+			return err
+		}
+
+		shardNum++
+		return nil
+	})
+}
+
+// validateShard checks configuration of the shard with the given number.
+// Paths of the shard components are added to paths, they must not be used by
+// other shards.
+func validateShard(sc *shardconfig.ShardDetails, shardNum int, paths map[string]pathDescription) error {
+	var err error
+
+	if !sc.Mode.IsValid() {
+		return fmt.Errorf("unknown shard mode: %s (shard %d)", sc.Mode, shardNum)
+	}
+	if sc.CompressionCodec != "" && !compression.IsKnownCodec(sc.CompressionCodec) {
+		return fmt.Errorf("unknown compression codec: %s, expected one of %v (shard %d)",
+			sc.CompressionCodec, compression.Codecs(), shardNum)
+	}
+	if _, err := containerCompressionCodecs(sc.CompressionRules); err != nil {
+		return fmt.Errorf("%w (shard %d)", err, shardNum)
+	}
+	if sc.CompressionMinSavingRatio < 0 || sc.CompressionMinSavingRatio >= 1 {
+		return fmt.Errorf("compression min saving ratio must be in [0, 1) range, got %v (shard %d)",
+			sc.CompressionMinSavingRatio, shardNum)
+	}
+	for _, p := range sc.Encryption.OldKeyFiles {
+		if p == "" {
+			return fmt.Errorf("empty old encryption key file path (shard %d)", shardNum)
+		}
+		if p == sc.Encryption.KeyFile {
+			return fmt.Errorf("encryption key file %s is also listed as an old one (shard %d)", p, shardNum)
 		}
-		if *sc.WriteCache.Enabled {
-			err = addPath(paths, "writecache", shardNum, sc.WriteCache.Path)
+	}
+	if *sc.WriteCache.Enabled {
+		for _, p := range sc.WriteCache.AllPaths() {
+			err = addPath(paths, "writecache", shardNum, p)
 			if err != nil {
 				return err
 			}
 		}
+	}
 
-		if err = addPath(paths, "metabase", shardNum, sc.Metabase.Path); err != nil {
-			return err
-		}
+	if err = addPath(paths, "metabase", shardNum, sc.Metabase.Path); err != nil {
+		return err
+	}
 
-		blobstor := sc.Blobstor
-		switch blobstor.Type {
-		case fstree.Type:
-		default:
-			return fmt.Errorf("unexpected storage type: %s (shard %d)",
-				blobstor.Type, shardNum)
-		}
-		if blobstor.Perm&0o600 != 0o600 {
-			return fmt.Errorf("invalid permissions for blobstor component: %s, "+
-				"expected at least rw- for the owner (shard %d)",
-				blobstor.Perm, shardNum)
-		}
-		if blobstor.Path == "" {
-			return fmt.Errorf("blobstor component path is empty (shard %d)", shardNum)
+	blobstor := sc.Blobstor
+	switch blobstor.Type {
+	case fstree.Type:
+	default:
+		return fmt.Errorf("unexpected storage type: %s (shard %d)",
+			blobstor.Type, shardNum)
+	}
+	if blobstor.Perm&0o600 != 0o600 {
+		return fmt.Errorf("invalid permissions for blobstor component: %s, "+
+			"expected at least rw- for the owner (shard %d)",
+			blobstor.Perm, shardNum)
+	}
+	if blobstor.Path == "" {
+		return fmt.Errorf("blobstor component path is empty (shard %d)", shardNum)
+	}
+	err = addPath(paths, "blobstor", shardNum, blobstor.Path)
+	if err != nil {
+		return err
+	}
+	if blobstor.QuarantinePath != "" {
+		err = addPath(paths, "quarantine", shardNum, blobstor.QuarantinePath)
+		if err != nil {
+			return err
 		}
-		err = addPath(paths, "blobstor", shardNum, blobstor.Path)
+	}
+	if blobstor.SmallObjects.Enabled() {
+		err = addPath(paths, "peapod", shardNum, blobstor.SmallObjects.Path)
 		if err != nil {
 			return err
 		}
+	}
 
-		shardNum++
-		return nil
-	})
+	return nil
 }
 
 type pathDescription struct {
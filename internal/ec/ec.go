@@ -0,0 +1,199 @@
+package ec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/reedsolomon"
+)
+
+// MaxPartNum is the maximum total number of data and parity parts supported
+// by a [Rule].
+const MaxPartNum = 256
+
+// Rule describes Reed-Solomon erasure coding parameters: the object payload is
+// split into DataPartNum data parts, and ParityPartNum parity parts are
+// calculated in addition. Any DataPartNum parts are enough to recover the
+// payload.
+type Rule struct {
+	DataPartNum   int
+	ParityPartNum int
+}
+
+// TotalPartNum returns total number of parts produced by r.
+func (r Rule) TotalPartNum() int {
+	return r.DataPartNum + r.ParityPartNum
+}
+
+// String returns string representation of r in 'DATA/PARITY' format. String
+// is the inverse of [DecodeRule].
+func (r Rule) String() string {
+	return strconv.Itoa(r.DataPartNum) + "/" + strconv.Itoa(r.ParityPartNum)
+}
+
+// verify checks whether r can be used for coding.
+func (r Rule) verify() error {
+	switch {
+	case r.DataPartNum <= 0:
+		return fmt.Errorf("non-positive number of data parts %d", r.DataPartNum)
+	case r.ParityPartNum <= 0:
+		return fmt.Errorf("non-positive number of parity parts %d", r.ParityPartNum)
+	case r.TotalPartNum() > MaxPartNum:
+		return fmt.Errorf("total number of parts %d exceeds the limit %d", r.TotalPartNum(), MaxPartNum)
+	}
+	return nil
+}
+
+// DecodeRule decodes Rule from the 'DATA/PARITY' string, e.g. '3/2'.
+func DecodeRule(s string) (Rule, error) {
+	dataStr, parityStr, ok := strings.Cut(s, "/")
+	if !ok {
+		return Rule{}, fmt.Errorf("missing '/' separator in %q", s)
+	}
+
+	var r Rule
+	var err error
+	if r.DataPartNum, err = strconv.Atoi(dataStr); err != nil {
+		return Rule{}, fmt.Errorf("invalid number of data parts: %w", err)
+	}
+	if r.ParityPartNum, err = strconv.Atoi(parityStr); err != nil {
+		return Rule{}, fmt.Errorf("invalid number of parity parts: %w", err)
+	}
+	if err = r.verify(); err != nil {
+		return Rule{}, err
+	}
+
+	return r, nil
+}
+
+// Encode splits data into [Rule.DataPartNum] data parts of equal size
+// (padding the last one with zeros) and calculates [Rule.ParityPartNum] parity
+// parts for them. Resulting slice has [Rule.TotalPartNum] elements, data parts
+// go first.
+func Encode(rule Rule, data []byte) ([][]byte, error) {
+	if err := rule.verify(); err != nil {
+		return nil, fmt.Errorf("invalid rule: %w", err)
+	}
+
+	if len(data) == 0 {
+		// reedsolomon does not support empty data, so all parts are empty
+		parts := make([][]byte, rule.TotalPartNum())
+		for i := range parts {
+			parts[i] = []byte{}
+		}
+		return parts, nil
+	}
+
+	enc, err := reedsolomon.New(rule.DataPartNum, rule.ParityPartNum)
+	if err != nil {
+		return nil, fmt.Errorf("init Reed-Solomon encoder: %w", err)
+	}
+
+	// Split does not copy data when its capacity is enough, clone to not corrupt
+	// the caller's buffer
+	parts, err := enc.Split(bytes.Clone(data))
+	if err != nil {
+		return nil, fmt.Errorf("split data: %w", err)
+	}
+
+	if err = enc.Encode(parts); err != nil {
+		return nil, fmt.Errorf("calculate parity parts: %w", err)
+	}
+
+	return parts, nil
+}
+
+// ErrNotEnoughParts is returned by [Decode] when less than [Rule.DataPartNum]
+// parts are available.
+var ErrNotEnoughParts = errors.New("not enough parts")
+
+// Decode restores data of the given size from parts produced by [Encode].
+// Missing parts must be nil, at least [Rule.DataPartNum] parts must be
+// present. Decode may modify parts.
+func Decode(rule Rule, size uint64, parts [][]byte) ([]byte, error) {
+	if err := rule.verify(); err != nil {
+		return nil, fmt.Errorf("invalid rule: %w", err)
+	}
+
+	if len(parts) != rule.TotalPartNum() {
+		return nil, fmt.Errorf("wrong number of parts %d, expected %d", len(parts), rule.TotalPartNum())
+	}
+
+	var present int
+	for i := range parts {
+		if parts[i] != nil {
+			present++
+		}
+	}
+	if present < rule.DataPartNum {
+		return nil, fmt.Errorf("%w: %d < %d", ErrNotEnoughParts, present, rule.DataPartNum)
+	}
+
+	if size == 0 {
+		return []byte{}, nil
+	}
+
+	enc, err := reedsolomon.New(rule.DataPartNum, rule.ParityPartNum)
+	if err != nil {
+		return nil, fmt.Errorf("init Reed-Solomon decoder: %w", err)
+	}
+
+	if err = enc.ReconstructData(parts); err != nil {
+		return nil, fmt.Errorf("reconstruct data parts: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(int(size))
+	if err = enc.Join(&buf, parts, int(size)); err != nil {
+		return nil, fmt.Errorf("join data parts: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Reconstruct restores all missing (nil) parts produced by [Encode]. At least
+// [Rule.DataPartNum] parts must be present. Reconstruct modifies parts in
+// place.
+func Reconstruct(rule Rule, parts [][]byte) error {
+	if err := rule.verify(); err != nil {
+		return fmt.Errorf("invalid rule: %w", err)
+	}
+
+	if len(parts) != rule.TotalPartNum() {
+		return fmt.Errorf("wrong number of parts %d, expected %d", len(parts), rule.TotalPartNum())
+	}
+
+	var present, size int
+	for i := range parts {
+		if parts[i] != nil {
+			present++
+			size = len(parts[i])
+		}
+	}
+	if present < rule.DataPartNum {
+		return fmt.Errorf("%w: %d < %d", ErrNotEnoughParts, present, rule.DataPartNum)
+	}
+
+	if size == 0 {
+		for i := range parts {
+			if parts[i] == nil {
+				parts[i] = []byte{}
+			}
+		}
+		return nil
+	}
+
+	enc, err := reedsolomon.New(rule.DataPartNum, rule.ParityPartNum)
+	if err != nil {
+		return fmt.Errorf("init Reed-Solomon decoder: %w", err)
+	}
+
+	if err = enc.Reconstruct(parts); err != nil {
+		return fmt.Errorf("reconstruct parts: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package ec_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/internal/ec"
+	"github.com/nspcc-dev/neofs-node/internal/testutil"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRule(t *testing.T) {
+	r, err := ec.DecodeRule("3/2")
+	require.NoError(t, err)
+	require.Equal(t, ec.Rule{DataPartNum: 3, ParityPartNum: 2}, r)
+	require.Equal(t, 5, r.TotalPartNum())
+	require.Equal(t, "3/2", r.String())
+
+	for _, s := range []string{
+		"", "3", "3/", "/2", "a/2", "3/b", "0/2", "3/0", "-1/2", "200/100",
+	} {
+		_, err := ec.DecodeRule(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	rule := ec.Rule{DataPartNum: 4, ParityPartNum: 2}
+
+	for _, ln := range []int{0, 1, 3, 4, 1023, 4096} {
+		t.Run(fmt.Sprintf("len=%d", ln), func(t *testing.T) {
+			data := testutil.RandByteSlice(ln)
+
+			parts, err := ec.Encode(rule, data)
+			require.NoError(t, err)
+			require.Len(t, parts, rule.TotalPartNum())
+
+			res, err := ec.Decode(rule, uint64(ln), parts)
+			require.NoError(t, err)
+			require.Equal(t, data, res)
+
+			for i := range rule.ParityPartNum {
+				parts[i*2] = nil
+			}
+
+			res, err = ec.Decode(rule, uint64(ln), parts)
+			require.NoError(t, err)
+			require.Equal(t, data, res)
+
+			// Decode restores missing data parts in place
+			parts[0], parts[1], parts[2] = nil, nil, nil
+			_, err = ec.Decode(rule, uint64(ln), parts)
+			require.ErrorIs(t, err, ec.ErrNotEnoughParts)
+		})
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	rule := ec.Rule{DataPartNum: 3, ParityPartNum: 2}
+	data := testutil.RandByteSlice(1000)
+
+	parts, err := ec.Encode(rule, data)
+	require.NoError(t, err)
+
+	broken := make([][]byte, len(parts))
+	copy(broken, parts)
+	broken[0], broken[4] = nil, nil
+
+	require.NoError(t, ec.Reconstruct(rule, broken))
+	require.Equal(t, parts, broken)
+
+	broken[0], broken[1], broken[2] = nil, nil, nil
+	require.ErrorIs(t, ec.Reconstruct(rule, broken), ec.ErrNotEnoughParts)
+}
+
+func newParent(t *testing.T, payload []byte) object.Object {
+	var obj object.Object
+	obj.SetContainerID(cidtest.ID())
+	obj.SetAttributes(
+		object.NewAttribute("any", "attr"),
+		object.NewAttribute(object.AttributeExpirationEpoch, "100"),
+	)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+
+	usr := usertest.User()
+	obj.SetOwner(usr.UserID())
+	require.NoError(t, obj.SetVerificationFields(usr))
+
+	return obj
+}
+
+func TestFormObjectForPart(t *testing.T) {
+	rule := ec.Rule{DataPartNum: 3, ParityPartNum: 1}
+	parent := newParent(t, testutil.RandByteSlice(2048))
+	signer := usertest.User()
+
+	parts, err := ec.Encode(rule, parent.Payload())
+	require.NoError(t, err)
+
+	objs := make([]*object.Object, len(parts))
+	for i := range parts {
+		obj, err := ec.FormObjectForPart(signer, parent, rule, i, parts[i], 10, true)
+		require.NoError(t, err)
+		require.NoError(t, obj.VerifyID())
+		require.True(t, obj.VerifySignature())
+		require.Equal(t, signer.UserID(), obj.Owner())
+		require.Equal(t, parent.GetContainerID(), obj.GetContainerID())
+		require.EqualValues(t, 10, obj.CreationEpoch())
+		_, ok := obj.PayloadHomomorphicHash()
+		require.True(t, ok)
+		require.True(t, ec.IsPart(obj))
+
+		info, ok, err := ec.GetPartInfo(obj)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, ec.PartInfo{Parent: parent.GetID(), Rule: rule, Index: i}, info)
+
+		exp, ok := attribute(obj, object.AttributeExpirationEpoch)
+		require.True(t, ok)
+		require.Equal(t, "100", exp)
+
+		hdr, part, err := ec.DecodePartPayload(obj)
+		require.NoError(t, err)
+		require.Equal(t, *parent.CutPayload(), hdr)
+		require.Equal(t, parts[i], part)
+
+		objs[i] = &obj
+	}
+
+	res, err := ec.JoinParts(rule, objs)
+	require.NoError(t, err)
+	require.Equal(t, parent, *res)
+
+	objs[1] = nil
+	res, err = ec.JoinParts(rule, objs)
+	require.NoError(t, err)
+	require.Equal(t, parent, *res)
+
+	objs[2] = nil
+	_, err = ec.JoinParts(rule, objs)
+	require.ErrorIs(t, err, ec.ErrNotEnoughParts)
+
+	t.Run("not a part", func(t *testing.T) {
+		_, ok, err := ec.GetPartInfo(parent)
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.False(t, ec.IsPart(parent))
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		obj, err := ec.FormObjectForPart(signer, parent, rule, 0, parts[0], 10, false)
+		require.NoError(t, err)
+		pld := obj.Payload()
+		pld[len(pld)-1]++
+
+		objs := make([]*object.Object, len(parts))
+		objs[0] = &obj
+		for i := 1; i < rule.DataPartNum; i++ {
+			o, err := ec.FormObjectForPart(signer, parent, rule, i, parts[i], 10, false)
+			require.NoError(t, err)
+			objs[i] = &o
+		}
+
+		_, err = ec.JoinParts(rule, objs)
+		require.EqualError(t, err, "restored payload checksum mismatch")
+	})
+}
+
+func attribute(obj object.Object, key string) (string, bool) {
+	for _, a := range obj.Attributes() {
+		if a.Key() == key {
+			return a.Value(), true
+		}
+	}
+	return "", false
+}
+
+func TestPartNodes(t *testing.T) {
+	nodes := make([]netmap.NodeInfo, 4)
+	for i := range nodes {
+		nodes[i].SetPublicKey([]byte{byte(i)})
+	}
+
+	res := ec.PartNodes([][]netmap.NodeInfo{
+		{nodes[2], nodes[0]},
+		{nodes[0], nodes[3], nodes[1]},
+		{nodes[2]},
+	})
+	require.Equal(t, []netmap.NodeInfo{nodes[2], nodes[0], nodes[3], nodes[1]}, res)
+}
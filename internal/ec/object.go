@@ -0,0 +1,295 @@
+package ec
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// Object and container attributes used for erasure coding.
+const (
+	// ContainerAttributeRule is a container attribute enabling erasure coding
+	// for the container's regular objects. Value format is described in
+	// [DecodeRule].
+	ContainerAttributeRule = "__NEOFS__EC_RULE"
+
+	// AttributeParent is an attribute of the part object holding string ID of
+	// the original object.
+	AttributeParent = "__NEOFS__EC_PARENT"
+	// AttributeRule is an attribute of the part object holding [Rule] used to
+	// produce it.
+	AttributeRule = "__NEOFS__EC_RULE"
+	// AttributePartIdx is an attribute of the part object holding its index in
+	// the [Encode] result.
+	AttributePartIdx = "__NEOFS__EC_PART_IDX"
+)
+
+// parentHdrLenSize is a size of the parent header length prefix in the part
+// object payload.
+const parentHdrLenSize = 4
+
+// RuleFromContainer returns erasure coding rule set in the given container.
+// The second value is false if container has no rule.
+func RuleFromContainer(cnr container.Container) (Rule, bool, error) {
+	s := cnr.Attribute(ContainerAttributeRule)
+	if s == "" {
+		return Rule{}, false, nil
+	}
+
+	r, err := DecodeRule(s)
+	if err != nil {
+		return Rule{}, false, fmt.Errorf("invalid %s container attribute: %w", ContainerAttributeRule, err)
+	}
+
+	return r, true, nil
+}
+
+// PartInfo describes erasure-coded part of some object.
+type PartInfo struct {
+	// ID of the original object.
+	Parent oid.ID
+	// Rule used to encode the original object.
+	Rule Rule
+	// Index of the part in the [Encode] result.
+	Index int
+}
+
+// GetPartInfo reads [PartInfo] from attributes of the given object. The
+// second value is false if the object is not an erasure-coded part.
+func GetPartInfo(obj object.Object) (PartInfo, bool, error) {
+	var res PartInfo
+	var parentSet, ruleSet, idxSet bool
+	var err error
+
+	for _, a := range obj.Attributes() {
+		switch a.Key() {
+		case AttributeParent:
+			if err = res.Parent.DecodeString(a.Value()); err != nil {
+				return res, false, fmt.Errorf("invalid %s attribute: %w", AttributeParent, err)
+			}
+			parentSet = true
+		case AttributeRule:
+			if res.Rule, err = DecodeRule(a.Value()); err != nil {
+				return res, false, fmt.Errorf("invalid %s attribute: %w", AttributeRule, err)
+			}
+			ruleSet = true
+		case AttributePartIdx:
+			if res.Index, err = strconv.Atoi(a.Value()); err != nil {
+				return res, false, fmt.Errorf("invalid %s attribute: %w", AttributePartIdx, err)
+			}
+			idxSet = true
+		}
+	}
+
+	if !parentSet && !ruleSet && !idxSet {
+		return res, false, nil
+	}
+
+	switch {
+	case !parentSet:
+		return res, false, fmt.Errorf("missing %s attribute", AttributeParent)
+	case !ruleSet:
+		return res, false, fmt.Errorf("missing %s attribute", AttributeRule)
+	case !idxSet:
+		return res, false, fmt.Errorf("missing %s attribute", AttributePartIdx)
+	case res.Index < 0 || res.Index >= res.Rule.TotalPartNum():
+		return res, false, fmt.Errorf("part index %d is out of rule %s range", res.Index, res.Rule)
+	}
+
+	return res, true, nil
+}
+
+// IsPart checks whether given object is an erasure-coded part of another
+// object.
+func IsPart(obj object.Object) bool {
+	for _, a := range obj.Attributes() {
+		switch a.Key() {
+		case AttributeParent, AttributePartIdx:
+			return true
+		}
+	}
+	return false
+}
+
+// FormObjectForPart forms signed object carrying idx-th erasure-coded part of
+// the parent object. The part object is owned and signed by the given signer,
+// it also carries parent header (without payload) to serve its HEAD requests.
+// Expiration of the parent is inherited by the part. Homomorphic payload
+// checksum is calculated only when homomorphic is set.
+func FormObjectForPart(signer user.Signer, parent object.Object, rule Rule, idx int, part []byte, epoch uint64, homomorphic bool) (object.Object, error) {
+	parentHdr := parent.CutPayload().Marshal()
+
+	payload := make([]byte, parentHdrLenSize+len(parentHdr)+len(part))
+	binary.BigEndian.PutUint32(payload, uint32(len(parentHdr)))
+	copy(payload[parentHdrLenSize:], parentHdr)
+	copy(payload[parentHdrLenSize+len(parentHdr):], part)
+
+	attrs := []object.Attribute{
+		object.NewAttribute(AttributeParent, parent.GetID().EncodeToString()),
+		object.NewAttribute(AttributeRule, rule.String()),
+		object.NewAttribute(AttributePartIdx, strconv.Itoa(idx)),
+	}
+	for _, a := range parent.Attributes() {
+		if a.Key() == object.AttributeExpirationEpoch {
+			attrs = append(attrs, a)
+			break
+		}
+	}
+
+	var obj object.Object
+	ver := version.Current()
+	obj.SetVersion(&ver)
+	obj.SetContainerID(parent.GetContainerID())
+	obj.SetOwner(signer.UserID())
+	obj.SetCreationEpoch(epoch)
+	obj.SetType(object.TypeRegular)
+	obj.SetAttributes(attrs...)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	if homomorphic {
+		obj.SetPayloadHomomorphicHash(checksum.NewTillichZemor(tz.Sum(payload)))
+	}
+
+	if err := obj.SetVerificationFields(signer); err != nil {
+		return object.Object{}, fmt.Errorf("sign part object: %w", err)
+	}
+
+	return obj, nil
+}
+
+// DecodePartPayload splits payload of the part object formed by
+// [FormObjectForPart] into parent header and the part itself.
+func DecodePartPayload(obj object.Object) (object.Object, []byte, error) {
+	var parent object.Object
+
+	payload := obj.Payload()
+	if len(payload) < parentHdrLenSize {
+		return parent, nil, errors.New("too short payload")
+	}
+
+	hdrLen := binary.BigEndian.Uint32(payload)
+	if uint64(len(payload)-parentHdrLenSize) < uint64(hdrLen) {
+		return parent, nil, fmt.Errorf("parent header length %d overflows payload", hdrLen)
+	}
+
+	if err := parent.Unmarshal(payload[parentHdrLenSize : parentHdrLenSize+hdrLen]); err != nil {
+		return parent, nil, fmt.Errorf("decode parent header: %w", err)
+	}
+
+	return parent, payload[parentHdrLenSize+hdrLen:], nil
+}
+
+// PartNodes returns nodes sorted for the original object by the container's
+// storage policy in the order parts of the object are placed. Nodes repeated
+// in several lists are included only once. First [Rule.TotalPartNum] nodes are
+// primary part holders, others are reserve.
+func PartNodes(sorted [][]netmap.NodeInfo) []netmap.NodeInfo {
+	var n int
+	for i := range sorted {
+		n += len(sorted[i])
+	}
+
+	res := make([]netmap.NodeInfo, 0, n)
+	seen := make(map[string]struct{}, n)
+	for i := range sorted {
+		for j := range sorted[i] {
+			k := string(sorted[i][j].PublicKey())
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			res = append(res, sorted[i][j])
+		}
+	}
+
+	return res
+}
+
+// JoinParts restores original object from its erasure-coded parts. Parts must
+// be indexed according to [PartInfo.Index], missing parts must be nil. At
+// least [Rule.DataPartNum] parts are required. Restored object is checked
+// against parent header's ID and payload checksum.
+func JoinParts(rule Rule, parts []*object.Object) (*object.Object, error) {
+	if len(parts) != rule.TotalPartNum() {
+		return nil, fmt.Errorf("wrong number of parts %d, expected %d", len(parts), rule.TotalPartNum())
+	}
+
+	var parent *object.Object
+	shards := make([][]byte, len(parts))
+	for i := range parts {
+		if parts[i] == nil {
+			continue
+		}
+
+		hdr, shard, err := DecodePartPayload(*parts[i])
+		if err != nil {
+			return nil, fmt.Errorf("decode part #%d: %w", i, err)
+		}
+
+		if parent == nil {
+			parent = &hdr
+		} else if hdr.GetID() != parent.GetID() {
+			return nil, fmt.Errorf("part #%d has different parent %s != %s", i, hdr.GetID(), parent.GetID())
+		}
+
+		shards[i] = shard
+	}
+
+	if parent == nil {
+		return nil, fmt.Errorf("%w: 0 < %d", ErrNotEnoughParts, rule.DataPartNum)
+	}
+
+	payload, err := Decode(rule, parent.PayloadSize(), shards)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, ok := parent.PayloadChecksum()
+	if !ok {
+		return nil, errors.New("missing payload checksum in parent header")
+	}
+
+	if err = verifyPayloadChecksum(cs, payload); err != nil {
+		return nil, err
+	}
+
+	if err = parent.VerifyID(); err != nil {
+		return nil, fmt.Errorf("invalid parent header: %w", err)
+	}
+
+	parent.SetPayload(payload)
+
+	return parent, nil
+}
+
+func verifyPayloadChecksum(cs checksum.Checksum, payload []byte) error {
+	var exp checksum.Checksum
+
+	//nolint:exhaustive
+	switch cs.Type() {
+	case checksum.SHA256:
+		exp = checksum.NewSHA256(sha256.Sum256(payload))
+	case checksum.TillichZemor:
+		exp = checksum.NewTillichZemor(tz.Sum(payload))
+	default:
+		return fmt.Errorf("unsupported payload checksum type %v", cs.Type())
+	}
+
+	if !bytes.Equal(exp.Value(), cs.Value()) {
+		return errors.New("restored payload checksum mismatch")
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// Package quota provides storage quotas limiting space used by containers and
+// their owners.
+package quota
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+)
+
+// Container attributes setting storage quotas. Values are decimal numbers of
+// bytes, zero or missing attribute means no limit.
+const (
+	// AttributeContainerSoft is a soft limit of the space used by the
+	// container. Exceeding it is reported, but objects are still accepted.
+	AttributeContainerSoft = "__NEOFS__QUOTA_SOFT"
+	// AttributeContainerHard is a hard limit of the space used by the
+	// container. Objects exceeding it are rejected.
+	AttributeContainerHard = "__NEOFS__QUOTA_HARD"
+	// AttributeOwnerSoft is a soft limit of the space used by all containers
+	// of the container owner.
+	AttributeOwnerSoft = "__NEOFS__OWNER_QUOTA_SOFT"
+	// AttributeOwnerHard is a hard limit of the space used by all containers of
+	// the container owner.
+	AttributeOwnerHard = "__NEOFS__OWNER_QUOTA_HARD"
+)
+
+// Limits groups soft and hard space limits in bytes. Zero value means no
+// limit.
+type Limits struct {
+	Soft uint64
+	Hard uint64
+}
+
+// IsZero checks whether no limits are set.
+func (l Limits) IsZero() bool {
+	return l.Soft == 0 && l.Hard == 0
+}
+
+// Strictest returns the lowest of the soft and hard limits set in l and o.
+func (l Limits) Strictest(o Limits) Limits {
+	return Limits{Soft: lowestLimit(l.Soft, o.Soft), Hard: lowestLimit(l.Hard, o.Hard)}
+}
+
+func lowestLimit(a, b uint64) uint64 {
+	if a == 0 || b != 0 && b < a {
+		return b
+	}
+	return a
+}
+
+// Status is a result of the quota check.
+type Status uint8
+
+const (
+	// OK means that no limit is exceeded.
+	OK Status = iota
+	// SoftExceeded means that soft limit is exceeded.
+	SoftExceeded
+	// HardExceeded means that hard limit is exceeded.
+	HardExceeded
+)
+
+// Check checks whether adding size bytes to the used space exceeds limits.
+func (l Limits) Check(used, size uint64) Status {
+	total := used + size
+	if total < used { // overflow
+		total = ^uint64(0)
+	}
+
+	switch {
+	case l.Hard != 0 && total > l.Hard:
+		return HardExceeded
+	case l.Soft != 0 && total > l.Soft:
+		return SoftExceeded
+	default:
+		return OK
+	}
+}
+
+// FromContainer returns container and owner limits set in the given container
+// attributes.
+func FromContainer(cnr container.Container) (Limits, Limits, error) {
+	var (
+		cnrLimits, ownerLimits Limits
+		err                    error
+	)
+
+	for _, a := range []struct {
+		key string
+		dst *uint64
+	}{
+		{AttributeContainerSoft, &cnrLimits.Soft},
+		{AttributeContainerHard, &cnrLimits.Hard},
+		{AttributeOwnerSoft, &ownerLimits.Soft},
+		{AttributeOwnerHard, &ownerLimits.Hard},
+	} {
+		s := cnr.Attribute(a.key)
+		if s == "" {
+			continue
+		}
+
+		if *a.dst, err = strconv.ParseUint(s, 10, 64); err != nil {
+			return Limits{}, Limits{}, fmt.Errorf("invalid %s container attribute: %w", a.key, err)
+		}
+	}
+
+	return cnrLimits, ownerLimits, nil
+}
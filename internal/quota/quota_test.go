@@ -0,0 +1,70 @@
+package quota_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/internal/quota"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContainer(t *testing.T) {
+	var cnr container.Container
+
+	cnrLimits, ownerLimits, err := quota.FromContainer(cnr)
+	require.NoError(t, err)
+	require.True(t, cnrLimits.IsZero())
+	require.True(t, ownerLimits.IsZero())
+
+	cnr.SetAttribute(quota.AttributeContainerSoft, "100")
+	cnr.SetAttribute(quota.AttributeContainerHard, "200")
+	cnr.SetAttribute(quota.AttributeOwnerHard, "1000")
+
+	cnrLimits, ownerLimits, err = quota.FromContainer(cnr)
+	require.NoError(t, err)
+	require.Equal(t, quota.Limits{Soft: 100, Hard: 200}, cnrLimits)
+	require.Equal(t, quota.Limits{Hard: 1000}, ownerLimits)
+
+	for _, attr := range []string{
+		quota.AttributeContainerSoft,
+		quota.AttributeContainerHard,
+		quota.AttributeOwnerSoft,
+		quota.AttributeOwnerHard,
+	} {
+		t.Run("invalid "+attr, func(t *testing.T) {
+			var cnr container.Container
+			cnr.SetAttribute(attr, "-1")
+			_, _, err := quota.FromContainer(cnr)
+			require.ErrorContains(t, err, attr)
+		})
+	}
+}
+
+func TestLimits_Check(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		limits quota.Limits
+		used   uint64
+		size   uint64
+		exp    quota.Status
+	}{
+		{name: "no limits", used: math.MaxUint64, size: 1, exp: quota.OK},
+		{name: "below soft", limits: quota.Limits{Soft: 10, Hard: 20}, used: 5, size: 5, exp: quota.OK},
+		{name: "soft exceeded", limits: quota.Limits{Soft: 10, Hard: 20}, used: 5, size: 6, exp: quota.SoftExceeded},
+		{name: "hard reached", limits: quota.Limits{Soft: 10, Hard: 20}, used: 15, size: 5, exp: quota.SoftExceeded},
+		{name: "hard exceeded", limits: quota.Limits{Soft: 10, Hard: 20}, used: 15, size: 6, exp: quota.HardExceeded},
+		{name: "hard only", limits: quota.Limits{Hard: 20}, used: 15, size: 5, exp: quota.OK},
+		{name: "overflow", limits: quota.Limits{Hard: math.MaxUint64 - 1}, used: math.MaxUint64, size: 1, exp: quota.HardExceeded},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, tc.limits.Check(tc.used, tc.size))
+		})
+	}
+}
+
+func TestLimits_Strictest(t *testing.T) {
+	require.Equal(t, quota.Limits{}, quota.Limits{}.Strictest(quota.Limits{}))
+	require.Equal(t, quota.Limits{Soft: 10, Hard: 20}, quota.Limits{Soft: 10}.Strictest(quota.Limits{Hard: 20}))
+	require.Equal(t, quota.Limits{Soft: 5, Hard: 20}, quota.Limits{Soft: 10, Hard: 20}.Strictest(quota.Limits{Soft: 5, Hard: 30}))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/misc"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/config"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/internal/blockchain"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/alphabet"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/balance"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/container"
@@ -78,6 +79,7 @@ type (
 
 		// metrics
 		metrics *metrics.InnerRingServiceMetrics
+		queues  *processors.Queues
 
 		// notary configuration
 		mainNotaryConfig *notaryConfig
@@ -413,13 +415,13 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 		return nil, fmt.Errorf("invalid consensus configuration: %w", err)
 	}
 
+	serveMetrics(server, cfg)
+
 	err = serveControl(server, log, cfg, errChan)
 	if err != nil {
 		return nil, err
 	}
 
-	serveMetrics(server, cfg)
-
 	var localWSClient *rpcclient.WSClient // set if isLocalConsensus only
 
 	// create FS chain client
@@ -469,7 +471,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 		}
 
 		fsChainParams.key = server.key
-		fsChainOpts := make([]client.Option, 3, 4)
+		fsChainOpts := make([]client.Option, 3, 5)
 		fsChainOpts[0] = client.WithContext(ctx)
 		fsChainOpts[1] = client.WithLogger(log)
 		fsChainOpts[2] = client.WithSingleClient(localWSClient)
@@ -477,6 +479,9 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 		if !cfg.FSChainAutodeploy {
 			fsChainOpts = append(fsChainOpts, client.WithAutoFSChainScope())
 		}
+		if server.metrics != nil {
+			fsChainOpts = append(fsChainOpts, client.WithMetrics(server.metrics))
+		}
 
 		server.fsChainClient, err = client.New(server.key, fsChainOpts...)
 		if err != nil {
@@ -724,7 +729,9 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 			State:       server,
 		},
 		settlement.WithLogger(server.log),
+		settlement.WithQueues(server.queues),
 	)
+	basicSettlementDeps.reportNotaryRequest = settlementProcessor.ReportNotaryRequest
 
 	locodeValidator, err := server.newLocodeValidator()
 	if err != nil {
@@ -742,6 +749,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 		// create governance processor
 		governanceProcessor, err = governance.New(&governance.Params{
 			Log:           log,
+			Queues:        server.queues,
 			NeoFSClient:   neofsCli,
 			NetmapClient:  server.netmapClient,
 			AlphabetState: server,
@@ -784,6 +792,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 	server.netmapProcessor, err = netmap.New(&netmap.Params{
 		Log:              log,
 		PoolSize:         cfg.Workers.Netmap,
+		Queues:           server.queues,
 		NetmapClient:     server.netmapClient,
 		EpochTimer:       server,
 		EpochState:       server,
@@ -808,6 +817,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 	containerProcessor, err := container.New(&container.Params{
 		Log:             log,
 		PoolSize:        cfg.Workers.Container,
+		Queues:          server.queues,
 		AlphabetState:   server,
 		ContainerClient: cnrClient,
 		NetworkState:    server.netmapClient,
@@ -828,6 +838,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 	balanceProcessor, err := balance.New(&balance.Params{
 		Log:           log,
 		PoolSize:      cfg.Workers.Balance,
+		Queues:        server.queues,
 		NeoFSClient:   neofsCli,
 		BalanceSC:     server.contracts.balance,
 		AlphabetState: server,
@@ -848,6 +859,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 		neofsProcessor, err = neofs.New(&neofs.Params{
 			Log:                 log,
 			PoolSize:            cfg.Workers.NeoFS,
+			Queues:              server.queues,
 			NeoFSContract:       server.contracts.neofs,
 			BalanceClient:       server.balanceClient,
 			NetmapClient:        server.netmapClient,
@@ -874,6 +886,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 	alphabetProcessor, err := alphabet.New(&alphabet.Params{
 		Log:               log,
 		PoolSize:          cfg.Workers.Alphabet,
+		Queues:            server.queues,
 		AlphabetContracts: server.contracts.alphabet,
 		NetmapClient:      server.netmapClient,
 		FSChainClient:     server.fsChainClient,
@@ -893,6 +906,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *config.Config, errChan chan<
 	reputationProcessor, err := reputation.New(&reputation.Params{
 		Log:               log,
 		PoolSize:          cfg.Workers.Reputation,
+		Queues:            server.queues,
 		EpochState:        server,
 		AlphabetState:     server,
 		ReputationWrapper: reputationClient,
@@ -970,6 +984,9 @@ func (s *Server) createClient(ctx context.Context, p chainParams, errChan chan<-
 	if p.withAutoFSChainScope {
 		options = append(options, client.WithAutoFSChainScope())
 	}
+	if p.name == cfgFSChainName && s.metrics != nil {
+		options = append(options, client.WithMetrics(s.metrics))
+	}
 
 	return client.New(p.key, options...)
 }
@@ -1075,6 +1092,7 @@ func serveControl(server *Server, log *zap.Logger, cfg *config.Config, errChan c
 		p.SetPrivateKey(*server.key)
 		p.SetHealthChecker(server)
 		p.SetNetworkManager(server)
+		p.SetQueueInspector(server)
 
 		controlSvc := controlsrv.New(p,
 			controlsrv.WithAllowedKeys(authKeys),
@@ -1099,5 +1117,8 @@ func serveMetrics(server *Server, cfg *config.Config) {
 	if cfg.Prometheus.Address != "" {
 		m := metrics.NewInnerRingMetrics(misc.Version)
 		server.metrics = &m
+		server.queues = processors.NewQueues(m)
+		return
 	}
+	server.queues = processors.NewQueues(nil)
 }
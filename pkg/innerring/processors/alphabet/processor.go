@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
 	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
@@ -23,7 +24,7 @@ type (
 	// Processor of events produced for alphabet contracts in FS chain.
 	Processor struct {
 		log               *zap.Logger
-		pool              *ants.Pool
+		pool              *processors.Queue
 		alphabetContracts []util.Uint160
 		netmapClient      *nmClient.Client
 		fsChainClient     *client.Client
@@ -35,6 +36,7 @@ type (
 	Params struct {
 		Log               *zap.Logger
 		PoolSize          int
+		Queues            *processors.Queues
 		AlphabetContracts []util.Uint160
 		NetmapClient      *nmClient.Client
 		FSChainClient     *client.Client
@@ -56,7 +58,7 @@ func New(p *Params) (*Processor, error) {
 
 	p.Log.Debug("alphabet worker pool", zap.Int("size", p.PoolSize))
 
-	pool, err := ants.NewPool(p.PoolSize, ants.WithNonblocking(true))
+	pool, err := p.Queues.New("alphabet", p.PoolSize, ants.WithNonblocking(true))
 	if err != nil {
 		return nil, fmt.Errorf("ir/neofs: can't create worker pool: %w", err)
 	}
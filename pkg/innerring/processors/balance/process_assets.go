@@ -14,6 +14,7 @@ func (bp *Processor) processLock(lock *balanceEvent.Lock) {
 	}
 
 	err := bp.neofsClient.Cheque(lock.TxHash(), lock.ID(), lock.User(), bp.converter.ToFixed8(lock.Amount()), lock.LockAccount())
+	bp.pool.ReportNotaryRequest(err)
 	if err != nil {
 		bp.log.Error("can't send lock asset tx", zap.Error(err))
 	}
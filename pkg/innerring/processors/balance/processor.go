@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors"
 	neofscontract "github.com/nspcc-dev/neofs-node/pkg/morph/client/neofs"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
 	balanceEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/balance"
@@ -26,7 +27,7 @@ type (
 	// Processor of events produced by balance contract in the FS chain.
 	Processor struct {
 		log           *zap.Logger
-		pool          *ants.Pool
+		pool          *processors.Queue
 		neofsClient   *neofscontract.Client
 		balanceSC     util.Uint160
 		alphabetState AlphabetState
@@ -37,6 +38,7 @@ type (
 	Params struct {
 		Log           *zap.Logger
 		PoolSize      int
+		Queues        *processors.Queues
 		NeoFSClient   *neofscontract.Client
 		BalanceSC     util.Uint160
 		AlphabetState AlphabetState
@@ -61,7 +63,7 @@ func New(p *Params) (*Processor, error) {
 
 	p.Log.Debug("balance worker pool", zap.Int("size", p.PoolSize))
 
-	pool, err := ants.NewPool(p.PoolSize, ants.WithNonblocking(true))
+	pool, err := p.Queues.New("balance", p.PoolSize, ants.WithNonblocking(true))
 	if err != nil {
 		return nil, fmt.Errorf("ir/balance: can't create worker pool: %w", err)
 	}
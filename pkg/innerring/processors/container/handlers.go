@@ -136,6 +136,7 @@ func (cp *Processor) handleObjectPut(ev event.Event) {
 	err := cp.objectPool.Submit(func() {
 		nr := e.NotaryRequest()
 		err := cp.cnrClient.Morph().NotarySignAndInvokeTX(nr.MainTransaction, false)
+		cp.objectPool.ReportNotaryRequest(err)
 		if err != nil {
 			cp.log.Error("could not approve object put",
 				zap.Stringer("cID", e.ContainerID()),
@@ -27,6 +27,7 @@ func (cp *Processor) processAnnounceLoad(e container.AnnounceLoad) {
 
 	nr := e.NotaryRequest()
 	err = cp.cnrClient.Morph().NotarySignAndInvokeTX(nr.MainTransaction, false)
+	cp.pool.ReportNotaryRequest(err)
 	if err != nil {
 		cp.log.Error("could not approve announce load",
 			zap.Error(err),
@@ -127,6 +127,7 @@ func (cp *Processor) approvePutContainer(ctx *putContainerContext) {
 	var err error
 
 	err = cp.cnrClient.Morph().NotarySignAndInvokeTX(&e.MainTransaction, true)
+	cp.pool.ReportNotaryRequest(err)
 
 	if err != nil {
 		cp.log.Error("could not approve put container",
@@ -213,6 +214,7 @@ func (cp *Processor) checkDeleteContainer(req containerEvent.RemoveContainerRequ
 
 func (cp *Processor) approveDeleteContainer(e containerEvent.RemoveContainerRequest) {
 	err := cp.cnrClient.Morph().NotarySignAndInvokeTX(&e.MainTransaction, false)
+	cp.pool.ReportNotaryRequest(err)
 
 	if err != nil {
 		cp.log.Error("could not approve delete container",
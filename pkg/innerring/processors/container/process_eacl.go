@@ -77,6 +77,7 @@ func (cp *Processor) checkSetEACL(req container.PutContainerEACLRequest) error {
 
 func (cp *Processor) approveSetEACL(req container.PutContainerEACLRequest) {
 	err := cp.cnrClient.Morph().NotarySignAndInvokeTX(&req.MainTransaction, false)
+	cp.pool.ReportNotaryRequest(err)
 
 	if err != nil {
 		cp.log.Error("could not approve set EACL",
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
 	fschaincontracts "github.com/nspcc-dev/neofs-node/pkg/morph/contracts"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
@@ -22,8 +23,8 @@ type (
 	// Processor of events produced by container contract in FS chain.
 	Processor struct {
 		log           *zap.Logger
-		pool          *ants.Pool
-		objectPool    *ants.Pool
+		pool          *processors.Queue
+		objectPool    *processors.Queue
 		alphabetState AlphabetState
 		cnrClient     *container.Client // notary must be enabled
 		netState      NetworkState
@@ -34,6 +35,7 @@ type (
 	Params struct {
 		Log             *zap.Logger
 		PoolSize        int
+		Queues          *processors.Queues
 		AlphabetState   AlphabetState
 		ContainerClient *container.Client
 		NetworkState    NetworkState
@@ -80,13 +82,13 @@ func New(p *Params) (*Processor, error) {
 
 	p.Log.Debug("container worker pool", zap.Int("size", p.PoolSize))
 
-	pool, err := ants.NewPool(p.PoolSize, ants.WithNonblocking(true))
+	pool, err := p.Queues.New("container", p.PoolSize, ants.WithNonblocking(true))
 	if err != nil {
 		return nil, fmt.Errorf("ir/container: can't create worker pool: %w", err)
 	}
 
 	const objectPoolSize = 1024
-	objectPool, _ := ants.NewPool(objectPoolSize)
+	objectPool, _ := p.Queues.New("container_objects", objectPoolSize)
 
 	return &Processor{
 		log:           p.Log,
@@ -87,6 +87,7 @@ func (gp *Processor) processAlphabetSync(txHash util.Uint256) {
 
 	// 3. Update notary role in FS chain.
 	err = gp.fsChainClient.UpdateNotaryList(newAlphabet, txHash)
+	gp.pool.ReportNotaryRequest(err)
 	if err != nil {
 		gp.log.Error("can't update list of notary nodes in FS chain",
 			zap.Error(err))
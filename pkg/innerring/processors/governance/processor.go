@@ -7,6 +7,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/core/native"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
 	neofscontract "github.com/nspcc-dev/neofs-node/pkg/morph/client/neofs"
 	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
@@ -49,7 +50,7 @@ type (
 	// Processor of events related to governance in the network.
 	Processor struct {
 		log          *zap.Logger
-		pool         *ants.Pool
+		pool         *processors.Queue
 		neofsClient  *neofscontract.Client
 		netmapClient *nmClient.Client
 
@@ -66,7 +67,8 @@ type (
 
 	// Params of the processor constructor.
 	Params struct {
-		Log *zap.Logger
+		Log    *zap.Logger
+		Queues *processors.Queues
 
 		AlphabetState AlphabetState
 		EpochState    EpochState
@@ -99,7 +101,7 @@ func New(p *Params) (*Processor, error) {
 		return nil, errors.New("ir/governance: innerring keys fetcher is not set")
 	}
 
-	pool, err := ants.NewPool(ProcessorPoolSize, ants.WithNonblocking(true))
+	pool, err := p.Queues.New("governance", ProcessorPoolSize, ants.WithNonblocking(true))
 	if err != nil {
 		return nil, fmt.Errorf("ir/governance: can't create worker pool: %w", err)
 	}
@@ -28,6 +28,7 @@ func (np *Processor) processDeposit(deposit *neofsEvent.Deposit) {
 
 	// send transferX to a balance contract
 	err := np.balanceClient.Mint(prm)
+	np.pool.ReportNotaryRequest(err)
 	if err != nil {
 		np.log.Error("can't transfer assets to balance contract", zap.Error(err))
 	}
@@ -103,6 +104,7 @@ func (np *Processor) processWithdraw(withdraw *neofsEvent.Withdraw) {
 	prm.SetDueEpoch(int64(curEpoch + lockAccountLifetime))
 
 	err = np.balanceClient.Lock(prm)
+	np.pool.ReportNotaryRequest(err)
 	if err != nil {
 		np.log.Error("can't lock assets for withdraw", zap.Error(err))
 	}
@@ -123,6 +125,7 @@ func (np *Processor) processCheque(cheque *neofsEvent.Cheque) {
 	prm.SetID(cheque.ID())
 
 	err := np.balanceClient.Burn(prm)
+	np.pool.ReportNotaryRequest(err)
 	if err != nil {
 		np.log.Error("can't transfer assets to fed contract", zap.Error(err))
 	}
@@ -21,6 +21,7 @@ func (np *Processor) processConfig(config *neofsEvent.Config) {
 	prm.SetHash(config.TxHash())
 
 	err := np.netmapClient.SetConfig(prm)
+	np.pool.ReportNotaryRequest(err)
 	if err != nil {
 		np.log.Error("can't relay set config event", zap.Error(err))
 	}
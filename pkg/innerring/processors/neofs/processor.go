@@ -8,6 +8,7 @@ import (
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
 	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/client/balance"
 	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
@@ -36,7 +37,7 @@ type (
 	// Processor of events produced by neofs contract in main net.
 	Processor struct {
 		log                 *zap.Logger
-		pool                *ants.Pool
+		pool                *processors.Queue
 		neofsContract       util.Uint160
 		balanceClient       *balance.Client
 		netmapClient        *nmClient.Client
@@ -55,6 +56,7 @@ type (
 	Params struct {
 		Log                 *zap.Logger
 		PoolSize            int
+		Queues              *processors.Queues
 		NeoFSContract       util.Uint160
 		BalanceClient       *balance.Client
 		NetmapClient        *nmClient.Client
@@ -95,7 +97,7 @@ func New(p *Params) (*Processor, error) {
 
 	p.Log.Debug("neofs worker pool", zap.Int("size", p.PoolSize))
 
-	pool, err := ants.NewPool(p.PoolSize, ants.WithNonblocking(true))
+	pool, err := p.Queues.New("neofs", p.PoolSize, ants.WithNonblocking(true))
 	if err != nil {
 		return nil, fmt.Errorf("ir/neofs: can't create worker pool: %w", err)
 	}
@@ -162,6 +162,7 @@ func (np *Processor) processNewEpochTick() {
 	np.log.Debug("next epoch", zap.Uint64("value", nextEpoch))
 
 	err := np.netmapClient.NewEpoch(nextEpoch)
+	np.pool.ReportNotaryRequest(err)
 	if err != nil {
 		np.log.Error("can't invoke netmap.NewEpoch", zap.Error(err))
 	}
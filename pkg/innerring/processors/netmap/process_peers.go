@@ -48,6 +48,7 @@ func (np *Processor) processAddNode(ev netmapEvent.AddNode) {
 	np.log.Info("approving network map candidate", zap.String("key", keyString))
 
 	err = np.netmapClient.Morph().NotarySignAndInvokeTX(tx, false)
+	np.pool.ReportNotaryRequest(err)
 	if err != nil {
 		np.log.Error("can't sign and send notary request calling netmap.AddPeer", zap.Error(err))
 	}
@@ -64,6 +65,7 @@ func (np *Processor) processUpdatePeer(ev netmapEvent.UpdatePeer) {
 
 	nr := ev.NotaryRequest()
 	err = np.netmapClient.Morph().NotarySignAndInvokeTX(nr.MainTransaction, false)
+	np.pool.ReportNotaryRequest(err)
 
 	if err != nil {
 		np.log.Error("can't invoke netmap.UpdatePeer", zap.Error(err))
@@ -6,6 +6,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
 	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
@@ -48,7 +49,7 @@ type (
 	// and new epoch ticker, because it is related to contract.
 	Processor struct {
 		log           *zap.Logger
-		pool          *ants.Pool
+		pool          *processors.Queue
 		epochTimer    EpochTimerReseter
 		epochState    EpochState
 		alphabetState AlphabetState
@@ -67,6 +68,7 @@ type (
 	Params struct {
 		Log              *zap.Logger
 		PoolSize         int
+		Queues           *processors.Queues
 		NetmapClient     *nmClient.Client
 		EpochTimer       EpochTimerReseter
 		EpochState       EpochState
@@ -110,7 +112,7 @@ func New(p *Params) (*Processor, error) {
 		return nil, fmt.Errorf("ir/netmap: can't fetch network map: %w", err)
 	}
 
-	pool, err := ants.NewPool(p.PoolSize, ants.WithNonblocking(true))
+	pool, err := p.Queues.New("netmap", p.PoolSize, ants.WithNonblocking(true))
 	if err != nil {
 		return nil, fmt.Errorf("ir/netmap: can't create worker pool: %w", err)
 	}
@@ -0,0 +1,199 @@
+/*
+Package processors provides event queues shared by the Inner Ring processors.
+
+Each processor handles events in its own worker pool. [Queue] wraps the pool
+accounting submitted, handled and dropped events along with the notary
+requests made while handling them. The accounting is reported to [Metrics]
+and is available as [QueueState] for inspection.
+*/
+package processors
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/panjf2000/ants/v2"
+)
+
+// Metrics is an interface of the processor metrics collector.
+type Metrics interface {
+	IncProcessorReceivedEvents(processor string)
+	IncProcessorHandledEvents(processor string)
+	IncProcessorDroppedEvents(processor string)
+	AddProcessorEventHandlingDuration(processor string, d time.Duration)
+	IncProcessorNotaryRequestsSent(processor string)
+	IncProcessorNotaryRequestsFailed(processor string)
+	SetProcessorBusyWorkers(processor string, n int)
+	SetProcessorPoolCapacity(processor string, n int)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) IncProcessorReceivedEvents(string)                       {}
+func (noopMetrics) IncProcessorHandledEvents(string)                        {}
+func (noopMetrics) IncProcessorDroppedEvents(string)                        {}
+func (noopMetrics) AddProcessorEventHandlingDuration(string, time.Duration) {}
+func (noopMetrics) IncProcessorNotaryRequestsSent(string)                   {}
+func (noopMetrics) IncProcessorNotaryRequestsFailed(string)                 {}
+func (noopMetrics) SetProcessorBusyWorkers(string, int)                     {}
+func (noopMetrics) SetProcessorPoolCapacity(string, int)                    {}
+
+// QueueState is a snapshot of the [Queue] accounting.
+type QueueState struct {
+	// Processor name.
+	Name string
+	// Worker pool capacity.
+	Capacity int
+	// Number of workers handling events.
+	BusyWorkers int
+	// Number of events submitted to the queue including dropped ones.
+	ReceivedEvents uint64
+	// Number of handled events.
+	HandledEvents uint64
+	// Number of events dropped because the pool was full.
+	DroppedEvents uint64
+	// Number of notary requests sent while handling events.
+	NotaryRequestsSent uint64
+	// Number of notary requests failed to be sent.
+	NotaryRequestsFailed uint64
+	// Time of the last handled event, zero if none.
+	LastHandled time.Time
+}
+
+// Queue is an event queue of the processor.
+type Queue struct {
+	name    string
+	pool    *ants.Pool
+	metrics Metrics
+
+	busy                 atomic.Int64
+	received             atomic.Uint64
+	handled              atomic.Uint64
+	dropped              atomic.Uint64
+	notaryRequestsSent   atomic.Uint64
+	notaryRequestsFailed atomic.Uint64
+	lastHandled          atomic.Int64 // Unix nanoseconds
+}
+
+// Submit submits task handling an event to the worker pool. Returns an error
+// if the task can not be submitted, e.g. when the non-blocking pool is full.
+func (q *Queue) Submit(task func()) error {
+	q.received.Add(1)
+	q.metrics.IncProcessorReceivedEvents(q.name)
+
+	err := q.pool.Submit(func() {
+		q.metrics.SetProcessorBusyWorkers(q.name, int(q.busy.Add(1)))
+		start := time.Now()
+
+		task()
+
+		q.metrics.AddProcessorEventHandlingDuration(q.name, time.Since(start))
+		q.handled.Add(1)
+		q.metrics.IncProcessorHandledEvents(q.name)
+		q.lastHandled.Store(time.Now().UnixNano())
+		q.metrics.SetProcessorBusyWorkers(q.name, int(q.busy.Add(-1)))
+	})
+	if err != nil {
+		q.dropped.Add(1)
+		q.metrics.IncProcessorDroppedEvents(q.name)
+	}
+	return err
+}
+
+// ReportNotaryRequest accounts notary request sent by the processor while
+// handling an event. Non-nil err means the request was not sent.
+func (q *Queue) ReportNotaryRequest(err error) {
+	if err != nil {
+		q.notaryRequestsFailed.Add(1)
+		q.metrics.IncProcessorNotaryRequestsFailed(q.name)
+		return
+	}
+	q.notaryRequestsSent.Add(1)
+	q.metrics.IncProcessorNotaryRequestsSent(q.name)
+}
+
+// Cap returns capacity of the worker pool.
+func (q *Queue) Cap() int {
+	return q.pool.Cap()
+}
+
+// Release closes the worker pool.
+func (q *Queue) Release() {
+	q.pool.Release()
+}
+
+// State returns current state of the queue.
+func (q *Queue) State() QueueState {
+	st := QueueState{
+		Name:                 q.name,
+		Capacity:             q.pool.Cap(),
+		BusyWorkers:          int(q.busy.Load()),
+		ReceivedEvents:       q.received.Load(),
+		HandledEvents:        q.handled.Load(),
+		DroppedEvents:        q.dropped.Load(),
+		NotaryRequestsSent:   q.notaryRequestsSent.Load(),
+		NotaryRequestsFailed: q.notaryRequestsFailed.Load(),
+	}
+	if t := q.lastHandled.Load(); t != 0 {
+		st.LastHandled = time.Unix(0, t)
+	}
+	return st
+}
+
+// Queues is a set of the processor queues. Zero value is not usable, but nil
+// *Queues is: it creates queues without metrics and does not track them.
+type Queues struct {
+	metrics Metrics
+
+	mtx  sync.RWMutex
+	list []*Queue
+}
+
+// NewQueues returns empty set of queues reporting to m. Nil m disables
+// metrics.
+func NewQueues(m Metrics) *Queues {
+	if m == nil {
+		m = noopMetrics{}
+	}
+	return &Queues{metrics: m}
+}
+
+// New creates queue of the named processor with the worker pool of the given
+// size and adds it to the set. Returns [ants.NewPool] errors.
+func (x *Queues) New(name string, size int, opts ...ants.Option) (*Queue, error) {
+	pool, err := ants.NewPool(size, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Queue{
+		name:    name,
+		pool:    pool,
+		metrics: noopMetrics{},
+	}
+
+	if x != nil {
+		q.metrics = x.metrics
+
+		x.mtx.Lock()
+		x.list = append(x.list, q)
+		x.mtx.Unlock()
+	}
+
+	q.metrics.SetProcessorPoolCapacity(name, pool.Cap())
+
+	return q, nil
+}
+
+// States returns current states of all queues in the order they were created.
+func (x *Queues) States() []QueueState {
+	x.mtx.RLock()
+	defer x.mtx.RUnlock()
+
+	res := make([]QueueState, len(x.list))
+	for i := range x.list {
+		res[i] = x.list[i].State()
+	}
+	return res
+}
@@ -0,0 +1,140 @@
+package processors
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/panjf2000/ants/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type testMetrics struct {
+	noopMetrics
+
+	mtx      sync.Mutex
+	received map[string]int
+	handled  map[string]int
+	dropped  map[string]int
+	capacity map[string]int
+}
+
+func newTestMetrics() *testMetrics {
+	return &testMetrics{
+		received: make(map[string]int),
+		handled:  make(map[string]int),
+		dropped:  make(map[string]int),
+		capacity: make(map[string]int),
+	}
+}
+
+func (x *testMetrics) IncProcessorReceivedEvents(p string) {
+	x.mtx.Lock()
+	x.received[p]++
+	x.mtx.Unlock()
+}
+
+func (x *testMetrics) IncProcessorHandledEvents(p string) {
+	x.mtx.Lock()
+	x.handled[p]++
+	x.mtx.Unlock()
+}
+
+func (x *testMetrics) IncProcessorDroppedEvents(p string) {
+	x.mtx.Lock()
+	x.dropped[p]++
+	x.mtx.Unlock()
+}
+
+func (x *testMetrics) SetProcessorPoolCapacity(p string, n int) {
+	x.mtx.Lock()
+	x.capacity[p] = n
+	x.mtx.Unlock()
+}
+
+func TestQueue(t *testing.T) {
+	m := newTestMetrics()
+	qs := NewQueues(m)
+
+	q, err := qs.New("test", 1, ants.WithNonblocking(true))
+	require.NoError(t, err)
+	t.Cleanup(q.Release)
+	require.Equal(t, 1, m.capacity["test"])
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.NoError(t, q.Submit(func() {
+		close(started)
+		<-release
+	}))
+	<-started
+
+	st := q.State()
+	require.Equal(t, 1, st.BusyWorkers)
+	require.Zero(t, st.HandledEvents)
+	require.True(t, st.LastHandled.IsZero())
+
+	require.Error(t, q.Submit(func() {}))
+
+	close(release)
+	require.Eventually(t, func() bool {
+		return q.State().HandledEvents == 1
+	}, time.Second, 10*time.Millisecond)
+
+	q.ReportNotaryRequest(nil)
+	q.ReportNotaryRequest(errors.New("any error"))
+
+	states := qs.States()
+	require.Len(t, states, 1)
+	st = states[0]
+	require.Equal(t, "test", st.Name)
+	require.Equal(t, 1, st.Capacity)
+	require.Zero(t, st.BusyWorkers)
+	require.EqualValues(t, 2, st.ReceivedEvents)
+	require.EqualValues(t, 1, st.HandledEvents)
+	require.EqualValues(t, 1, st.DroppedEvents)
+	require.EqualValues(t, 1, st.NotaryRequestsSent)
+	require.EqualValues(t, 1, st.NotaryRequestsFailed)
+	require.False(t, st.LastHandled.IsZero())
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	require.Equal(t, 2, m.received["test"])
+	require.Equal(t, 1, m.handled["test"])
+	require.Equal(t, 1, m.dropped["test"])
+}
+
+func TestQueues_New(t *testing.T) {
+	t.Run("nil set", func(t *testing.T) {
+		var qs *Queues
+
+		q, err := qs.New("test", 1)
+		require.NoError(t, err)
+		t.Cleanup(q.Release)
+
+		done := make(chan struct{})
+		require.NoError(t, q.Submit(func() { close(done) }))
+		<-done
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := NewQueues(nil).New("test", 1, ants.WithExpiryDuration(-1))
+		require.Error(t, err)
+	})
+
+	t.Run("order", func(t *testing.T) {
+		qs := NewQueues(nil)
+		for _, name := range []string{"b", "a", "c"} {
+			q, err := qs.New(name, 1)
+			require.NoError(t, err)
+			t.Cleanup(q.Release)
+		}
+
+		states := qs.States()
+		require.Len(t, states, 3)
+		require.Equal(t, "b", states[0].Name)
+		require.Equal(t, "a", states[1].Name)
+		require.Equal(t, "c", states[2].Name)
+	})
+}
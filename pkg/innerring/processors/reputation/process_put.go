@@ -78,6 +78,7 @@ func (rp *Processor) approvePutReputation(e *reputationEvent.Put) {
 	)
 
 	err = rp.reputationWrp.Morph().NotarySignAndInvokeTX(nr.MainTransaction, false)
+	rp.pool.ReportNotaryRequest(err)
 
 	if err != nil {
 		rp.log.Warn("can't send approval tx for reputation value",
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors"
 	repClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/reputation"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
 	reputationEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/reputation"
@@ -26,7 +27,7 @@ type (
 	// Processor of events produced by reputation contract.
 	Processor struct {
 		log  *zap.Logger
-		pool *ants.Pool
+		pool *processors.Queue
 
 		epochState    EpochState
 		alphabetState AlphabetState
@@ -40,6 +41,7 @@ type (
 	Params struct {
 		Log               *zap.Logger
 		PoolSize          int
+		Queues            *processors.Queues
 		EpochState        EpochState
 		AlphabetState     AlphabetState
 		ReputationWrapper *repClient.Client
@@ -64,7 +66,7 @@ func New(p *Params) (*Processor, error) {
 
 	p.Log.Debug("reputation worker pool", zap.Int("size", p.PoolSize))
 
-	pool, err := ants.NewPool(p.PoolSize, ants.WithNonblocking(true))
+	pool, err := p.Queues.New("reputation", p.PoolSize, ants.WithNonblocking(true))
 	if err != nil {
 		return nil, fmt.Errorf("ir/reputation: can't create worker pool: %w", err)
 	}
@@ -1,6 +1,7 @@
 package settlement
 
 import (
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors"
 	"go.uber.org/zap"
 )
 
@@ -9,6 +10,7 @@ type Option func(*options)
 
 type options struct {
 	poolSize int
+	queues   *processors.Queues
 
 	log *zap.Logger
 }
@@ -28,3 +30,10 @@ func WithLogger(l *zap.Logger) Option {
 		o.log = l
 	}
 }
+
+// WithQueues returns option to add the processor queue to the set.
+func WithQueues(qs *processors.Queues) Option {
+	return func(o *options) {
+		o.queues = qs
+	}
+}
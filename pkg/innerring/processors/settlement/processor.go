@@ -3,7 +3,7 @@ package settlement
 import (
 	"fmt"
 
-	nodeutil "github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
 )
@@ -20,7 +20,7 @@ type (
 
 		state AlphabetState
 
-		pool nodeutil.WorkerPool
+		pool *processors.Queue
 
 		basicIncome BasicIncomeInitializer
 	}
@@ -40,7 +40,7 @@ func New(prm Prm, opts ...Option) *Processor {
 		opts[i](o)
 	}
 
-	pool, err := ants.NewPool(o.poolSize, ants.WithNonblocking(true))
+	pool, err := o.queues.New("settlement", o.poolSize, ants.WithNonblocking(true))
 	if err != nil {
 		panic(fmt.Errorf("could not create worker pool: %w", err))
 	}
@@ -56,3 +56,9 @@ func New(prm Prm, opts ...Option) *Processor {
 		basicIncome: prm.BasicIncome,
 	}
 }
+
+// ReportNotaryRequest accounts notary request sent by the processor's
+// settlement contexts. See [processors.Queue.ReportNotaryRequest].
+func (p *Processor) ReportNotaryRequest(err error) {
+	p.pool.ReportNotaryRequest(err)
+}
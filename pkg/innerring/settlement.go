@@ -35,6 +35,8 @@ type settlementDeps struct {
 	balanceClient *balanceClient.Client
 
 	settlementCtx string
+
+	reportNotaryRequest func(error)
 }
 
 type basicIncomeSettlementDeps struct {
@@ -156,6 +158,7 @@ func (s settlementDeps) Transfer(sender, recipient user.ID, amount *big.Int, det
 	)
 
 	err := s.balanceClient.TransferX(sender, recipient, amount, details)
+	s.reportNotaryRequest(err)
 	if err != nil {
 		log.Error(fmt.Sprintf("%s: could not send transfer", s.settlementCtx),
 			zap.Error(err),
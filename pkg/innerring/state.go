@@ -200,6 +200,31 @@ func (s *Server) HealthStatus() control.HealthStatus {
 	return s.healthStatus.Load().(control.HealthStatus)
 }
 
+// ProcessorQueues returns current states of the event queues of the IR
+// processors.
+func (s *Server) ProcessorQueues() []*control.ProcessorQueue {
+	states := s.queues.States()
+	res := make([]*control.ProcessorQueue, 0, len(states))
+	for i := range states {
+		var lastHandled uint64
+		if !states[i].LastHandled.IsZero() {
+			lastHandled = uint64(states[i].LastHandled.Unix())
+		}
+		res = append(res, &control.ProcessorQueue{
+			Name:                 states[i].Name,
+			Capacity:             uint32(states[i].Capacity),
+			BusyWorkers:          uint32(states[i].BusyWorkers),
+			ReceivedEvents:       states[i].ReceivedEvents,
+			HandledEvents:        states[i].HandledEvents,
+			DroppedEvents:        states[i].DroppedEvents,
+			NotaryRequestsSent:   states[i].NotaryRequestsSent,
+			NotaryRequestsFailed: states[i].NotaryRequestsFailed,
+			LastHandled:          lastHandled,
+		})
+	}
+	return res
+}
+
 func initPersistentStateStorage(cfg *config.Config) (*state.PersistentStorage, error) {
 	persistStorage, err := state.NewPersistentStorage(cfg.Node.PersistentState.Path)
 	if err != nil {
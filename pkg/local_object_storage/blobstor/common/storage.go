@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
@@ -30,11 +31,35 @@ type Storage interface {
 	Exists(oid.Address) (bool, error)
 	Put(oid.Address, []byte) error
 	PutBatch(map[oid.Address][]byte) error
+	// Rewrite replaces data of the stored object, e.g. to apply the current
+	// compression and encryption settings. Unlike Put, it overwrites the
+	// object if it already exists.
+	Rewrite(oid.Address, []byte) error
 	Delete(oid.Address) error
 	Iterate(func(oid.Address, []byte) error, func(oid.Address, error) error) error
 	IterateAddresses(func(oid.Address) error, bool) error
 }
 
+// Partitioned is implemented by the Storage that can be iterated by parts,
+// e.g. to process them in parallel or to resume an interrupted processing.
+// Every stored object belongs to exactly one partition.
+type Partitioned interface {
+	// Partitions returns names of the storage partitions.
+	Partitions() ([]string, error)
+	// IteratePartition works like Storage.Iterate for the objects of the
+	// named partition only.
+	IteratePartition(string, func(oid.Address, []byte) error, func(oid.Address, error) error) error
+}
+
+// EncryptionKeyReader is implemented by the Storage that can tell the key the
+// stored object data is encrypted with without reading and decrypting it.
+type EncryptionKeyReader interface {
+	// EncryptionKeyID returns ID of the key the stored object data is
+	// encrypted with. Returns false if the data is not encrypted and
+	// [apistatus.ObjectNotFound] if object is missing.
+	EncryptionKeyID(oid.Address) (encryption.KeyID, bool, error)
+}
+
 // Copy copies all objects from source Storage into the destination one. If any
 // object cannot be stored, Copy immediately fails.
 func Copy(dst, src Storage) error {
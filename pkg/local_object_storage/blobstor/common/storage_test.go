@@ -7,26 +7,50 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/peapod"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 )
 
+func newFSTree(path string) common.Storage {
+	return fstree.New(fstree.WithPath(path))
+}
+
+func newPeapod(path string) common.Storage {
+	return peapod.New(peapod.WithPath(filepath.Join(path, "peapod.db")))
+}
+
 func TestCopy(t *testing.T) {
-	testCopy(t, common.Copy)
+	testCopyStorages(t, common.Copy)
 }
 
 func TestCopyBatched(t *testing.T) {
-	testCopy(t, func(dst, src common.Storage) error {
+	testCopyStorages(t, func(dst, src common.Storage) error {
 		return common.CopyBatched(dst, src, 7)
 	})
 }
 
-func testCopy(t *testing.T, copier func(dst, src common.Storage) error) {
+func testCopyStorages(t *testing.T, copier func(dst, src common.Storage) error) {
+	for _, tc := range []struct {
+		name     string
+		src, dst func(string) common.Storage
+	}{
+		{name: "fstree to fstree", src: newFSTree, dst: newFSTree},
+		{name: "fstree to peapod", src: newFSTree, dst: newPeapod},
+		{name: "peapod to fstree", src: newPeapod, dst: newFSTree},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testCopy(t, copier, tc.src, tc.dst)
+		})
+	}
+}
+
+func testCopy(t *testing.T, copier func(dst, src common.Storage) error, newSrc, newDst func(string) common.Storage) {
 	dir := t.TempDir()
 	const nObjects = 100
 
-	src := fstree.New(fstree.WithPath(filepath.Join(dir, "src")))
+	src := newSrc(filepath.Join(dir, "src"))
 
 	require.NoError(t, src.Open(false))
 	require.NoError(t, src.Init())
@@ -45,7 +69,7 @@ func testCopy(t *testing.T, copier func(dst, src common.Storage) error) {
 
 	require.NoError(t, src.Close())
 
-	dst := fstree.New(fstree.WithPath(filepath.Join(dir, "dst")))
+	dst := newDst(filepath.Join(dir, "dst"))
 
 	err := copier(dst, src)
 	require.NoError(t, err)
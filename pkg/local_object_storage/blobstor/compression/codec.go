@@ -0,0 +1,205 @@
+package compression
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+)
+
+// Names of the supported codecs.
+const (
+	// None stores data as is.
+	None = "none"
+	// Zstd is Zstandard with the default level.
+	Zstd = "zstd"
+	// ZstdFastest is Zstandard with the fastest level.
+	ZstdFastest = "zstd-fastest"
+	// ZstdBetter is Zstandard with better than default compression ratio.
+	ZstdBetter = "zstd-better"
+	// ZstdBest is Zstandard with the best compression ratio.
+	ZstdBest = "zstd-best"
+	// LZ4 is LZ4 block compression.
+	LZ4 = "lz4"
+	// Snappy is Snappy block compression.
+	Snappy = "snappy"
+)
+
+// ContainerAttribute is a container attribute requesting compression codec
+// for the container objects. Its value is one of the codec names.
+const ContainerAttribute = "__NEOFS__COMPRESSION"
+
+var codecNames = []string{None, Zstd, ZstdFastest, ZstdBetter, ZstdBest, LZ4, Snappy}
+
+// Codecs returns names of all supported codecs.
+func Codecs() []string {
+	return slices.Clone(codecNames)
+}
+
+// IsKnownCodec checks whether codec with the given name is supported.
+func IsKnownCodec(name string) bool {
+	return slices.Contains(codecNames, name)
+}
+
+// Data compressed with block codecs starts with the header:
+//   - blockMagic which starts neither protobuf-encoded object (wire type 6
+//     is invalid) nor Zstandard frame;
+//   - codec ID byte;
+//   - uvarint length of the original data;
+//   - uvarint length of the compressed data following the header.
+//
+// Zstandard frames are stored as is since they have their own magic, so
+// data written before codecs were introduced is read the same way.
+var blockMagic = []byte{0xfe, 'N', 'B', 'C'}
+
+const (
+	blockCodecLZ4 byte = iota + 1
+	blockCodecSnappy
+)
+
+const maxBlockHeaderLen = 5 + 2*binary.MaxVarintLen64
+
+// maxBlockRatio limits original to compressed data length ratio to reject
+// corrupted headers before allocating memory. Neither LZ4 nor Snappy can
+// reach it.
+const maxBlockRatio = 256
+
+type encoder struct {
+	compress func(src []byte) []byte
+	zstd     *zstd.Encoder
+}
+
+func newEncoder(name string) (encoder, error) {
+	var level zstd.EncoderLevel
+
+	switch name {
+	case None:
+		return encoder{compress: func(src []byte) []byte { return src }}, nil
+	case LZ4:
+		return encoder{compress: func(src []byte) []byte {
+			dst := make([]byte, maxBlockHeaderLen+lz4.CompressBlockBound(len(src)))
+			n, err := lz4.CompressBlock(src, dst[maxBlockHeaderLen:], nil)
+			if err != nil || n == 0 {
+				return src
+			}
+			return finishBlock(blockCodecLZ4, src, dst, n)
+		}}, nil
+	case Snappy:
+		return encoder{compress: func(src []byte) []byte {
+			dst := make([]byte, maxBlockHeaderLen+s2.MaxEncodedLen(len(src)))
+			n := len(s2.EncodeSnappy(dst[maxBlockHeaderLen:], src))
+			return finishBlock(blockCodecSnappy, src, dst, n)
+		}}, nil
+	case Zstd:
+		level = zstd.SpeedDefault
+	case ZstdFastest:
+		level = zstd.SpeedFastest
+	case ZstdBetter:
+		level = zstd.SpeedBetterCompression
+	case ZstdBest:
+		level = zstd.SpeedBestCompression
+	default:
+		return encoder{}, fmt.Errorf("unknown compression codec %q", name)
+	}
+
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(level))
+	if err != nil {
+		return encoder{}, err
+	}
+
+	return encoder{
+		compress: func(src []byte) []byte {
+			return enc.EncodeAll(src, make([]byte, 0, enc.MaxEncodedSize(len(src))))
+		},
+		zstd: enc,
+	}, nil
+}
+
+// finishBlock writes block header right before n bytes of compressed data
+// located at maxBlockHeaderLen offset of dst and returns the whole block.
+// src is returned if compression is useless.
+func finishBlock(id byte, src []byte, dst []byte, n int) []byte {
+	var hdr [maxBlockHeaderLen]byte
+	copy(hdr[:], blockMagic)
+	hdr[4] = id
+	hdrLen := 5 + binary.PutUvarint(hdr[5:], uint64(len(src)))
+	hdrLen += binary.PutUvarint(hdr[hdrLen:], uint64(n))
+
+	if hdrLen+n >= len(src) {
+		return src
+	}
+
+	off := maxBlockHeaderLen - hdrLen
+	copy(dst[off:], hdr[:hdrLen])
+	return dst[off : maxBlockHeaderLen+n]
+}
+
+var errInvalidBlock = errors.New("invalid compressed block")
+
+// parseBlockHeader parses header of the block-compressed data. Returns codec
+// ID, original and compressed data lengths and the header length.
+func parseBlockHeader(data []byte) (byte, uint64, uint64, int, error) {
+	if len(data) < 5 || !bytes.Equal(data[:4], blockMagic) {
+		return 0, 0, 0, 0, errInvalidBlock
+	}
+
+	srcLen, n := binary.Uvarint(data[5:])
+	if n <= 0 {
+		return 0, 0, 0, 0, errInvalidBlock
+	}
+	off := 5 + n
+
+	cmpLen, n := binary.Uvarint(data[off:])
+	if n <= 0 {
+		return 0, 0, 0, 0, errInvalidBlock
+	}
+	off += n
+
+	if cmpLen > math.MaxInt32 || srcLen > maxBlockRatio*cmpLen {
+		return 0, 0, 0, 0, fmt.Errorf("%w: unexpected lengths %d/%d", errInvalidBlock, srcLen, cmpLen)
+	}
+
+	return data[4], srcLen, cmpLen, off, nil
+}
+
+func decompressBlock(data []byte) ([]byte, error) {
+	id, srcLen, cmpLen, off, err := parseBlockHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)-off) != cmpLen {
+		return nil, fmt.Errorf("%w: wrong length %d, expected %d", errInvalidBlock, len(data)-off, cmpLen)
+	}
+
+	data = data[off:]
+	res := make([]byte, srcLen)
+
+	switch id {
+	case blockCodecLZ4:
+		n, err := lz4.UncompressBlock(data, res)
+		if err != nil {
+			return nil, fmt.Errorf("lz4: %w", err)
+		}
+		if uint64(n) != srcLen {
+			return nil, fmt.Errorf("lz4: %w: wrong decompressed length %d, expected %d", errInvalidBlock, n, srcLen)
+		}
+	case blockCodecSnappy:
+		out, err := s2.Decode(res, data)
+		if err != nil {
+			return nil, fmt.Errorf("snappy: %w", err)
+		}
+		if uint64(len(out)) != srcLen {
+			return nil, fmt.Errorf("snappy: %w: wrong decompressed length %d, expected %d", errInvalidBlock, len(out), srcLen)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown codec %d", errInvalidBlock, id)
+	}
+
+	return res, nil
+}
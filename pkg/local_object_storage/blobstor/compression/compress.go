@@ -2,9 +2,14 @@ package compression
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/klauspost/compress/zstd"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 )
 
@@ -13,8 +18,43 @@ type Config struct {
 	Enabled                    bool
 	UncompressableContentTypes []string
 
-	encoder *zstd.Encoder
+	// Codec is a name of the codec used by default, [Zstd] if empty.
+	Codec string
+	// ContainerCodecs overrides the codec for particular containers. It has
+	// precedence over ContainerPolicy.
+	ContainerCodecs map[cid.ID]string
+	// ContainerPolicy returns name of the codec requested for the container,
+	// empty if none. Unknown codecs are ignored.
+	ContainerPolicy func(cid.ID) string
+
+	// SampleSize is the size of data tail (which is a payload part of
+	// the object) compressed first to check whether the whole data is worth
+	// compressing. Zero disables sampling.
+	SampleSize int
+	// MinSavingRatio is the minimum share of data size compression must save
+	// to store the compressed data, otherwise data is stored as is. Zero
+	// means any saving.
+	MinSavingRatio float64
+	// Metrics receives compression statistics, optional.
+	Metrics Metrics
+
+	// Encryption encrypts compressed data and decrypts it before
+	// decompression, optional. Encryption is applied regardless of Enabled.
+	Encryption *encryption.Cipher
+
 	decoder *zstd.Decoder
+
+	encodersMtx sync.RWMutex
+	encoders    map[string]encoder
+}
+
+// Metrics is an interface that must store compression statistics.
+type Metrics interface {
+	// AddSavedBytes must add the number of bytes saved by compression.
+	AddSavedBytes(n int)
+	// IncSkipped must increment the number of objects stored uncompressed
+	// because compression is useless for them.
+	IncSkipped()
 }
 
 // zstdFrameMagic contains first 4 bytes of any compressed object
@@ -26,10 +66,17 @@ func (c *Config) Init() error {
 	var err error
 
 	if c.Enabled {
-		c.encoder, err = zstd.NewWriter(nil)
+		_, err = c.encoder(c.defaultCodec())
 		if err != nil {
 			return err
 		}
+
+		for cnr, codec := range c.ContainerCodecs {
+			_, err = c.encoder(codec)
+			if err != nil {
+				return fmt.Errorf("container %s: %w", cnr, err)
+			}
+		}
 	}
 
 	c.decoder, err = zstd.NewReader(nil)
@@ -40,6 +87,58 @@ func (c *Config) Init() error {
 	return nil
 }
 
+// CodecFor returns name of the codec used to compress objects of the given
+// container.
+func (c *Config) CodecFor(cnr cid.ID) string {
+	if !c.Enabled {
+		return None
+	}
+	if codec, ok := c.ContainerCodecs[cnr]; ok {
+		return codec
+	}
+	if c.ContainerPolicy != nil {
+		if codec := c.ContainerPolicy(cnr); IsKnownCodec(codec) {
+			return codec
+		}
+	}
+	return c.defaultCodec()
+}
+
+func (c *Config) defaultCodec() string {
+	if c.Codec == "" {
+		return Zstd
+	}
+	return c.Codec
+}
+
+// encoder returns cached encoder of the given codec creating it if needed.
+func (c *Config) encoder(name string) (encoder, error) {
+	c.encodersMtx.RLock()
+	enc, ok := c.encoders[name]
+	c.encodersMtx.RUnlock()
+	if ok {
+		return enc, nil
+	}
+
+	c.encodersMtx.Lock()
+	defer c.encodersMtx.Unlock()
+
+	if enc, ok = c.encoders[name]; ok {
+		return enc, nil
+	}
+	if c.encoders == nil {
+		c.encoders = make(map[string]encoder)
+	}
+
+	enc, err := newEncoder(name)
+	if err != nil {
+		return encoder{}, err
+	}
+	c.encoders[name] = enc
+
+	return enc, nil
+}
+
 // NeedsCompression returns true if the object should be compressed.
 // For an object to be compressed 2 conditions must hold:
 // 1. Compression is enabled in settings.
@@ -71,41 +170,144 @@ func (c *Config) NeedsCompression(obj *objectSDK.Object) bool {
 	return c.Enabled
 }
 
-// IsCompressed checks whether given data is compressed.
+// IsCompressed checks whether given data is compressed with any codec.
 func (c *Config) IsCompressed(data []byte) bool {
-	return len(data) >= 4 && bytes.Equal(data[:4], zstdFrameMagic)
+	return c.IsBlockCompressed(data) || len(data) >= 4 && bytes.Equal(data[:4], zstdFrameMagic)
 }
 
-// Decompress decompresses data if it starts with the magic
-// and returns data untouched otherwise.
+// IsBlockCompressed checks whether given data is compressed with a block
+// codec. Such data can only be decompressed as a whole, unlike Zstandard
+// frames that can be streamed.
+func (c *Config) IsBlockCompressed(data []byte) bool {
+	return len(data) >= 4 && bytes.Equal(data[:4], blockMagic)
+}
+
+// IsEncrypted checks whether given data is encrypted. Such data can only be
+// decrypted as a whole.
+func (c *Config) IsEncrypted(data []byte) bool {
+	return encryption.IsEncrypted(data)
+}
+
+// DataLen returns full length of the block-compressed or encrypted data by
+// its prefix containing the header.
+func (c *Config) DataLen(prefix []byte) (int, error) {
+	if c.IsEncrypted(prefix) {
+		return encryption.DataLen(prefix)
+	}
+
+	_, _, cmpLen, off, err := parseBlockHeader(prefix)
+	if err != nil {
+		return 0, err
+	}
+	return off + int(cmpLen), nil
+}
+
+// Decompress decrypts data if it is encrypted and decompresses it if it is
+// compressed with any codec. Data is returned untouched otherwise.
 func (c *Config) Decompress(data []byte) ([]byte, error) {
+	if c.IsEncrypted(data) {
+		if c == nil || c.Encryption == nil {
+			return nil, errors.New("data is encrypted, but no keys are provided")
+		}
+
+		var err error
+		data, err = c.Encryption.Decrypt(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.IsBlockCompressed(data) {
+		return decompressBlock(data)
+	}
 	if !c.IsCompressed(data) {
 		return data, nil
 	}
 	return c.DecompressForce(data)
 }
 
-// DecompressForce decompresses given compressed data.
+// DecompressForce decompresses given Zstandard-compressed data.
 func (c *Config) DecompressForce(data []byte) ([]byte, error) {
 	return c.decoder.DecodeAll(data, nil)
 }
 
-// Compress compresses data if compression is enabled
-// and returns data untouched otherwise.
-func (c *Config) Compress(data []byte) []byte {
-	if c == nil || !c.Enabled {
+// Compress compresses data of the given container with the codec selected
+// for it (see [Config.CodecFor]) if compression is enabled and returns data
+// untouched otherwise. Data is also returned untouched if compression doesn't
+// save enough space (see [Config.SampleSize] and [Config.MinSavingRatio]).
+// Then data is encrypted if [Config.Encryption] is set.
+func (c *Config) Compress(cnr cid.ID, data []byte) ([]byte, error) {
+	if c == nil {
+		return data, nil
+	}
+
+	data = c.compress(cnr, data)
+	if c.Encryption != nil {
+		return c.Encryption.Encrypt(data)
+	}
+	return data, nil
+}
+
+func (c *Config) compress(cnr cid.ID, data []byte) []byte {
+	if !c.Enabled {
 		return data
 	}
-	maxSize := c.encoder.MaxEncodedSize(len(data))
-	return c.encoder.EncodeAll(data, make([]byte, 0, maxSize))
+
+	codec := c.CodecFor(cnr)
+	if codec == None || len(data) == 0 {
+		return data
+	}
+
+	enc, err := c.encoder(codec)
+	if err != nil {
+		// unknown codec is reported by Init
+		return data
+	}
+
+	if c.SampleSize > 0 && len(data) > c.SampleSize {
+		sample := data[len(data)-c.SampleSize:]
+		if !c.worthCompressing(len(sample), len(enc.compress(sample))) {
+			c.reportSkipped()
+			return data
+		}
+	}
+
+	res := enc.compress(data)
+	if !c.worthCompressing(len(data), len(res)) {
+		c.reportSkipped()
+		return data
+	}
+
+	if c.Metrics != nil {
+		c.Metrics.AddSavedBytes(len(data) - len(res))
+	}
+	return res
+}
+
+func (c *Config) worthCompressing(size, compressedSize int) bool {
+	saved := size - compressedSize
+	return saved > 0 && float64(saved) >= c.MinSavingRatio*float64(size)
 }
 
-// Close closes encoder and decoder, returns any error occurred.
+func (c *Config) reportSkipped() {
+	if c.Metrics != nil {
+		c.Metrics.IncSkipped()
+	}
+}
+
+// Close closes encoders and decoder, returns any error occurred.
 func (c *Config) Close() error {
 	var err error
-	if c.encoder != nil {
-		err = c.encoder.Close()
+
+	c.encodersMtx.Lock()
+	for _, enc := range c.encoders {
+		if enc.zstd != nil {
+			err = errors.Join(err, enc.zstd.Close())
+		}
 	}
+	c.encoders = nil
+	c.encodersMtx.Unlock()
+
 	if c.decoder != nil {
 		c.decoder.Close()
 	}
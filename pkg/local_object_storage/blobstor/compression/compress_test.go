@@ -0,0 +1,277 @@
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecs(t *testing.T) {
+	compressible := bytes.Repeat([]byte("neofs object payload "), 1000)
+	random := make([]byte, 1000)
+	_, _ = rand.Read(random)
+
+	for _, codec := range Codecs() {
+		t.Run(codec, func(t *testing.T) {
+			c := &Config{Enabled: true, Codec: codec}
+			require.NoError(t, c.Init())
+			t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+			res := mustCompress(t, c, cidtest.ID(), compressible)
+			if codec == None {
+				require.Equal(t, compressible, res)
+			} else {
+				require.Less(t, len(res), len(compressible))
+				require.True(t, c.IsCompressed(res))
+			}
+
+			for _, data := range [][]byte{compressible, random, {}} {
+				dec, err := c.Decompress(mustCompress(t, c, cidtest.ID(), data))
+				require.NoError(t, err)
+				require.Equal(t, data, dec)
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		c := &Config{Enabled: true, Codec: "gzip"}
+		require.ErrorContains(t, c.Init(), "unknown compression codec")
+
+		c = &Config{Enabled: true, ContainerCodecs: map[cid.ID]string{cidtest.ID(): "gzip"}}
+		require.ErrorContains(t, c.Init(), "unknown compression codec")
+	})
+}
+
+func TestConfig_CodecFor(t *testing.T) {
+	cnrRule, cnrAttr, cnrBoth, cnrInvalid := cidtest.ID(), cidtest.ID(), cidtest.ID(), cidtest.ID()
+
+	c := &Config{
+		Enabled: true,
+		Codec:   LZ4,
+		ContainerCodecs: map[cid.ID]string{
+			cnrRule: ZstdBest,
+			cnrBoth: Snappy,
+		},
+		ContainerPolicy: func(cnr cid.ID) string {
+			switch cnr {
+			case cnrAttr, cnrBoth:
+				return ZstdFastest
+			case cnrInvalid:
+				return "gzip"
+			}
+			return ""
+		},
+	}
+	require.NoError(t, c.Init())
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	require.Equal(t, LZ4, c.CodecFor(cidtest.ID()))
+	require.Equal(t, ZstdBest, c.CodecFor(cnrRule))
+	require.Equal(t, ZstdFastest, c.CodecFor(cnrAttr))
+	require.Equal(t, Snappy, c.CodecFor(cnrBoth))
+	require.Equal(t, LZ4, c.CodecFor(cnrInvalid))
+
+	// any codec is decoded regardless of the configuration
+	data := bytes.Repeat([]byte{1, 2, 3, 4}, 1000)
+	for _, cnr := range []cid.ID{cnrRule, cnrAttr, cnrBoth, cnrInvalid} {
+		res := mustCompress(t, c, cnr, data)
+		require.True(t, c.IsCompressed(res))
+
+		other := &Config{}
+		require.NoError(t, other.Init())
+		dec, err := other.Decompress(res)
+		require.NoError(t, err)
+		require.Equal(t, data, dec)
+	}
+
+	c.Enabled = false
+	require.Equal(t, None, c.CodecFor(cnrRule))
+	require.Equal(t, data, mustCompress(t, c, cnrRule, data))
+
+	require.Equal(t, Zstd, (&Config{Enabled: true}).CodecFor(cidtest.ID()))
+}
+
+func TestConfig_Decompress(t *testing.T) {
+	c := &Config{}
+	require.NoError(t, c.Init())
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	data := bytes.Repeat([]byte{1, 2, 3, 4}, 1000)
+
+	t.Run("legacy zstd", func(t *testing.T) {
+		enc, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, enc.Close()) })
+
+		res, err := c.Decompress(enc.EncodeAll(data, nil))
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+	})
+
+	t.Run("uncompressed", func(t *testing.T) {
+		res, err := c.Decompress(data)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+	})
+
+	t.Run("block", func(t *testing.T) {
+		for _, codec := range []string{LZ4, Snappy} {
+			comp := &Config{Enabled: true, Codec: codec}
+			require.NoError(t, comp.Init())
+			block := mustCompress(t, comp, cid.ID{}, data)
+			require.NoError(t, comp.Close())
+
+			require.True(t, c.IsBlockCompressed(block))
+			l, err := c.DataLen(block[:maxBlockHeaderLen])
+			require.NoError(t, err)
+			require.Equal(t, len(block), l)
+
+			_, err = c.Decompress(block[:len(block)-1])
+			require.ErrorIs(t, err, errInvalidBlock)
+
+			corrupted := bytes.Clone(block)
+			corrupted[4] = 42
+			_, err = c.Decompress(corrupted)
+			require.ErrorIs(t, err, errInvalidBlock)
+		}
+	})
+}
+
+func mustCompress(t *testing.T, c *Config, cnr cid.ID, data []byte) []byte {
+	res, err := c.Compress(cnr, data)
+	require.NoError(t, err)
+	return res
+}
+
+type testMetrics struct {
+	saved   int
+	skipped int
+}
+
+func (m *testMetrics) AddSavedBytes(n int) { m.saved += n }
+
+func (m *testMetrics) IncSkipped() { m.skipped++ }
+
+func TestConfig_CompressAdaptive(t *testing.T) {
+	compressible := bytes.Repeat([]byte("neofs object payload "), 1000)
+	random := make([]byte, len(compressible))
+	_, _ = rand.Read(random)
+	// compressible header with incompressible payload
+	mixed := append(bytes.Clone(compressible[:len(compressible)/2]), random[:len(random)/2]...)
+
+	for _, codec := range []string{Zstd, ZstdFastest, LZ4, Snappy} {
+		t.Run(codec, func(t *testing.T) {
+			t.Run("no sampling", func(t *testing.T) {
+				var m testMetrics
+				c := &Config{Enabled: true, Codec: codec, Metrics: &m}
+				require.NoError(t, c.Init())
+				t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+				res := mustCompress(t, c, cid.ID{}, compressible)
+				require.Less(t, len(res), len(compressible))
+				require.Equal(t, len(compressible)-len(res), m.saved)
+				require.Zero(t, m.skipped)
+
+				require.Equal(t, random, mustCompress(t, c, cid.ID{}, random))
+				require.Equal(t, 1, m.skipped)
+
+				res = mustCompress(t, c, cid.ID{}, mixed)
+				require.Less(t, len(res), len(mixed))
+				require.Equal(t, 1, m.skipped)
+			})
+
+			t.Run("sampling", func(t *testing.T) {
+				var m testMetrics
+				c := &Config{Enabled: true, Codec: codec, SampleSize: 1024, Metrics: &m}
+				require.NoError(t, c.Init())
+				t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+				require.Less(t, len(mustCompress(t, c, cid.ID{}, compressible)), len(compressible))
+				require.Zero(t, m.skipped)
+
+				require.Equal(t, random, mustCompress(t, c, cid.ID{}, random))
+				require.Equal(t, mixed, mustCompress(t, c, cid.ID{}, mixed))
+				require.Equal(t, 2, m.skipped)
+
+				// data not exceeding sample size is compressed as a whole
+				res := mustCompress(t, c, cid.ID{}, compressible[:1024])
+				require.Less(t, len(res), 1024)
+				require.Equal(t, 2, m.skipped)
+			})
+
+			t.Run("min saving ratio", func(t *testing.T) {
+				var m testMetrics
+				c := &Config{Enabled: true, Codec: codec, MinSavingRatio: 0.6, Metrics: &m}
+				require.NoError(t, c.Init())
+				t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+				require.Less(t, len(mustCompress(t, c, cid.ID{}, compressible)), len(compressible))
+				// about a half is saved only
+				require.Equal(t, mixed, mustCompress(t, c, cid.ID{}, mixed))
+				require.Equal(t, 1, m.skipped)
+			})
+		})
+	}
+
+	t.Run("none", func(t *testing.T) {
+		var m testMetrics
+		c := &Config{Enabled: true, Codec: None, SampleSize: 1024, Metrics: &m}
+		require.NoError(t, c.Init())
+
+		require.Equal(t, compressible, mustCompress(t, c, cid.ID{}, compressible))
+		require.Zero(t, m.saved)
+		require.Zero(t, m.skipped)
+	})
+}
+
+func TestConfig_Encryption(t *testing.T) {
+	var k1, k2 encryption.Key
+	_, _ = rand.Read(k1[:])
+	_, _ = rand.Read(k2[:])
+
+	c1, err := encryption.New(&k1)
+	require.NoError(t, err)
+
+	compressible := bytes.Repeat([]byte("neofs object payload "), 1000)
+
+	for _, enabled := range []bool{false, true} {
+		c := &Config{Enabled: enabled, Codec: LZ4, Encryption: c1}
+		require.NoError(t, c.Init())
+		t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+		res := mustCompress(t, c, cid.ID{}, compressible)
+		require.True(t, c.IsEncrypted(res))
+		require.False(t, c.IsCompressed(res))
+		require.NotContains(t, string(res), "neofs object payload")
+		if enabled {
+			require.Less(t, len(res), len(compressible))
+		}
+
+		l, err := c.DataLen(res[:encryption.MaxHeaderLen])
+		require.NoError(t, err)
+		require.Equal(t, len(res), l)
+
+		dec, err := c.Decompress(res)
+		require.NoError(t, err)
+		require.Equal(t, compressible, dec)
+
+		_, err = (&Config{}).Decompress(res)
+		require.Error(t, err)
+
+		c2, err := encryption.New(&k2)
+		require.NoError(t, err)
+		_, err = (&Config{Encryption: c2}).Decompress(res)
+		require.ErrorIs(t, err, encryption.ErrUnknownKey)
+
+		// plain data is still readable
+		dec, err = c.Decompress(compressible)
+		require.NoError(t, err)
+		require.Equal(t, compressible, dec)
+	}
+}
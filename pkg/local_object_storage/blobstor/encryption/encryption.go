@@ -0,0 +1,245 @@
+/*
+Package encryption provides AES-GCM encryption of the stored object data.
+
+Encrypted data has the following format:
+  - 4-byte magic which starts neither protobuf-encoded object nor compressed
+    data;
+  - 4-byte ID of the key (first bytes of its SHA-256 hash);
+  - uvarint length of the following data;
+  - 12-byte random nonce;
+  - encrypted data with 16-byte authentication tag.
+
+The magic and the key ID are authenticated as additional data. Key ID allows
+to decrypt data written with any of the known keys, so keys can be rotated
+without downtime.
+*/
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+)
+
+// KeySize is the size of encryption key in bytes.
+const KeySize = 32
+
+// Key is an AES-256 encryption key.
+type Key [KeySize]byte
+
+// KeyID identifies the key used to encrypt the data.
+type KeyID [4]byte
+
+// String implements [fmt.Stringer].
+func (x KeyID) String() string {
+	return hex.EncodeToString(x[:])
+}
+
+// ID returns identifier of the key.
+func (k Key) ID() KeyID {
+	h := sha256.Sum256(k[:])
+	return KeyID(h[:4])
+}
+
+// keyDerivationDomain separates keys derived for the object data from any
+// other use of the same private key.
+const keyDerivationDomain = "neofs-node object data encryption"
+
+// DeriveKey derives encryption key from the private key, e.g. the node one.
+func DeriveKey(pk *ecdsa.PrivateKey) Key {
+	d := make([]byte, (pk.Curve.Params().N.BitLen()+7)/8)
+	pk.D.FillBytes(d)
+
+	h := sha256.New()
+	h.Write([]byte(keyDerivationDomain))
+	h.Write(d)
+	return Key(h.Sum(nil))
+}
+
+// ReadKeyFile reads hex-encoded key from the file.
+func ReadKeyFile(path string) (Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Key{}, fmt.Errorf("read key file: %w", err)
+	}
+
+	b, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return Key{}, fmt.Errorf("decode key file %s: %w", path, err)
+	}
+	if len(b) != KeySize {
+		return Key{}, fmt.Errorf("invalid key length in %s: %d instead of %d", path, len(b), KeySize)
+	}
+
+	return Key(b), nil
+}
+
+var (
+	// ErrUnknownKey is returned when data is encrypted with the key that is
+	// not known to the [Cipher].
+	ErrUnknownKey = logicerr.New("data is encrypted with unknown key")
+
+	errInvalidData = errors.New("invalid encrypted data")
+)
+
+var magic = []byte{0xfe, 'N', 'E', 'C'}
+
+const (
+	nonceSize    = 12
+	headerPrefix = 4 + len(KeyID{})
+	// MaxHeaderLen is the maximum length of the encrypted data header
+	// required by [DataLen].
+	MaxHeaderLen = headerPrefix + binary.MaxVarintLen64
+)
+
+// IsEncrypted checks whether data is encrypted.
+func IsEncrypted(data []byte) bool {
+	return len(data) >= len(magic) && bytes.Equal(data[:len(magic)], magic)
+}
+
+// KeyIDOf returns ID of the key data is encrypted with. Returns false if data
+// is not encrypted.
+func KeyIDOf(data []byte) (KeyID, bool) {
+	if !IsEncrypted(data) || len(data) < headerPrefix {
+		return KeyID{}, false
+	}
+	return KeyID(data[len(magic):headerPrefix]), true
+}
+
+func parseHeader(data []byte) (KeyID, uint64, int, error) {
+	id, ok := KeyIDOf(data)
+	if !ok {
+		return KeyID{}, 0, 0, errInvalidData
+	}
+
+	l, n := binary.Uvarint(data[headerPrefix:])
+	if n <= 0 || l < nonceSize || l > 1<<40 {
+		return KeyID{}, 0, 0, errInvalidData
+	}
+
+	return id, l, headerPrefix + n, nil
+}
+
+// DataLen returns full length of the encrypted data by its prefix of at
+// least [MaxHeaderLen] bytes (or less if data is shorter).
+func DataLen(prefix []byte) (int, error) {
+	_, l, off, err := parseHeader(prefix)
+	if err != nil {
+		return 0, err
+	}
+	return off + int(l), nil
+}
+
+// Cipher encrypts data with the active key and decrypts data encrypted with
+// any of the known keys.
+type Cipher struct {
+	active *KeyID
+	keys   map[KeyID]cipher.AEAD
+}
+
+// New creates [Cipher] encrypting data with the active key. Data encrypted
+// with the active or any of the old keys can be decrypted. Nil active key
+// means the data is stored unencrypted, old keys are used to read the data
+// encrypted previously.
+func New(active *Key, old ...Key) (*Cipher, error) {
+	c := &Cipher{keys: make(map[KeyID]cipher.AEAD, len(old)+1)}
+
+	add := func(k Key) error {
+		block, err := aes.NewCipher(k[:])
+		if err != nil {
+			return fmt.Errorf("could not create cipher block: %w", err)
+		}
+
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return fmt.Errorf("could not wrap cipher block in Galois Counter Mode: %w", err)
+		}
+
+		c.keys[k.ID()] = gcm
+		return nil
+	}
+
+	if active != nil {
+		if err := add(*active); err != nil {
+			return nil, err
+		}
+		id := active.ID()
+		c.active = &id
+	}
+
+	for i := range old {
+		if err := add(old[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// ActiveKeyID returns ID of the key data is encrypted with. Returns false if
+// there is no active key.
+func (c *Cipher) ActiveKeyID() (KeyID, bool) {
+	if c.active == nil {
+		return KeyID{}, false
+	}
+	return *c.active, true
+}
+
+// Encrypt encrypts data with the active key. Data is returned as is if there
+// is no active key.
+func (c *Cipher) Encrypt(data []byte) ([]byte, error) {
+	if c.active == nil {
+		return data, nil
+	}
+
+	var lenBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBuf[:], uint64(nonceSize+len(data)+c.keys[*c.active].Overhead()))
+
+	res := make([]byte, headerPrefix+n+nonceSize, headerPrefix+n+nonceSize+len(data)+c.keys[*c.active].Overhead())
+	copy(res, magic)
+	copy(res[len(magic):], c.active[:])
+	copy(res[headerPrefix:], lenBuf[:n])
+
+	nonce := res[headerPrefix+n:]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("could not init random nonce: %w", err)
+	}
+
+	return c.keys[*c.active].Seal(res, nonce, data, res[:headerPrefix]), nil
+}
+
+// Decrypt decrypts data encrypted with any of the known keys. Returns
+// [ErrUnknownKey] if the key is not known.
+func (c *Cipher) Decrypt(data []byte) ([]byte, error) {
+	id, l, off, err := parseHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)-off) != l {
+		return nil, fmt.Errorf("%w: wrong length %d, expected %d", errInvalidData, len(data)-off, l)
+	}
+
+	gcm, ok := c.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownKey, id)
+	}
+
+	res, err := gcm.Open(nil, data[off:off+nonceSize], data[off+nonceSize:], data[:headerPrefix])
+	if err != nil {
+		return nil, fmt.Errorf("decrypt with key %s: %w", id, err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,115 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func randKey() Key {
+	var k Key
+	_, _ = rand.Read(k[:])
+	return k
+}
+
+func TestCipher(t *testing.T) {
+	k1, k2 := randKey(), randKey()
+	data := []byte("object data")
+
+	c1, err := New(&k1)
+	require.NoError(t, err)
+
+	enc, err := c1.Encrypt(data)
+	require.NoError(t, err)
+	require.True(t, IsEncrypted(enc))
+	require.NotContains(t, string(enc), string(data))
+
+	id, ok := KeyIDOf(enc)
+	require.True(t, ok)
+	require.Equal(t, k1.ID(), id)
+
+	l, err := DataLen(enc[:MaxHeaderLen])
+	require.NoError(t, err)
+	require.Equal(t, len(enc), l)
+
+	dec, err := c1.Decrypt(enc)
+	require.NoError(t, err)
+	require.Equal(t, data, dec)
+
+	t.Run("rotation", func(t *testing.T) {
+		c2, err := New(&k2, k1)
+		require.NoError(t, err)
+
+		active, ok := c2.ActiveKeyID()
+		require.True(t, ok)
+		require.Equal(t, k2.ID(), active)
+
+		dec, err := c2.Decrypt(enc)
+		require.NoError(t, err)
+		require.Equal(t, data, dec)
+
+		enc2, err := c2.Encrypt(data)
+		require.NoError(t, err)
+		id, _ := KeyIDOf(enc2)
+		require.Equal(t, k2.ID(), id)
+
+		_, err = c1.Decrypt(enc2)
+		require.ErrorIs(t, err, ErrUnknownKey)
+	})
+
+	t.Run("decrypt only", func(t *testing.T) {
+		c, err := New(nil, k1)
+		require.NoError(t, err)
+
+		_, ok := c.ActiveKeyID()
+		require.False(t, ok)
+
+		res, err := c.Encrypt(data)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+
+		dec, err := c.Decrypt(enc)
+		require.NoError(t, err)
+		require.Equal(t, data, dec)
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		for _, i := range []int{len(magic), headerPrefix + 1, len(enc) - 1} {
+			corrupted := append([]byte(nil), enc...)
+			corrupted[i]++
+			_, err := c1.Decrypt(corrupted)
+			require.Error(t, err, i)
+		}
+
+		_, err := c1.Decrypt(enc[:len(enc)-1])
+		require.ErrorIs(t, err, errInvalidData)
+	})
+}
+
+func TestKeys(t *testing.T) {
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	k := DeriveKey(&pk.PrivateKey)
+	require.Equal(t, k, DeriveKey(&pk.PrivateKey))
+
+	d := make([]byte, 32)
+	pk.PrivateKey.D.FillBytes(d)
+	require.NotEqual(t, d, k[:])
+
+	path := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(k[:])+"\n"), 0o600))
+
+	res, err := ReadKeyFile(path)
+	require.NoError(t, err)
+	require.Equal(t, k, res)
+
+	require.NoError(t, os.WriteFile(path, []byte("abcd"), 0o600))
+	_, err = ReadKeyFile(path)
+	require.ErrorContains(t, err, "invalid key length")
+}
@@ -0,0 +1,89 @@
+package fstree
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// EncryptionKeyID implements [common.EncryptionKeyReader]. Only the beginning
+// of the object data is read.
+func (t *FSTree) EncryptionKeyID(addr oid.Address) (encryption.KeyID, bool, error) {
+	p := t.treePath(addr)
+
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return encryption.KeyID{}, false, logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+		return encryption.KeyID{}, false, fmt.Errorf("read file %q: %w", p, err)
+	}
+	defer f.Close()
+
+	var buf [encryption.MaxHeaderLen]byte
+	n, err := readObjectPrefix(addr.Object(), f, buf[:])
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return encryption.KeyID{}, false, logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+		return encryption.KeyID{}, false, fmt.Errorf("read object prefix from %q: %w", p, err)
+	}
+
+	id, ok := encryption.KeyIDOf(buf[:n])
+	return id, ok, nil
+}
+
+// readObjectPrefix reads the beginning of the stored object data into buf
+// and returns the number of bytes read. Combined files are searched for the
+// object with the given ID.
+func readObjectPrefix(id oid.ID, f *os.File, buf []byte) (int, error) {
+	var (
+		comBuf     [combinedDataOff]byte
+		isCombined bool
+	)
+
+	for {
+		n, err := io.ReadFull(f, comBuf[:])
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				if !isCombined {
+					return copy(buf, comBuf[:n]), nil
+				}
+				return 0, fs.ErrNotExist
+			}
+			return 0, err
+		}
+		thisOID, l := parseCombinedPrefix(comBuf[:])
+		if thisOID == nil {
+			if isCombined {
+				return 0, errors.New("malformed combined file")
+			}
+			n = copy(buf, comBuf[:n])
+			k, err := io.ReadFull(f, buf[n:])
+			if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				return 0, err
+			}
+			return n + k, nil
+		}
+		isCombined = true
+		if bytes.Equal(thisOID, id[:]) {
+			n, err := io.ReadFull(f, buf[:min(len(buf), int(l))])
+			if err != nil {
+				return 0, err
+			}
+			return n, nil
+		}
+		_, err = f.Seek(int64(l), io.SeekCurrent)
+		if err != nil {
+			return 0, err
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package fstree_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFSTree_EncryptionKeyID(t *testing.T) {
+	fsTree := fstree.New(fstree.WithPath(t.TempDir()))
+	require.NoError(t, fsTree.Open(false))
+	require.NoError(t, fsTree.Init())
+
+	var key encryption.Key
+	_, _ = rand.Read(key[:])
+	c, err := encryption.New(&key)
+	require.NoError(t, err)
+
+	_, _, err = fsTree.EncryptionKeyID(oidtest.Address())
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+	plain := generateTestObject(1024)
+	require.NoError(t, fsTree.Put(object.AddressOf(plain), plain.Marshal()))
+
+	fsTree.SetCompressor(&compression.Config{Encryption: c})
+
+	single := generateTestObject(1024)
+	require.NoError(t, fsTree.Put(object.AddressOf(single), single.Marshal()))
+
+	batch := make(map[oid.Address][]byte)
+	for range 10 {
+		obj := generateTestObject(128)
+		batch[object.AddressOf(obj)] = obj.Marshal()
+	}
+	require.NoError(t, fsTree.PutBatch(batch))
+
+	_, ok, err := fsTree.EncryptionKeyID(object.AddressOf(plain))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	id, ok, err := fsTree.EncryptionKeyID(object.AddressOf(single))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, key.ID(), id)
+
+	for addr := range batch {
+		id, ok, err := fsTree.EncryptionKeyID(addr)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, key.ID(), id)
+	}
+}
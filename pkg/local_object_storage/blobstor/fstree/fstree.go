@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
@@ -150,6 +151,37 @@ func (t *FSTree) Iterate(objHandler func(addr oid.Address, data []byte) error, e
 	return t.iterate(0, []string{t.RootPath}, objHandler, errorHandler, nil, nil)
 }
 
+// Partitions implements [common.Partitioned]. Top-level directories are the
+// partitions of the tree, tree of zero depth is a single partition with the
+// empty name.
+func (t *FSTree) Partitions() ([]string, error) {
+	if t.Depth == 0 {
+		return []string{""}, nil
+	}
+
+	des, err := os.ReadDir(t.RootPath)
+	if err != nil {
+		return nil, fmt.Errorf("read dir %q: %w", t.RootPath, err)
+	}
+
+	res := make([]string, 0, len(des))
+	for i := range des {
+		if des[i].IsDir() {
+			res = append(res, des[i].Name())
+		}
+	}
+
+	return res, nil
+}
+
+// IteratePartition implements [common.Partitioned].
+func (t *FSTree) IteratePartition(p string, objHandler func(addr oid.Address, data []byte) error, errorHandler func(addr oid.Address, err error) error) error {
+	if t.Depth == 0 || p == "" {
+		return t.Iterate(objHandler, errorHandler)
+	}
+	return t.iterate(1, []string{t.RootPath, p}, objHandler, errorHandler, nil, nil)
+}
+
 // IterateAddresses iterates over all objects stored in the underlying storage
 // and passes their addresses into f. If f returns an error, IterateAddresses
 // returns it and breaks. ignoreErrors allows to continue if internal errors
@@ -339,9 +371,12 @@ func (t *FSTree) Put(addr oid.Address, data []byte) error {
 	if err := util.MkdirAllX(filepath.Dir(p), t.Permissions); err != nil {
 		return fmt.Errorf("mkdirall for %q: %w", p, err)
 	}
-	data = t.Compress(data)
+	data, err := t.Compress(addr.Container(), data)
+	if err != nil {
+		return fmt.Errorf("compress object data: %w", err)
+	}
 
-	err := t.writer.writeData(addr.Object(), p, data)
+	err = t.writer.writeData(addr.Object(), p, data)
 	if err != nil {
 		return fmt.Errorf("write object data into file %q: %w", p, err)
 	}
@@ -360,10 +395,14 @@ func (t *FSTree) PutBatch(objs map[oid.Address][]byte) error {
 		if err := util.MkdirAllX(filepath.Dir(p), t.Permissions); err != nil {
 			return fmt.Errorf("mkdirall for %q: %w", p, err)
 		}
+		data, err := t.Compress(addr.Container(), data)
+		if err != nil {
+			return fmt.Errorf("compress object data: %w", err)
+		}
 		writeDataUnits = append(writeDataUnits, writeDataUnit{
 			id:   addr.Object(),
 			path: p,
-			data: t.Compress(data),
+			data: data,
 		})
 	}
 
@@ -375,6 +414,52 @@ func (t *FSTree) PutBatch(objs map[oid.Address][]byte) error {
 	return nil
 }
 
+// Rewrite implements common.Storage. Data is written into a temporary file
+// which then atomically replaces the existing one.
+func (t *FSTree) Rewrite(addr oid.Address, data []byte) error {
+	if t.readOnly {
+		return common.ErrReadOnly
+	}
+
+	p := t.treePath(addr)
+
+	if err := util.MkdirAllX(filepath.Dir(p), t.Permissions); err != nil {
+		return fmt.Errorf("mkdirall for %q: %w", p, err)
+	}
+	data, err := t.Compress(addr.Container(), data)
+	if err != nil {
+		return fmt.Errorf("compress object data: %w", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(p), filepath.Base(p)+"#rewrite*")
+	if err != nil {
+		return fmt.Errorf("create temporary file: %w", err)
+	}
+	tmpPath := f.Name()
+
+	_, err = f.Write(data)
+	if err == nil && !t.noSync {
+		err = f.Sync()
+	}
+	if errClose := f.Close(); err == nil {
+		err = errClose
+	}
+	if err == nil {
+		err = os.Chmod(tmpPath, t.Permissions)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, p)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		if errors.Is(err, syscall.ENOSPC) {
+			return common.ErrNoSpace
+		}
+		return fmt.Errorf("rewrite object data in file %q: %w", p, err)
+	}
+	return nil
+}
+
 // Get returns an object from the storage by address.
 func (t *FSTree) Get(addr oid.Address) (*objectSDK.Object, error) {
 	data, err := t.getObjBytes(addr)
@@ -126,7 +126,17 @@ func (t *FSTree) extractHeaderAndStream(id oid.ID, f *os.File) (*objectSDK.Objec
 // This function takes ownership of the io.ReadCloser and will close it if it does not return it.
 func (t *FSTree) readHeaderAndPayload(f io.ReadCloser, initial []byte) (*objectSDK.Object, io.ReadSeekCloser, error) {
 	var err error
-	if len(initial) < objectSDK.MaxHeaderLen {
+	full := len(initial) < objectSDK.MaxHeaderLen
+	if t.IsBlockCompressed(initial) || t.IsEncrypted(initial) {
+		// block-compressed and encrypted data can't be streamed, so it is read as a whole
+		initial, err = t.readBlock(f, initial)
+		if err != nil {
+			_ = f.Close()
+			return nil, nil, err
+		}
+		full = true
+	}
+	if full {
 		_ = f.Close()
 		initial, err = t.Decompress(initial)
 		if err != nil {
@@ -146,6 +156,26 @@ func (t *FSTree) readHeaderAndPayload(f io.ReadCloser, initial []byte) (*objectS
 	return t.readUntilPayload(f, initial)
 }
 
+// readBlock reads the rest of the block-compressed or encrypted data which
+// starts with initial from f.
+func (t *FSTree) readBlock(f io.Reader, initial []byte) ([]byte, error) {
+	l, err := t.DataLen(initial)
+	if err != nil {
+		return nil, fmt.Errorf("read data header: %w", err)
+	}
+	if len(initial) >= l {
+		return initial[:l], nil
+	}
+
+	data := make([]byte, l)
+	copy(data, initial)
+	_, err = io.ReadFull(f, data[len(initial):])
+	if err != nil {
+		return nil, fmt.Errorf("read data: %w", err)
+	}
+	return data, nil
+}
+
 // readUntilPayload reads an object from the file until the payload field is reached
 // and returns the object along with a reader for the remaining data.
 // This function takes ownership of the io.ReadCloser and will close it if it does not return it.
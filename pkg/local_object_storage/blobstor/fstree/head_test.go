@@ -1,11 +1,14 @@
 package fstree_test
 
 import (
+	"crypto/rand"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -130,6 +133,69 @@ func TestHeadStorage(t *testing.T) {
 			})
 		}
 	})
+
+	testConfig := func(t *testing.T, compressConfig *compression.Config) {
+		require.NoError(t, compressConfig.Init())
+
+		fsComp := fstree.New(fstree.WithPath(t.TempDir()))
+		fsComp.SetCompressor(compressConfig)
+
+		require.NoError(t, fsComp.Open(false))
+		require.NoError(t, fsComp.Init())
+
+		for _, size := range payloadSizes[1:] {
+			obj := generateTestObject(0)
+			obj.SetPayload(make([]byte, size))
+			obj.SetPayloadSize(uint64(size))
+			addr := object.AddressOf(obj)
+
+			require.NoError(t, fsComp.Put(addr, obj.Marshal()))
+
+			res, err := fsComp.Head(addr)
+			require.NoError(t, err)
+			require.Equal(t, obj.CutPayload(), res)
+
+			fullObj, err := fsComp.Get(addr)
+			require.NoError(t, err)
+			require.Equal(t, obj, fullObj)
+
+			stream, reader, err := fsComp.GetStream(addr)
+			require.NoError(t, err)
+			require.Equal(t, obj.CutPayload(), stream)
+			payload, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+			require.Equal(t, obj.Payload(), payload)
+		}
+	}
+
+	t.Run("compression codecs", func(t *testing.T) {
+		for _, codec := range compression.Codecs() {
+			t.Run(codec, func(t *testing.T) {
+				testConfig(t, &compression.Config{
+					Enabled: true,
+					Codec:   codec,
+				})
+			})
+		}
+	})
+
+	t.Run("encryption", func(t *testing.T) {
+		var key encryption.Key
+		_, _ = rand.Read(key[:])
+		c, err := encryption.New(&key)
+		require.NoError(t, err)
+
+		for _, codec := range []string{compression.None, compression.Zstd} {
+			t.Run(codec, func(t *testing.T) {
+				testConfig(t, &compression.Config{
+					Enabled:    true,
+					Codec:      codec,
+					Encryption: c,
+				})
+			})
+		}
+	})
 }
 
 func addAttribute(obj *objectSDK.Object, key, value string) {
@@ -41,6 +41,9 @@ func TestAll(t *testing.T, cons Constructor, minSize, maxSize uint64) {
 	t.Run("iterate", func(t *testing.T) {
 		TestIterate(t, cons, minSize, maxSize)
 	})
+	t.Run("rewrite", func(t *testing.T) {
+		TestRewrite(t, cons, minSize, maxSize)
+	})
 }
 
 func TestInfo(t *testing.T, cons Constructor, expectedType string, expectedPath string) {
@@ -45,6 +45,10 @@ func TestControl(t *testing.T, cons Constructor, minSize, maxSize uint64) {
 		})
 		require.ErrorIs(t, err, common.ErrReadOnly)
 	})
+	t.Run("rewrite fails", func(t *testing.T) {
+		err := s.Rewrite(objects[0].addr, objects[0].raw)
+		require.ErrorIs(t, err, common.ErrReadOnly)
+	})
 	t.Run("delete fails", func(t *testing.T) {
 		err := s.Delete(objects[0].addr)
 		require.ErrorIs(t, err, common.ErrReadOnly)
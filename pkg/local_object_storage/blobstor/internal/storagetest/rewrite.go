@@ -0,0 +1,52 @@
+package storagetest
+
+import (
+	"testing"
+
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewrite(t *testing.T, cons Constructor, minSize, maxSize uint64) {
+	s := cons(t)
+	require.NoError(t, s.Open(false))
+	require.NoError(t, s.Init())
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	objects := prepare(t, 2, s, minSize, maxSize)
+	objects = append(objects, prepareBatch(t, 2, s, minSize, maxSize)...)
+
+	for i := range objects {
+		// same object with another payload
+		obj := NewObject(maxSize - (maxSize-minSize)/2)
+		obj.SetContainerID(objects[i].addr.Container())
+		obj.SetID(objects[i].addr.Object())
+		raw := obj.Marshal()
+
+		require.NoError(t, s.Rewrite(objects[i].addr, raw))
+
+		res, err := s.GetBytes(objects[i].addr)
+		require.NoError(t, err)
+		require.Equal(t, raw, res)
+	}
+
+	var n int
+	require.NoError(t, s.Iterate(func(oid.Address, []byte) error {
+		n++
+		return nil
+	}, nil))
+	require.Equal(t, len(objects), n)
+
+	t.Run("missing object", func(t *testing.T) {
+		obj := NewObject(minSize)
+		raw := obj.Marshal()
+		addr := objects[0].addr
+		addr.SetObject(obj.GetID())
+
+		require.NoError(t, s.Rewrite(addr, raw))
+
+		res, err := s.GetBytes(addr)
+		require.NoError(t, err)
+		require.Equal(t, raw, res)
+	})
+}
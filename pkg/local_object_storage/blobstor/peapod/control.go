@@ -0,0 +1,70 @@
+package peapod
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"go.etcd.io/bbolt"
+)
+
+// Open implements common.Storage.
+func (p *Peapod) Open(ro bool) error {
+	if !ro {
+		err := util.MkdirAllX(filepath.Dir(p.path), p.perm)
+		if err != nil {
+			return fmt.Errorf("mkdir all for %q: %w", filepath.Dir(p.path), err)
+		}
+	}
+
+	db, err := bbolt.Open(p.path, p.perm, &bbolt.Options{
+		ReadOnly: ro,
+		NoSync:   p.noSync,
+		Timeout:  time.Second,
+	})
+	if err != nil {
+		return fmt.Errorf("open BoltDB file %q: %w", p.path, err)
+	}
+
+	db.MaxBatchDelay = p.flushInterval
+
+	p.db = db
+	p.readOnly = ro
+
+	return nil
+}
+
+// Init implements common.Storage.
+func (p *Peapod) Init() error {
+	if p.readOnly {
+		return nil
+	}
+
+	err := p.db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(rootBucket)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("create root bucket: %w", err)
+	}
+
+	return nil
+}
+
+// Close implements common.Storage.
+func (p *Peapod) Close() error {
+	if p.db == nil {
+		return nil
+	}
+
+	err := p.db.Close()
+	if err != nil && !errors.Is(err, bbolt.ErrDatabaseNotOpen) {
+		return fmt.Errorf("close BoltDB file %q: %w", p.path, err)
+	}
+
+	p.db = nil
+
+	return nil
+}
@@ -0,0 +1,20 @@
+/*
+Package peapod implements a storage subsystem that packs objects into a single
+BoltDB file.
+
+It's intended to be used for small objects that would otherwise be stored as
+separate files in FSTree creating lots of inodes and directory
+entries for a relatively small amount of data. Peapod keeps all objects in one
+large file instead, so millions of tiny objects do not put any additional
+pressure on the file system.
+
+All objects are stored in a single bucket, keys are 64-byte concatenations of
+container and object IDs, values are protobuf-encoded or ZSTD-compressed
+object data (the same as for FSTree files). Writes are collected
+into batches that are flushed to disk together (see [WithFlushInterval]), so
+concurrent writers share disk synchronization costs.
+
+Iteration is performed in chunks using separate read-only transactions, so
+long-running handlers do not prevent the file from growing.
+*/
+package peapod
@@ -0,0 +1,41 @@
+package peapod
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/internal/storagetest"
+)
+
+func TestGeneric(t *testing.T) {
+	dir := t.TempDir()
+
+	var n int
+	newPeapod := func(t *testing.T) common.Storage {
+		n++
+		return New(WithPath(filepath.Join(dir, strconv.Itoa(n), "peapod.db")))
+	}
+
+	storagetest.TestAll(t, newPeapod, 2048, 16*1024)
+
+	t.Run("info", func(t *testing.T) {
+		path := filepath.Join(dir, "info", "peapod.db")
+		storagetest.TestInfo(t, func(t *testing.T) common.Storage {
+			return New(WithPath(path))
+		}, Type, path)
+	})
+}
+
+func TestControl(t *testing.T) {
+	dir := t.TempDir()
+
+	var n int
+	newPeapod := func(t *testing.T) common.Storage {
+		n++
+		return New(WithPath(filepath.Join(dir, strconv.Itoa(n), "peapod.db")))
+	}
+
+	storagetest.TestControl(t, newPeapod, 2048, 2048)
+}
@@ -0,0 +1,38 @@
+package peapod
+
+import (
+	"io/fs"
+	"time"
+)
+
+// Option configures [Peapod].
+type Option func(*Peapod)
+
+// WithPath sets path to the BoltDB file.
+func WithPath(p string) Option {
+	return func(p2 *Peapod) {
+		p2.path = p
+	}
+}
+
+// WithPerm sets permission bits of the BoltDB file and its directory.
+func WithPerm(perm fs.FileMode) Option {
+	return func(p *Peapod) {
+		p.perm = perm
+	}
+}
+
+// WithNoSync disables fsync after each write batch.
+func WithNoSync(noSync bool) Option {
+	return func(p *Peapod) {
+		p.noSync = noSync
+	}
+}
+
+// WithFlushInterval sets maximum time interval for collecting concurrent
+// writes into a single batch.
+func WithFlushInterval(d time.Duration) Option {
+	return func(p *Peapod) {
+		p.flushInterval = d
+	}
+}
@@ -0,0 +1,406 @@
+package peapod
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// Type is peapod storage type used in logs and configuration.
+const Type = "peapod"
+
+const (
+	// keyLen is the length of the object key in the root bucket.
+	keyLen = cid.Size + oid.Size
+
+	// iterateBatchSize is the number of objects read within a single
+	// transaction during iteration.
+	iterateBatchSize = 1000
+)
+
+var rootBucket = []byte("root")
+
+// Peapod represents an object storage packing all objects into a single
+// BoltDB file.
+type Peapod struct {
+	path          string
+	perm          fs.FileMode
+	noSync        bool
+	flushInterval time.Duration
+
+	readOnly bool
+	db       *bbolt.DB
+
+	compress *compression.Config
+	log      *zap.Logger
+}
+
+var _ common.Storage = (*Peapod)(nil)
+
+// New returns new Peapod instance with the given options applied. The storage
+// must be opened and initialized before use.
+func New(opts ...Option) *Peapod {
+	p := &Peapod{
+		perm:          0o640,
+		flushInterval: 10 * time.Millisecond,
+		log:           zap.NewNop(),
+	}
+	for i := range opts {
+		opts[i](p)
+	}
+
+	return p
+}
+
+func objectKey(addr oid.Address) []byte {
+	key := make([]byte, keyLen)
+	cnr := addr.Container()
+	obj := addr.Object()
+	copy(key, cnr[:])
+	copy(key[cid.Size:], obj[:])
+	return key
+}
+
+func addressFromKey(key []byte) (oid.Address, error) {
+	var addr oid.Address
+	if len(key) != keyLen {
+		return addr, fmt.Errorf("invalid key length %d", len(key))
+	}
+	addr.SetContainer(cid.ID(key[:cid.Size]))
+	addr.SetObject(oid.ID(key[cid.Size:]))
+	return addr, nil
+}
+
+// Type implements common.Storage.
+func (*Peapod) Type() string {
+	return Type
+}
+
+// Path implements common.Storage.
+func (p *Peapod) Path() string {
+	return p.path
+}
+
+// SetCompressor implements common.Storage.
+func (p *Peapod) SetCompressor(cc *compression.Config) {
+	p.compress = cc
+}
+
+// SetLogger sets logger. It is used after the shard ID was generated to use it in logs.
+func (p *Peapod) SetLogger(l *zap.Logger) {
+	p.log = l.With(zap.String("substorage", Type))
+}
+
+// Put puts an object in the storage.
+func (p *Peapod) Put(addr oid.Address, data []byte) error {
+	if p.readOnly {
+		return common.ErrReadOnly
+	}
+
+	data, err := p.compress.Compress(addr.Container(), data)
+	if err != nil {
+		return fmt.Errorf("compress object data: %w", err)
+	}
+
+	err = p.db.Batch(func(tx *bbolt.Tx) error {
+		return tx.Bucket(rootBucket).Put(objectKey(addr), data)
+	})
+	if err != nil {
+		return fmt.Errorf("put object into BoltDB: %w", err)
+	}
+
+	return nil
+}
+
+// Rewrite implements common.Storage. It is the same as Put since Put
+// overwrites existing objects.
+func (p *Peapod) Rewrite(addr oid.Address, data []byte) error {
+	return p.Put(addr, data)
+}
+
+// PutBatch puts a batch of objects in the storage.
+func (p *Peapod) PutBatch(objs map[oid.Address][]byte) error {
+	if p.readOnly {
+		return common.ErrReadOnly
+	}
+
+	items := make([]addressData, 0, len(objs))
+	for addr, data := range objs {
+		data, err := p.compress.Compress(addr.Container(), data)
+		if err != nil {
+			return fmt.Errorf("compress object data: %w", err)
+		}
+		items = append(items, addressData{addr: addr, data: data})
+	}
+
+	err := p.db.Batch(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(rootBucket)
+		for i := range items {
+			if err := b.Put(objectKey(items[i].addr), items[i].data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("put objects into BoltDB: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the object with the specified address from the storage.
+func (p *Peapod) Delete(addr oid.Address) error {
+	if p.readOnly {
+		return common.ErrReadOnly
+	}
+
+	exists, err := p.Exists(addr)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+
+	// missing key is not an error for BoltDB, so concurrent removal of the
+	// same object is OK here
+	err = p.db.Batch(func(tx *bbolt.Tx) error {
+		return tx.Bucket(rootBucket).Delete(objectKey(addr))
+	})
+	if err != nil {
+		return fmt.Errorf("delete object from BoltDB: %w", err)
+	}
+
+	return nil
+}
+
+// Exists checks whether the object is stored.
+func (p *Peapod) Exists(addr oid.Address) (bool, error) {
+	var exists bool
+
+	err := p.db.View(func(tx *bbolt.Tx) error {
+		if b := tx.Bucket(rootBucket); b != nil {
+			exists = b.Get(objectKey(addr)) != nil
+		}
+		return nil
+	})
+
+	return exists, err
+}
+
+// GetBytes reads object from the Peapod by address into memory buffer in a
+// canonical NeoFS binary format. Returns [apistatus.ObjectNotFound] if object
+// is missing.
+func (p *Peapod) GetBytes(addr oid.Address) ([]byte, error) {
+	var data []byte
+
+	err := p.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(rootBucket)
+		if b == nil {
+			return logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+
+		val := b.Get(objectKey(addr))
+		if val == nil {
+			return logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+
+		data = bytes.Clone(val)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = p.compress.Decompress(data)
+	if err != nil {
+		return nil, fmt.Errorf("decompress object data: %w", err)
+	}
+
+	return data, nil
+}
+
+// EncryptionKeyID implements [common.EncryptionKeyReader].
+func (p *Peapod) EncryptionKeyID(addr oid.Address) (encryption.KeyID, bool, error) {
+	var (
+		id encryption.KeyID
+		ok bool
+	)
+
+	err := p.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(rootBucket)
+		if b == nil {
+			return logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+
+		val := b.Get(objectKey(addr))
+		if val == nil {
+			return logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+
+		id, ok = encryption.KeyIDOf(val)
+		return nil
+	})
+
+	return id, ok, err
+}
+
+// Get returns an object from the storage by address.
+func (p *Peapod) Get(addr oid.Address) (*objectSDK.Object, error) {
+	data, err := p.GetBytes(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("decode object: %w", err)
+	}
+
+	return obj, nil
+}
+
+// Head returns an object's header from the storage by address. Objects are
+// small, so they are read in full.
+func (p *Peapod) Head(addr oid.Address) (*objectSDK.Object, error) {
+	obj, err := p.Get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.CutPayload(), nil
+}
+
+// GetRange implements common.Storage.
+func (p *Peapod) GetRange(addr oid.Address, from uint64, length uint64) ([]byte, error) {
+	obj, err := p.Get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := obj.Payload()
+	pLen := uint64(len(payload))
+	var to uint64
+	if length != 0 {
+		to = from + length
+	} else {
+		to = pLen
+	}
+
+	if to < from || pLen < from || pLen < to {
+		return nil, logicerr.Wrap(apistatus.ErrObjectOutOfRange)
+	}
+
+	return payload[from:to], nil
+}
+
+// Iterate iterates over all stored objects.
+func (p *Peapod) Iterate(objHandler func(addr oid.Address, data []byte) error, errorHandler func(addr oid.Address, err error) error) error {
+	return p.iterate(func(addr oid.Address, data []byte) error {
+		data, err := p.compress.Decompress(data)
+		if err != nil {
+			err = fmt.Errorf("decompress object data: %w", err)
+			if errorHandler != nil {
+				return errorHandler(addr, err)
+			}
+			return err
+		}
+		return objHandler(addr, data)
+	}, errorHandler, true)
+}
+
+// IterateAddresses iterates over all objects stored in the underlying storage
+// and passes their addresses into f. If f returns an error, IterateAddresses
+// returns it and breaks. ignoreErrors allows to continue if internal errors
+// happen.
+func (p *Peapod) IterateAddresses(f func(addr oid.Address) error, ignoreErrors bool) error {
+	var errorHandler func(oid.Address, error) error
+	if ignoreErrors {
+		errorHandler = func(oid.Address, error) error { return nil }
+	}
+
+	return p.iterate(func(addr oid.Address, _ []byte) error {
+		return f(addr)
+	}, errorHandler, false)
+}
+
+type addressData struct {
+	addr oid.Address
+	data []byte
+}
+
+// iterate passes stored objects to f in batches read in separate transactions,
+// so f can access the storage itself. Data is read only if withData is set.
+func (p *Peapod) iterate(f func(oid.Address, []byte) error, errorHandler func(oid.Address, error) error, withData bool) error {
+	var (
+		batch = make([]addressData, 0, iterateBatchSize)
+		last  []byte
+	)
+
+	for {
+		batch = batch[:0]
+
+		err := p.db.View(func(tx *bbolt.Tx) error {
+			b := tx.Bucket(rootBucket)
+			if b == nil {
+				return nil
+			}
+
+			c := b.Cursor()
+			k, v := c.First()
+			if last != nil {
+				k, v = c.Seek(last)
+				if bytes.Equal(k, last) {
+					k, v = c.Next()
+				}
+			}
+
+			for ; k != nil && len(batch) < iterateBatchSize; k, v = c.Next() {
+				last = bytes.Clone(k)
+
+				addr, err := addressFromKey(k)
+				if err != nil {
+					if errorHandler != nil {
+						if err = errorHandler(oid.Address{}, err); err == nil {
+							continue
+						}
+					}
+					return fmt.Errorf("decode object address from key %x: %w", k, err)
+				}
+
+				var data []byte
+				if withData {
+					data = bytes.Clone(v)
+				}
+				batch = append(batch, addressData{addr: addr, data: data})
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range batch {
+			if err := f(batch[i].addr, batch[i].data); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < iterateBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package router
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/internal/storagetest"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/peapod"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func newRouter(dir string, smallLimit uint64) *Router {
+	return New(
+		peapod.New(peapod.WithPath(filepath.Join(dir, "peapod.db"))),
+		fstree.New(fstree.WithPath(filepath.Join(dir, "fstree")), fstree.WithDepth(2), fstree.WithDirNameLen(2)),
+		smallLimit)
+}
+
+func TestGeneric(t *testing.T) {
+	dir := t.TempDir()
+
+	var n int
+	newStorage := func(t *testing.T) common.Storage {
+		n++
+		return newRouter(filepath.Join(dir, strconv.Itoa(n)), 8*1024)
+	}
+
+	storagetest.TestAll(t, newStorage, 2048, 16*1024)
+
+	t.Run("info", func(t *testing.T) {
+		d := filepath.Join(dir, "info")
+		storagetest.TestInfo(t, func(t *testing.T) common.Storage {
+			return newRouter(d, 8*1024)
+		}, Type, filepath.Join(d, "fstree"))
+	})
+}
+
+func TestControl(t *testing.T) {
+	dir := t.TempDir()
+
+	var n int
+	newStorage := func(t *testing.T) common.Storage {
+		n++
+		return newRouter(filepath.Join(dir, strconv.Itoa(n)), 2048)
+	}
+
+	storagetest.TestControl(t, newStorage, 1024, 4096)
+}
+
+func TestRouting(t *testing.T) {
+	r := newRouter(t.TempDir(), 4096)
+	require.NoError(t, r.Open(false))
+	require.NoError(t, r.Init())
+	t.Cleanup(func() { require.NoError(t, r.Close()) })
+
+	small := storagetest.NewObject(1024)
+	big := storagetest.NewObject(8192)
+	smallAddr := objectCore.AddressOf(small)
+	bigAddr := objectCore.AddressOf(big)
+
+	require.NoError(t, r.Put(smallAddr, small.Marshal()))
+	require.NoError(t, r.PutBatch(map[oid.Address][]byte{bigAddr: big.Marshal()}))
+
+	exists, err := r.Small().Exists(smallAddr)
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = r.Main().Exists(smallAddr)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	exists, err = r.Small().Exists(bigAddr)
+	require.NoError(t, err)
+	require.False(t, exists)
+	exists, err = r.Main().Exists(bigAddr)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	t.Run("limit change", func(t *testing.T) {
+		// objects put before limit change must be available anyway
+		r.smallLimit = 0
+		for _, obj := range []*objectSDK.Object{small, big} {
+			res, err := r.Get(objectCore.AddressOf(obj))
+			require.NoError(t, err)
+			require.Equal(t, obj, res)
+		}
+		require.NoError(t, r.Delete(smallAddr))
+		require.ErrorAs(t, r.Delete(smallAddr), new(apistatus.ObjectNotFound))
+	})
+}
+
+func TestPartitions(t *testing.T) {
+	r := newRouter(t.TempDir(), 4096)
+	require.NoError(t, r.Open(false))
+	require.NoError(t, r.Init())
+	t.Cleanup(func() { require.NoError(t, r.Close()) })
+
+	exp := make(map[oid.Address]struct{})
+	for i := range 20 {
+		obj := storagetest.NewObject(1024 + uint64(i%2)*8192)
+		addr := objectCore.AddressOf(obj)
+		require.NoError(t, r.Put(addr, obj.Marshal()))
+		exp[addr] = struct{}{}
+	}
+
+	ps, err := r.Partitions()
+	require.NoError(t, err)
+	require.Contains(t, ps, smallPartition)
+	require.Greater(t, len(ps), 2)
+
+	got := make(map[oid.Address]struct{})
+	for _, p := range ps {
+		require.NoError(t, r.IteratePartition(p, func(addr oid.Address, data []byte) error {
+			require.NotContains(t, got, addr)
+			got[addr] = struct{}{}
+			return nil
+		}, nil))
+	}
+	require.Equal(t, exp, got)
+
+	require.Error(t, r.IteratePartition("unknown", func(oid.Address, []byte) error { return nil }, nil))
+}
@@ -0,0 +1,314 @@
+// Package router implements a storage subsystem distributing objects between
+// two sub-storages by their size.
+//
+// Objects not bigger than the configured limit are put into the storage for
+// small objects (like peapod), others go to the main one (like FSTree). Reads
+// and removals check both sub-storages since the limit can change between
+// restarts, small objects are looked up first.
+package router
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// Type is router storage type used in logs.
+const Type = "router"
+
+// Router routes objects between two sub-storages by their size.
+type Router struct {
+	small, main common.Storage
+	smallLimit  uint64
+}
+
+var _ common.Storage = (*Router)(nil)
+
+// New returns new Router putting objects with binary size not bigger than
+// smallLimit into small storage and all others into main storage.
+func New(small, main common.Storage, smallLimit uint64) *Router {
+	return &Router{
+		small:      small,
+		main:       main,
+		smallLimit: smallLimit,
+	}
+}
+
+// Small returns sub-storage for small objects.
+func (r *Router) Small() common.Storage {
+	return r.small
+}
+
+// Main returns sub-storage for big objects.
+func (r *Router) Main() common.Storage {
+	return r.main
+}
+
+func (r *Router) storageFor(data []byte) common.Storage {
+	if uint64(len(data)) <= r.smallLimit {
+		return r.small
+	}
+	return r.main
+}
+
+// Open implements common.Storage.
+func (r *Router) Open(readOnly bool) error {
+	if err := r.small.Open(readOnly); err != nil {
+		return fmt.Errorf("open %s sub-storage: %w", r.small.Type(), err)
+	}
+	if err := r.main.Open(readOnly); err != nil {
+		_ = r.small.Close()
+		return fmt.Errorf("open %s sub-storage: %w", r.main.Type(), err)
+	}
+	return nil
+}
+
+// Init implements common.Storage.
+func (r *Router) Init() error {
+	if err := r.small.Init(); err != nil {
+		return fmt.Errorf("init %s sub-storage: %w", r.small.Type(), err)
+	}
+	if err := r.main.Init(); err != nil {
+		return fmt.Errorf("init %s sub-storage: %w", r.main.Type(), err)
+	}
+	return nil
+}
+
+// Close implements common.Storage.
+func (r *Router) Close() error {
+	var errs []error
+	if err := r.small.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("close %s sub-storage: %w", r.small.Type(), err))
+	}
+	if err := r.main.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("close %s sub-storage: %w", r.main.Type(), err))
+	}
+	return errors.Join(errs...)
+}
+
+// Type implements common.Storage.
+func (*Router) Type() string {
+	return Type
+}
+
+// Path implements common.Storage. Path of the main sub-storage is returned.
+func (r *Router) Path() string {
+	return r.main.Path()
+}
+
+// SetLogger implements common.Storage.
+func (r *Router) SetLogger(l *zap.Logger) {
+	r.small.SetLogger(l)
+	r.main.SetLogger(l)
+}
+
+// SetCompressor implements common.Storage.
+func (r *Router) SetCompressor(cc *compression.Config) {
+	r.small.SetCompressor(cc)
+	r.main.SetCompressor(cc)
+}
+
+// GetBytes implements common.Storage.
+func (r *Router) GetBytes(addr oid.Address) ([]byte, error) {
+	data, err := r.small.GetBytes(addr)
+	if errors.As(err, new(apistatus.ObjectNotFound)) {
+		return r.main.GetBytes(addr)
+	}
+	return data, err
+}
+
+// Get implements common.Storage.
+func (r *Router) Get(addr oid.Address) (*objectSDK.Object, error) {
+	obj, err := r.small.Get(addr)
+	if errors.As(err, new(apistatus.ObjectNotFound)) {
+		return r.main.Get(addr)
+	}
+	return obj, err
+}
+
+// GetRange implements common.Storage.
+func (r *Router) GetRange(addr oid.Address, from uint64, length uint64) ([]byte, error) {
+	data, err := r.small.GetRange(addr, from, length)
+	if errors.As(err, new(apistatus.ObjectNotFound)) {
+		return r.main.GetRange(addr, from, length)
+	}
+	return data, err
+}
+
+// Head implements common.Storage.
+func (r *Router) Head(addr oid.Address) (*objectSDK.Object, error) {
+	obj, err := r.small.Head(addr)
+	if errors.As(err, new(apistatus.ObjectNotFound)) {
+		return r.main.Head(addr)
+	}
+	return obj, err
+}
+
+// EncryptionKeyID implements [common.EncryptionKeyReader]. Returns
+// [errors.ErrUnsupported] if any of the sub-storages does not implement it.
+func (r *Router) EncryptionKeyID(addr oid.Address) (encryption.KeyID, bool, error) {
+	for _, s := range []common.Storage{r.small, r.main} {
+		kr, ok := s.(common.EncryptionKeyReader)
+		if !ok {
+			return encryption.KeyID{}, false, errors.ErrUnsupported
+		}
+		id, ok, err := kr.EncryptionKeyID(addr)
+		if !errors.As(err, new(apistatus.ObjectNotFound)) {
+			return id, ok, err
+		}
+	}
+	return encryption.KeyID{}, false, logicerr.Wrap(apistatus.ObjectNotFound{})
+}
+
+// Exists implements common.Storage.
+func (r *Router) Exists(addr oid.Address) (bool, error) {
+	exists, err := r.small.Exists(addr)
+	if err != nil || exists {
+		return exists, err
+	}
+	return r.main.Exists(addr)
+}
+
+// Put implements common.Storage.
+func (r *Router) Put(addr oid.Address, data []byte) error {
+	return r.storageFor(data).Put(addr, data)
+}
+
+// PutBatch implements common.Storage.
+func (r *Router) PutBatch(objs map[oid.Address][]byte) error {
+	var small, main map[oid.Address][]byte
+	for addr, data := range objs {
+		if r.storageFor(data) == r.small {
+			if small == nil {
+				small = make(map[oid.Address][]byte)
+			}
+			small[addr] = data
+		} else {
+			if main == nil {
+				main = make(map[oid.Address][]byte)
+			}
+			main[addr] = data
+		}
+	}
+
+	if len(small) > 0 {
+		if err := r.small.PutBatch(small); err != nil {
+			return err
+		}
+	}
+	if len(main) > 0 {
+		return r.main.PutBatch(main)
+	}
+	return nil
+}
+
+// Rewrite implements common.Storage. The object is rewritten in the
+// sub-storage selected for the data and removed from the other one.
+func (r *Router) Rewrite(addr oid.Address, data []byte) error {
+	dst, other := r.small, r.main
+	if r.storageFor(data) == r.main {
+		dst, other = r.main, r.small
+	}
+
+	if err := dst.Rewrite(addr, data); err != nil {
+		return err
+	}
+
+	err := other.Delete(addr)
+	if err != nil && !errors.As(err, new(apistatus.ObjectNotFound)) {
+		return err
+	}
+	return nil
+}
+
+// Delete implements common.Storage. The object is removed from both
+// sub-storages.
+func (r *Router) Delete(addr oid.Address) error {
+	var found bool
+
+	for _, s := range []common.Storage{r.small, r.main} {
+		err := s.Delete(addr)
+		if err == nil {
+			found = true
+			continue
+		}
+		if !errors.As(err, new(apistatus.ObjectNotFound)) {
+			return err
+		}
+	}
+
+	if !found {
+		return logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+	return nil
+}
+
+// Iterate implements common.Storage.
+func (r *Router) Iterate(objHandler func(addr oid.Address, data []byte) error, errorHandler func(addr oid.Address, err error) error) error {
+	if err := r.small.Iterate(objHandler, errorHandler); err != nil {
+		return err
+	}
+	return r.main.Iterate(objHandler, errorHandler)
+}
+
+// Names of the [Router] partitions. Partitions of the main storage are
+// prefixed with mainPartitionPrefix.
+const (
+	smallPartition      = "small"
+	mainPartitionPrefix = "main/"
+)
+
+// Partitions implements [common.Partitioned]. Small storage is a single
+// partition, main storage is split into its own partitions if it supports it.
+func (r *Router) Partitions() ([]string, error) {
+	res := []string{smallPartition}
+
+	p, ok := r.main.(common.Partitioned)
+	if !ok {
+		return append(res, mainPartitionPrefix), nil
+	}
+
+	ps, err := p.Partitions()
+	if err != nil {
+		return nil, err
+	}
+	for i := range ps {
+		res = append(res, mainPartitionPrefix+ps[i])
+	}
+
+	return res, nil
+}
+
+// IteratePartition implements [common.Partitioned].
+func (r *Router) IteratePartition(name string, objHandler func(addr oid.Address, data []byte) error, errorHandler func(addr oid.Address, err error) error) error {
+	if name == smallPartition {
+		return r.small.Iterate(objHandler, errorHandler)
+	}
+
+	mp, ok := strings.CutPrefix(name, mainPartitionPrefix)
+	if !ok {
+		return fmt.Errorf("unknown partition %q", name)
+	}
+
+	if p, ok := r.main.(common.Partitioned); ok {
+		return p.IteratePartition(mp, objHandler, errorHandler)
+	}
+	return r.main.Iterate(objHandler, errorHandler)
+}
+
+// IterateAddresses implements common.Storage.
+func (r *Router) IterateAddresses(f func(addr oid.Address) error, ignoreErrors bool) error {
+	if err := r.small.IterateAddresses(f, ignoreErrors); err != nil {
+		return err
+	}
+	return r.main.IterateAddresses(f, ignoreErrors)
+}
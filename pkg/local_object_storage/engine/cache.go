@@ -0,0 +1,274 @@
+package engine
+
+import (
+	"container/list"
+	"sync"
+
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Object cache operations.
+const (
+	cacheOpGet  = "get"
+	cacheOpHead = "head"
+)
+
+// WithObjectCache returns an option to enable in-memory cache of the object
+// headers and objects read by [StorageEngine.Get] and [StorageEngine.Head].
+// capacity limits the total size of the cached objects in bytes, objects
+// with payload bigger than maxObjectSize are cached without payload, so only
+// headers are served from the cache for them. Zero capacity disables cache
+// (default).
+func WithObjectCache(capacity, maxObjectSize uint64) Option {
+	return func(c *cfg) {
+		c.objCacheCapacity = capacity
+		c.objCacheMaxObjectSize = maxObjectSize
+	}
+}
+
+// cachedObject is an element of the objectCache.
+type cachedObject struct {
+	addr oid.Address
+	obj  *objectSDK.Object
+	// payload is set if obj contains payload.
+	payload bool
+	// raw is set if obj is the stored object and not the header of the parent
+	// object assembled from its children, so it can be served to any request.
+	raw  bool
+	size uint64
+}
+
+// objectCache is an LRU cache of the objects bounded by their total size.
+// Cached objects are removed on each operation that can make them
+// unavailable, so removed objects are never served.
+type objectCache struct {
+	capacity      uint64
+	maxObjectSize uint64
+
+	mtx   sync.Mutex
+	size  uint64
+	gen   uint64 // incremented on each invalidation
+	lru   list.List
+	items map[oid.Address]*list.Element
+	// removing counts running operations that can make the objects
+	// unavailable, such objects are not cached until they are finished.
+	removing map[oid.Address]uint
+}
+
+func newObjectCache(capacity, maxObjectSize uint64) *objectCache {
+	return &objectCache{
+		capacity:      capacity,
+		maxObjectSize: maxObjectSize,
+		items:         make(map[oid.Address]*list.Element),
+		removing:      make(map[oid.Address]uint),
+	}
+}
+
+// generation returns the current cache generation that must be passed to
+// put for objects read after this call. Objects read before the invalidation
+// are not cached then.
+func (c *objectCache) generation() uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.gen
+}
+
+// get returns a copy of the cached object. payload is set if the object
+// must be returned with payload, raw is set if the parent object header
+// must not be returned.
+func (c *objectCache) get(addr oid.Address, payload, raw bool) (*objectSDK.Object, bool) {
+	c.mtx.Lock()
+	el, ok := c.items[addr]
+	if ok {
+		e := el.Value.(*cachedObject)
+		ok = (!payload || e.payload) && (!raw || e.raw)
+	}
+	if !ok {
+		c.mtx.Unlock()
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	obj := el.Value.(*cachedObject).obj
+	c.mtx.Unlock()
+
+	// cached objects are never changed, so they can be copied without lock
+	var res objectSDK.Object
+	if payload {
+		obj.CopyTo(&res)
+	} else {
+		obj.CutPayload().CopyTo(&res)
+	}
+	return &res, true
+}
+
+// put caches a copy of the object read at the given generation if there were
+// no invalidations after it.
+func (c *objectCache) put(gen uint64, addr oid.Address, obj *objectSDK.Object, payload, raw bool) {
+	if payload && uint64(len(obj.Payload())) > c.maxObjectSize {
+		payload = false
+	}
+
+	e := &cachedObject{
+		addr:    addr,
+		obj:     new(objectSDK.Object),
+		payload: payload,
+		raw:     raw,
+	}
+	if payload {
+		obj.CopyTo(e.obj)
+	} else {
+		obj.CutPayload().CopyTo(e.obj)
+	}
+	e.size = uint64(e.obj.HeaderLen() + len(e.obj.Payload()))
+	if e.size > c.capacity {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if gen != c.gen || c.removing[addr] > 0 {
+		return
+	}
+
+	if el, ok := c.items[addr]; ok {
+		old := el.Value.(*cachedObject)
+		if old.payload && !payload || old.raw && !raw {
+			c.lru.MoveToFront(el)
+			return
+		}
+		c.remove(el)
+	}
+
+	c.items[addr] = c.lru.PushFront(e)
+	c.size += e.size
+
+	for c.size > c.capacity {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *objectCache) remove(el *list.Element) {
+	e := c.lru.Remove(el).(*cachedObject)
+	delete(c.items, e.addr)
+	c.size -= e.size
+}
+
+// invalidate removes objects from the cache.
+func (c *objectCache) invalidate(addrs ...oid.Address) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.gen++
+	for i := range addrs {
+		if el, ok := c.items[addrs[i]]; ok {
+			c.remove(el)
+		}
+	}
+}
+
+// startRemoval removes objects from the cache and stops caching them until
+// finishRemoval is called for them, so the objects are not served while the
+// operation making them unavailable is running.
+func (c *objectCache) startRemoval(addrs ...oid.Address) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.gen++
+	for i := range addrs {
+		c.removing[addrs[i]]++
+		if el, ok := c.items[addrs[i]]; ok {
+			c.remove(el)
+		}
+	}
+}
+
+// finishRemoval invalidates objects passed to startRemoval and allows caching
+// them again.
+func (c *objectCache) finishRemoval(addrs ...oid.Address) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.gen++
+	for i := range addrs {
+		if n := c.removing[addrs[i]]; n > 1 {
+			c.removing[addrs[i]] = n - 1
+		} else {
+			delete(c.removing, addrs[i])
+		}
+		if el, ok := c.items[addrs[i]]; ok {
+			c.remove(el)
+		}
+	}
+}
+
+// purge removes all objects from the cache.
+func (c *objectCache) purge() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.gen++
+	c.lru.Init()
+	clear(c.items)
+	c.size = 0
+}
+
+// getCached returns the cached object if cache is enabled.
+func (e *StorageEngine) getCached(op string, addr oid.Address, payload, raw bool) (*objectSDK.Object, bool) {
+	if e.objCache == nil {
+		return nil, false
+	}
+
+	obj, ok := e.objCache.get(addr, payload, raw)
+	if e.metrics != nil {
+		if ok {
+			e.metrics.IncObjectCacheHit(op)
+		} else {
+			e.metrics.IncObjectCacheMiss(op)
+		}
+	}
+	return obj, ok
+}
+
+// cacheGeneration returns the current generation of the cache if it is
+// enabled.
+func (e *StorageEngine) cacheGeneration() uint64 {
+	if e.objCache == nil {
+		return 0
+	}
+	return e.objCache.generation()
+}
+
+// cacheObject caches the object read at the given generation if cache is
+// enabled.
+func (e *StorageEngine) cacheObject(gen uint64, addr oid.Address, obj *objectSDK.Object, payload, raw bool) {
+	if e.objCache != nil {
+		e.objCache.put(gen, addr, obj, payload, raw)
+	}
+}
+
+// invalidateCache removes objects from the cache if it is enabled.
+func (e *StorageEngine) invalidateCache(addrs ...oid.Address) {
+	if e.objCache != nil {
+		e.objCache.invalidate(addrs...)
+	}
+}
+
+// startCacheRemoval stops serving and caching the objects until the returned
+// function is called after the operation making them unavailable, if cache is
+// enabled.
+func (e *StorageEngine) startCacheRemoval(addrs ...oid.Address) func() {
+	if e.objCache == nil {
+		return func() {}
+	}
+	e.objCache.startRemoval(addrs...)
+	return func() { e.objCache.finishRemoval(addrs...) }
+}
+
+// purgeCache removes all objects from the cache if it is enabled.
+func (e *StorageEngine) purgeCache() {
+	if e.objCache != nil {
+		e.objCache.purge()
+	}
+}
@@ -0,0 +1,278 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/panjf2000/ants/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectCache(t *testing.T) {
+	obj := generateObjectWithCID(cidtest.ID())
+	addr := objectCore.AddressOf(obj)
+	size := uint64(obj.HeaderLen() + len(obj.Payload()))
+
+	t.Run("payload", func(t *testing.T) {
+		c := newObjectCache(10*size, uint64(len(obj.Payload())))
+		c.put(c.generation(), addr, obj, true, true)
+
+		got, ok := c.get(addr, true, true)
+		require.True(t, ok)
+		require.Equal(t, obj, got)
+
+		// returned object is a copy
+		got.Payload()[0]++
+		got, ok = c.get(addr, true, true)
+		require.True(t, ok)
+		require.Equal(t, obj, got)
+
+		got, ok = c.get(addr, false, true)
+		require.True(t, ok)
+		require.Equal(t, obj.CutPayload(), got)
+	})
+
+	t.Run("big payload", func(t *testing.T) {
+		c := newObjectCache(10*size, uint64(len(obj.Payload())-1))
+		c.put(c.generation(), addr, obj, true, true)
+
+		_, ok := c.get(addr, true, true)
+		require.False(t, ok)
+		got, ok := c.get(addr, false, true)
+		require.True(t, ok)
+		require.Equal(t, obj.CutPayload(), got)
+	})
+
+	t.Run("parent header", func(t *testing.T) {
+		c := newObjectCache(10*size, size)
+		c.put(c.generation(), addr, obj, false, false)
+
+		_, ok := c.get(addr, false, true)
+		require.False(t, ok)
+		_, ok = c.get(addr, false, false)
+		require.True(t, ok)
+
+		// stored object replaces parent header, but not vice versa
+		c.put(c.generation(), addr, obj, false, true)
+		c.put(c.generation(), addr, obj, false, false)
+		_, ok = c.get(addr, false, true)
+		require.True(t, ok)
+	})
+
+	t.Run("invalidation", func(t *testing.T) {
+		c := newObjectCache(10*size, size)
+		gen := c.generation()
+		c.put(gen, addr, obj, true, true)
+
+		c.invalidate(addr)
+		_, ok := c.get(addr, false, false)
+		require.False(t, ok)
+
+		// object read before invalidation is not cached
+		c.put(gen, addr, obj, true, true)
+		_, ok = c.get(addr, false, false)
+		require.False(t, ok)
+
+		c.put(c.generation(), addr, obj, true, true)
+		c.purge()
+		_, ok = c.get(addr, false, false)
+		require.False(t, ok)
+		require.Zero(t, c.size)
+	})
+
+	t.Run("removal", func(t *testing.T) {
+		c := newObjectCache(10*size, size)
+		c.put(c.generation(), addr, obj, true, true)
+
+		c.startRemoval(addr)
+		_, ok := c.get(addr, false, false)
+		require.False(t, ok)
+
+		// object read during removal is not cached
+		c.put(c.generation(), addr, obj, true, true)
+		_, ok = c.get(addr, false, false)
+		require.False(t, ok)
+
+		c.startRemoval(addr)
+		c.finishRemoval(addr)
+		c.put(c.generation(), addr, obj, true, true)
+		_, ok = c.get(addr, false, false)
+		require.False(t, ok)
+
+		c.finishRemoval(addr)
+		c.put(c.generation(), addr, obj, true, true)
+		_, ok = c.get(addr, false, false)
+		require.True(t, ok)
+		require.Empty(t, c.removing)
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		const num = 3
+		c := newObjectCache(num*size, size)
+
+		addrs := make([]oid.Address, num+1)
+		for i := range addrs {
+			o := *obj
+			o.SetID(oidtest.ID())
+			addrs[i] = objectCore.AddressOf(&o)
+			c.put(c.generation(), addrs[i], &o, true, true)
+
+			if i == 1 {
+				// make the first object recently used
+				_, ok := c.get(addrs[0], true, true)
+				require.True(t, ok)
+			}
+		}
+
+		require.LessOrEqual(t, c.size, c.capacity)
+		for i, addr := range addrs {
+			_, ok := c.get(addr, true, true)
+			require.Equal(t, i != 1, ok, i)
+		}
+	})
+}
+
+func TestStorageEngine_ObjectCache(t *testing.T) {
+	e := testEngineFromShardOpts(t, 2, []shard.Option{
+		shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
+			pool, err := ants.NewPool(sz)
+			require.NoError(t, err)
+			return pool
+		}),
+	})
+	t.Cleanup(func() {
+		_ = e.Close()
+		_ = os.RemoveAll(t.Name())
+	})
+	e.objCache = newObjectCache(1<<20, 1<<10)
+
+	var (
+		cnr        = cidtest.ID()
+		obj        = generateObjectWithCID(cnr)
+		addr       = objectCore.AddressOf(obj)
+		ctx        = context.Background()
+		dropStored = func() {
+			for _, sh := range e.unsortedShards() {
+				require.NoError(t, sh.Delete(context.Background(), []oid.Address{addr}))
+			}
+		}
+	)
+
+	require.NoError(t, e.Put(ctx, obj, nil))
+
+	_, err := e.Get(ctx, addr)
+	require.NoError(t, err)
+
+	// served from cache
+	dropStored()
+	got, err := e.Get(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, obj, got)
+
+	hdr, err := e.Head(ctx, addr, true)
+	require.NoError(t, err)
+	require.Equal(t, obj.CutPayload(), hdr)
+
+	e.HandleNewEpoch(1)
+	_, err = e.Get(ctx, addr)
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+	t.Run("inhume", func(t *testing.T) {
+		obj := generateObjectWithCID(cnr)
+		addr := objectCore.AddressOf(obj)
+		require.NoError(t, e.Put(ctx, obj, nil))
+
+		_, err := e.Head(ctx, addr, false)
+		require.NoError(t, err)
+
+		require.NoError(t, e.Inhume(objectCore.AddressOf(generateObjectWithCID(cnr)), 0, addr))
+
+		_, err = e.Head(ctx, addr, false)
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+		_, err = e.Get(ctx, addr)
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+	})
+
+	t.Run("concurrent inhume", func(t *testing.T) {
+		obj := generateObjectWithCID(cnr)
+		addr := objectCore.AddressOf(obj)
+		require.NoError(t, e.Put(ctx, obj, nil))
+
+		var (
+			wg      sync.WaitGroup
+			inhumed atomic.Bool
+			served  atomic.Bool
+			stop    = make(chan struct{})
+		)
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+					}
+					done := inhumed.Load()
+					if _, err := e.Get(ctx, addr); err == nil && done {
+						served.Store(true)
+					}
+				}
+			}()
+		}
+
+		require.NoError(t, e.Inhume(objectCore.AddressOf(generateObjectWithCID(cnr)), 0, addr))
+		inhumed.Store(true)
+		time.Sleep(50 * time.Millisecond)
+		close(stop)
+		wg.Wait()
+
+		require.False(t, served.Load(), "removed object is served")
+		_, err := e.Get(ctx, addr)
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		obj := generateObjectWithCID(cnr)
+		addr := objectCore.AddressOf(obj)
+		require.NoError(t, e.Put(ctx, obj, nil))
+
+		_, err := e.Get(ctx, addr)
+		require.NoError(t, err)
+
+		require.NoError(t, e.Delete(addr))
+
+		_, err = e.Get(ctx, addr)
+		require.Error(t, err)
+	})
+
+	t.Run("lock", func(t *testing.T) {
+		obj := generateObjectWithCID(cnr)
+		addr := objectCore.AddressOf(obj)
+		require.NoError(t, e.Put(ctx, obj, nil))
+
+		_, err := e.Get(ctx, addr)
+		require.NoError(t, err)
+
+		lock := generateObjectWithCID(cnr)
+		lock.SetType(objectSDK.TypeLock)
+		require.NoError(t, e.Put(ctx, lock, nil))
+		require.NoError(t, e.Lock(cnr, lock.GetID(), []oid.ID{addr.Object()}))
+
+		_, ok := e.objCache.get(addr, false, false)
+		require.False(t, ok)
+	})
+}
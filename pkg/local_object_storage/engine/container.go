@@ -90,6 +90,8 @@ func (e *StorageEngine) DeleteContainer(ctx context.Context, cID cid.ID) error {
 		return e.blockErr
 	}
 
+	defer e.purgeCache()
+
 	var wg errgroup.Group
 
 	for _, sh := range e.unsortedShards() {
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -49,17 +50,17 @@ func TestStorageEngine_ContainerCleanUp(t *testing.T) {
 	o2 := objecttest.Object()
 	o2.SetPayload(make([]byte, errSmallSize+1))
 
-	err := e.Put(&o1, nil)
+	err := e.Put(context.Background(), &o1, nil)
 	require.NoError(t, err)
 
-	err = e.Put(&o2, nil)
+	err = e.Put(context.Background(), &o2, nil)
 	require.NoError(t, err)
 
 	require.NoError(t, e.Init())
 
 	require.Eventually(t, func() bool {
-		_, err1 := e.Get(object.AddressOf(&o1))
-		_, err2 := e.Get(object.AddressOf(&o2))
+		_, err1 := e.Get(context.Background(), object.AddressOf(&o1))
+		_, err2 := e.Get(context.Background(), object.AddressOf(&o2))
 
 		return errors.Is(err1, new(apistatus.ObjectNotFound)) && errors.Is(err2, new(apistatus.ObjectNotFound))
 	}, time.Second, 100*time.Millisecond)
@@ -61,6 +61,18 @@ func (e *StorageEngine) Init() error {
 	e.wg.Add(1)
 	go e.setModeLoop()
 
+	if e.tieringEnabled() {
+		e.access.start()
+
+		e.wg.Add(1)
+		go e.tierMoveLoop()
+	}
+
+	if e.spaceMonitorEnabled() {
+		e.wg.Add(1)
+		go e.spaceMonitorLoop()
+	}
+
 	return nil
 }
 
@@ -71,6 +83,9 @@ var errClosed = errors.New("storage engine is closed")
 //
 // The method MUST only be called when the application exits.
 func (e *StorageEngine) Close() error {
+	// stopped job is continued by the next start after restart
+	_ = e.StopEvacuation()
+
 	close(e.closeCh)
 	defer e.wg.Wait()
 	return e.setBlockExecErr(errClosed)
@@ -253,24 +268,12 @@ loop:
 			return fmt.Errorf("could not add new shard with '%s' metabase path: %w", newID, err)
 		}
 
-		idStr := sh.ID().String()
-
-		err = sh.Open()
-		if err == nil {
-			err = sh.Init()
-		}
-		if err != nil {
-			_ = sh.Close()
-			return fmt.Errorf("could not init %s shard: %w", idStr, err)
-		}
-
-		err = e.addShard(sh)
+		err = e.attachShard(sh)
 		if err != nil {
-			_ = sh.Close()
-			return fmt.Errorf("could not add %s shard: %w", idStr, err)
+			return err
 		}
 
-		e.log.Info("added new shard", zap.String("id", idStr))
+		e.log.Info("added new shard", zap.Stringer("id", sh.ID()))
 	}
 
 	return nil
@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"sync"
+
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// maxCorruptedObjects limits the number of corrupted objects waiting to be
+// restored, others are restored by the remote nodes.
+const maxCorruptedObjects = 10000
+
+// corruptedObjects is a set of corrupted objects removed from the shards. It
+// is kept in memory only and lost on restart: quarantined objects are not
+// listed again, their replicas are restored by the other container nodes then.
+type corruptedObjects struct {
+	mtx  sync.Mutex
+	objs map[oid.Address]struct{}
+}
+
+func (x *corruptedObjects) add(addr oid.Address) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.objs == nil {
+		x.objs = make(map[oid.Address]struct{})
+	}
+	if len(x.objs) < maxCorruptedObjects {
+		x.objs[addr] = struct{}{}
+	}
+}
+
+// handleCorruptedObject remembers the corrupted object removed from the shard
+// to restore it.
+func (e *StorageEngine) handleCorruptedObject(addr oid.Address) {
+	e.invalidateCache(addr)
+	e.corrupted.add(addr)
+}
+
+// CorruptedObjects returns addresses of the corrupted objects removed from the
+// shards since the engine was started that are to be restored from the other
+// replicas. The list is not persisted.
+func (e *StorageEngine) CorruptedObjects() []oid.Address {
+	e.corrupted.mtx.Lock()
+	defer e.corrupted.mtx.Unlock()
+
+	res := make([]oid.Address, 0, len(e.corrupted.objs))
+	for addr := range e.corrupted.objs {
+		res = append(res, addr)
+	}
+
+	return res
+}
+
+// ForgetCorruptedObject removes address of the restored or no longer needed
+// corrupted object from the list returned by [StorageEngine.CorruptedObjects].
+func (e *StorageEngine) ForgetCorruptedObject(addr oid.Address) {
+	e.corrupted.mtx.Lock()
+	defer e.corrupted.mtx.Unlock()
+
+	delete(e.corrupted.objs, addr)
+}
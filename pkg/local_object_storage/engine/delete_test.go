@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -57,9 +58,9 @@ func TestDeleteBigObject(t *testing.T) {
 	defer e.Close()
 
 	for i := range children {
-		require.NoError(t, e.Put(children[i], nil))
+		require.NoError(t, e.Put(context.Background(), children[i], nil))
 	}
-	require.NoError(t, e.Put(link, nil))
+	require.NoError(t, e.Put(context.Background(), link, nil))
 
 	var splitErr *objectSDK.SplitInfoError
 
@@ -84,7 +85,7 @@ func TestDeleteBigObject(t *testing.T) {
 }
 
 func checkGetError(t *testing.T, e *StorageEngine, addr oid.Address, expected any) {
-	_, err := e.Get(addr)
+	_, err := e.Get(context.Background(), addr)
 	if expected != nil {
 		require.ErrorAs(t, err, expected)
 	} else {
@@ -4,20 +4,22 @@ import (
 	"io"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
 )
 
-// DumpShard dumps objects from the shard with provided identifier.
+// DumpShard dumps objects matching the filter from the shard with provided
+// identifier using the given format version.
 //
 // Returns an error if shard is not read-only.
-func (e *StorageEngine) DumpShard(id *shard.ID, w io.Writer, ignoreErrors bool) error {
+func (e *StorageEngine) DumpShard(id *shard.ID, w io.Writer, v dump.Version, flt dump.Filter, ignoreErrors bool) error {
 	e.mtx.RLock()
 	defer e.mtx.RUnlock()
 
 	sh, ok := e.shards[id.String()]
 	if !ok {
-		return errShardNotFound
+		return ErrShardNotFound
 	}
 
-	_, err := sh.Dump(w, ignoreErrors)
+	_, err := sh.Dump(w, v, flt, ignoreErrors)
 	return err
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/diskspace"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"go.uber.org/zap"
@@ -33,10 +34,21 @@ type StorageEngine struct {
 
 	blockMtx sync.RWMutex
 	blockErr error
+
+	rebalancing atomic.Bool
+
+	evacJob evacuationJob
+
+	corrupted corruptedObjects
+
+	access accessTracker
+
+	objCache *objectCache
 }
 
 type shardWrapper struct {
 	errorCount *atomic.Uint32
+	space      *shardSpace
 	*shard.Shard
 }
 
@@ -191,6 +203,15 @@ func (e *StorageEngine) reportShardErrorWithFlags(
 // Option represents StorageEngine's constructor option.
 type Option func(*cfg)
 
+// StateStorage is a persistent key-value storage of the engine state that
+// must survive restarts.
+type StateStorage interface {
+	// SetBytes saves value by the key.
+	SetBytes(key []byte, value []byte) error
+	// Bytes returns value saved by the key, nil if it is missing.
+	Bytes(key []byte) ([]byte, error)
+}
+
 type cfg struct {
 	log *zap.Logger
 
@@ -204,6 +225,16 @@ type cfg struct {
 	containerSource container.Source
 
 	isIgnoreUninitedShards bool
+
+	stateStorage StateStorage
+
+	tiering TieringPolicy
+
+	objCacheCapacity      uint64
+	objCacheMaxObjectSize uint64
+
+	space     SpacePolicy
+	diskUsage func(path string) (diskspace.Usage, error)
 }
 
 func defaultCfg() *cfg {
@@ -211,6 +242,8 @@ func defaultCfg() *cfg {
 		log: zap.L(),
 
 		shardPoolSize: 20,
+
+		diskUsage: diskspace.Get,
 	}
 }
 
@@ -222,6 +255,11 @@ func New(opts ...Option) *StorageEngine {
 		opts[i](c)
 	}
 
+	var objCache *objectCache
+	if c.objCacheCapacity > 0 {
+		objCache = newObjectCache(c.objCacheCapacity, c.objCacheMaxObjectSize)
+	}
+
 	return &StorageEngine{
 		cfg:        c,
 		mtx:        new(sync.RWMutex),
@@ -229,6 +267,7 @@ func New(opts ...Option) *StorageEngine {
 		shardPools: make(map[string]util.WorkerPool),
 		closeCh:    make(chan struct{}),
 		setModeCh:  make(chan setModeRequest),
+		objCache:   objCache,
 	}
 }
 
@@ -274,6 +313,15 @@ func WithIgnoreUninitedShards(flag bool) Option {
 	}
 }
 
+// WithStateStorage returns an option to specify persistent storage of the
+// engine state like background evacuation progress. Without it, the state is
+// lost on restart.
+func WithStateStorage(s StateStorage) Option {
+	return func(c *cfg) {
+		c.stateStorage = s
+	}
+}
+
 // WithObjectPutRetryTimeout return an option to specify time for object PUT operation.
 // It does not stop any disk operation, only affects retryes policy. Zero value
 // is acceptable and means no retry on any shard.
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -61,7 +62,7 @@ func benchmarkExists(b *testing.B, shardNum int) {
 	addr := oidtest.Address()
 	for range 100 {
 		obj := generateObjectWithCID(cidtest.ID())
-		err := e.Put(obj, nil)
+		err := e.Put(context.Background(), obj, nil)
 		if err != nil {
 			b.Fatal(err)
 		}
@@ -88,6 +89,7 @@ func testNewEngineWithShards(shards ...*shard.Shard) *StorageEngine {
 
 		engine.shards[s.ID().String()] = shardWrapper{
 			errorCount: new(atomic.Uint32),
+			space:      new(shardSpace),
 			Shard:      s,
 		}
 		engine.shardPools[s.ID().String()] = pool
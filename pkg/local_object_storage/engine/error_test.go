@@ -1,11 +1,13 @@
 package engine
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
@@ -63,11 +65,11 @@ func TestErrorReporting(t *testing.T) {
 		obj.SetPayload(make([]byte, errSmallSize))
 
 		e.mtx.RLock()
-		err := e.shards[id[0].String()].Shard.Put(obj, nil)
+		err := e.shards[id[0].String()].Shard.Put(context.Background(), obj, nil)
 		e.mtx.RUnlock()
 		require.NoError(t, err)
 
-		_, err = e.Get(object.AddressOf(obj))
+		_, err = e.Get(context.Background(), object.AddressOf(obj))
 		require.NoError(t, err)
 
 		checkShardState(t, e, id[0], 0, mode.ReadWrite)
@@ -76,7 +78,7 @@ func TestErrorReporting(t *testing.T) {
 		corruptSubDir(t, filepath.Join(dir, "0"))
 
 		for i := uint32(1); i < 3; i++ {
-			_, err = e.Get(object.AddressOf(obj))
+			_, err = e.Get(context.Background(), object.AddressOf(obj))
 			require.Error(t, err)
 			checkShardState(t, e, id[0], i, mode.ReadWrite)
 			checkShardState(t, e, id[1], 0, mode.ReadWrite)
@@ -91,11 +93,11 @@ func TestErrorReporting(t *testing.T) {
 		obj.SetPayload(make([]byte, errSmallSize))
 
 		e.mtx.RLock()
-		err := e.shards[id[0].String()].Put(obj, nil)
+		err := e.shards[id[0].String()].Put(context.Background(), obj, nil)
 		e.mtx.RUnlock()
 		require.NoError(t, err)
 
-		_, err = e.Get(object.AddressOf(obj))
+		_, err = e.Get(context.Background(), object.AddressOf(obj))
 		require.NoError(t, err)
 
 		checkShardState(t, e, id[0], 0, mode.ReadWrite)
@@ -104,14 +106,14 @@ func TestErrorReporting(t *testing.T) {
 		corruptSubDir(t, filepath.Join(dir, "0"))
 
 		for i := uint32(1); i < errThreshold; i++ {
-			_, err = e.Get(object.AddressOf(obj))
+			_, err = e.Get(context.Background(), object.AddressOf(obj))
 			require.Error(t, err)
 			checkShardState(t, e, id[0], i, mode.ReadWrite)
 			checkShardState(t, e, id[1], 0, mode.ReadWrite)
 		}
 
 		for i := range uint32(2) {
-			_, err = e.Get(object.AddressOf(obj))
+			_, err = e.Get(context.Background(), object.AddressOf(obj))
 			require.Error(t, err)
 			checkShardState(t, e, id[0], errThreshold+i, mode.DegradedReadOnly)
 			checkShardState(t, e, id[1], 0, mode.ReadWrite)
@@ -140,7 +142,7 @@ func TestBlobstorFailback(t *testing.T) {
 		obj.SetPayloadSize(uint64(size))
 
 		e.mtx.RLock()
-		err = e.shards[id[0].String()].Shard.Put(obj, nil)
+		err = e.shards[id[0].String()].Shard.Put(context.Background(), obj, nil)
 		e.mtx.RUnlock()
 		require.NoError(t, err)
 		objs = append(objs, obj)
@@ -148,9 +150,9 @@ func TestBlobstorFailback(t *testing.T) {
 
 	for i := range objs {
 		addr := object.AddressOf(objs[i])
-		_, err = e.Get(addr)
+		_, err = e.Get(context.Background(), addr)
 		require.NoError(t, err)
-		_, err = e.GetRange(addr, 0, 0)
+		_, err = e.GetRange(context.Background(), addr, 0, 0)
 		require.NoError(t, err)
 	}
 
@@ -168,18 +170,28 @@ func TestBlobstorFailback(t *testing.T) {
 
 	for i := range objs {
 		addr := object.AddressOf(objs[i])
-		getObj, err := e.Get(addr)
+		getObj, err := e.Get(context.Background(), addr)
 		require.NoError(t, err)
 		require.Equal(t, objs[i], getObj)
 
-		rngRes, err := e.GetRange(addr, 1, 10)
+		rngRes, err := e.GetRange(context.Background(), addr, 1, 10)
 		require.NoError(t, err)
 		require.Equal(t, objs[i].Payload()[1:11], rngRes)
 
-		_, err = e.GetRange(addr, errSmallSize+10, 1)
+		_, err = e.GetRange(context.Background(), addr, errSmallSize+10, 1)
 		require.ErrorAs(t, err, &apistatus.ObjectOutOfRange{})
 	}
 
+	// metabases do not match swapped blobstors, so they are resynced in background
+	require.Eventually(t, func() bool {
+		for _, sh := range e.DumpInfo().Shards {
+			if sh.Resync.InProgress {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
 	checkShardState(t, e, id[0], 0, mode.ReadWrite)
 	checkShardState(t, e, id[1], 0, mode.ReadWrite)
 }
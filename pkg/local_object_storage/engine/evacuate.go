@@ -1,10 +1,12 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/hrw/v2"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
@@ -30,7 +32,48 @@ var errMustHaveTwoShards = errors.New("must have at least 1 spare shard")
 // (if provided, fails otherwise) which can return its own error to abort
 // evacuation (or nil to continue). Returns the number of evacuated objects
 // (which can be non-zero even in case of error).
+//
+// See also [StorageEngine.StartEvacuation] for the background evacuation.
 func (e *StorageEngine) Evacuate(shardIDs []*shard.ID, ignoreErrors bool, faultHandler func(oid.Address, *objectSDK.Object) error) (int, error) {
+	ev, err := e.newEvacuator(shardIDs, ignoreErrors, faultHandler)
+	if err != nil {
+		return 0, err
+	}
+
+	e.log.Info("started shards evacuation", zap.Strings("shard_ids", ev.sidList))
+
+	for _, sid := range ev.sidList {
+		err = ev.evacuateShard(context.Background(), sid, nil, nil)
+		if err != nil {
+			return int(ev.evacuated), err
+		}
+	}
+
+	e.log.Info("finished shards evacuation",
+		zap.Strings("shard_ids", ev.sidList))
+	return int(ev.evacuated), nil
+}
+
+// evacuator moves objects from the set of shards to other ones.
+type evacuator struct {
+	e *StorageEngine
+
+	sidList []string
+	// all engine shards, evacuated ones are skipped during put
+	shards []pooledShard
+	// evacuated shards
+	shardMap map[string]*shard.Shard
+
+	ignoreErrors bool
+	faultHandler func(oid.Address, *objectSDK.Object) error
+
+	// number of objects moved to other shards or passed to faultHandler
+	evacuated uint64
+	// number of objects skipped because of read errors
+	skipped uint64
+}
+
+func (e *StorageEngine) newEvacuator(shardIDs []*shard.ID, ignoreErrors bool, faultHandler func(oid.Address, *objectSDK.Object) error) (*evacuator, error) {
 	sidList := make([]string, len(shardIDs))
 	for i := range shardIDs {
 		sidList[i] = shardIDs[i].String()
@@ -41,22 +84,20 @@ func (e *StorageEngine) Evacuate(shardIDs []*shard.ID, ignoreErrors bool, faultH
 		sh, ok := e.shards[sidList[i]]
 		if !ok {
 			e.mtx.RUnlock()
-			return 0, errShardNotFound
+			return nil, ErrShardNotFound
 		}
 
 		if !sh.GetMode().ReadOnly() {
 			e.mtx.RUnlock()
-			return 0, shard.ErrMustBeReadOnly
+			return nil, shard.ErrMustBeReadOnly
 		}
 	}
 
 	if len(e.shards)-len(sidList) < 1 && faultHandler == nil {
 		e.mtx.RUnlock()
-		return 0, errMustHaveTwoShards
+		return nil, errMustHaveTwoShards
 	}
 
-	e.log.Info("started shards evacuation", zap.Strings("shard_ids", sidList))
-
 	// We must have all shards, to have correct information about their
 	// indexes in a sorted slice and set appropriate marks in the metabase.
 	// Evacuated shard is skipped during put.
@@ -78,77 +119,98 @@ func (e *StorageEngine) Evacuate(shardIDs []*shard.ID, ignoreErrors bool, faultH
 		}
 	}
 
-	var count int
-
-mainLoop:
-	for n := range sidList {
-		sh := shardMap[sidList[n]]
-
-		var c *meta.Cursor
-		for {
-			// TODO (@fyrchik): #1731 this approach doesn't work in degraded modes
-			//  because ListWithCursor works only with the metabase.
-			lst, cursor, err := sh.ListWithCursor(defaultEvacuateBatchSize, c)
-			if err != nil {
-				if errors.Is(err, meta.ErrEndOfListing) || errors.Is(err, shard.ErrDegradedMode) {
-					continue mainLoop
-				}
-				return count, err
+	return &evacuator{
+		e:            e,
+		sidList:      sidList,
+		shards:       shards,
+		shardMap:     shardMap,
+		ignoreErrors: ignoreErrors,
+		faultHandler: faultHandler,
+	}, nil
+}
+
+// evacuateShard evacuates objects of the shard listed after the cursor (from
+// the beginning if it's nil). onBatch is called with the listing cursor after
+// each processed batch of objects, if set.
+func (ev *evacuator) evacuateShard(ctx context.Context, sid string, c *meta.Cursor, onBatch func(*meta.Cursor) error) error {
+	sh := ev.shardMap[sid]
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// TODO (@fyrchik): #1731 this approach doesn't work in degraded modes
+		//  because ListWithCursor works only with the metabase.
+		lst, cursor, err := sh.ListWithCursor(defaultEvacuateBatchSize, c)
+		if err != nil {
+			if errors.Is(err, meta.ErrEndOfListing) || errors.Is(err, shard.ErrDegradedMode) {
+				return nil
 			}
+			return err
+		}
+
+		// TODO (@fyrchik): #1731 parallelize the loop
+		for i := range lst {
+			if err = ev.evacuateObject(sid, sh, lst[i]); err != nil {
+				return err
+			}
+		}
+
+		c = cursor
 
-			// TODO (@fyrchik): #1731 parallelize the loop
-		loop:
-			for i := range lst {
-				addr := lst[i].Address
-				addrHash := hrw.WrapBytes([]byte(addr.EncodeToString()))
-
-				obj, err := sh.Get(addr, false)
-				if err != nil {
-					if ignoreErrors {
-						continue
-					}
-					return count, err
-				}
-
-				hrw.Sort(shards, addrHash)
-				for j := range shards {
-					if _, ok := shardMap[shards[j].ID().String()]; ok {
-						continue
-					}
-					putDone, exists, _ := e.putToShard(shards[j].shardWrapper, j, shards[j].pool, addr, obj, nil)
-					if putDone || exists {
-						if putDone {
-							e.log.Debug("object is moved to another shard",
-								zap.String("from", sidList[n]),
-								zap.Stringer("to", shards[j].ID()),
-								zap.Stringer("addr", addr))
-
-							count++
-						}
-						continue loop
-					}
-
-					e.log.Debug("could not put to shard, trying another", zap.String("shard", shards[j].ID().String()))
-				}
-
-				if faultHandler == nil {
-					// Do not check ignoreErrors flag here because
-					// ignoring errors on put make this command kinda useless.
-					return count, fmt.Errorf("%w: %s", errPutShard, lst[i])
-				}
-
-				err = faultHandler(addr, obj)
-				if err != nil {
-					return count, err
-				}
-				count++
+		if onBatch != nil {
+			if err = onBatch(c); err != nil {
+				return err
 			}
+		}
+	}
+}
 
-			c = cursor
+func (ev *evacuator) evacuateObject(sid string, sh *shard.Shard, obj objectcore.AddressWithType) error {
+	addr := obj.Address
+	addrHash := hrw.WrapBytes([]byte(addr.EncodeToString()))
+
+	o, err := sh.Get(moveCtx, addr, false)
+	if err != nil {
+		if ev.ignoreErrors {
+			ev.skipped++
+			return nil
 		}
+		return err
 	}
 
-	e.log.Info("finished shards evacuation",
-		zap.Strings("shard_ids", sidList))
-	return count, nil
+	hrw.Sort(ev.shards, addrHash)
+	for j := range ev.shards {
+		if _, ok := ev.shardMap[ev.shards[j].ID().String()]; ok {
+			continue
+		}
+		putDone, exists, _ := ev.e.putToShard(moveCtx, ev.shards[j].shardWrapper, j, ev.shards[j].pool, addr, o, nil)
+		if putDone || exists {
+			if putDone {
+				ev.e.log.Debug("object is moved to another shard",
+					zap.String("from", sid),
+					zap.Stringer("to", ev.shards[j].ID()),
+					zap.Stringer("addr", addr))
+
+				ev.evacuated++
+			}
+			return nil
+		}
+
+		ev.e.log.Debug("could not put to shard, trying another", zap.String("shard", ev.shards[j].ID().String()))
+	}
+
+	if ev.faultHandler == nil {
+		// Do not check ignoreErrors flag here because
+		// ignoring errors on put make this command kinda useless.
+		return fmt.Errorf("%w: %s", errPutShard, obj)
+	}
+
+	err = ev.faultHandler(addr, o)
+	if err != nil {
+		return err
+	}
+	ev.evacuated++
+	return nil
 }
@@ -0,0 +1,396 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mr-tron/base58"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrEvacuationInProgress is returned when evacuation is requested while
+	// another one is running.
+	ErrEvacuationInProgress = errors.New("evacuation is already in progress")
+	// ErrNoEvacuationInProgress is returned when there is no running
+	// evacuation to stop.
+	ErrNoEvacuationInProgress = errors.New("no evacuation in progress")
+)
+
+var evacuationStateKey = []byte("engine_evacuation_job")
+
+// EvacuationState is a state of the background evacuation job.
+type EvacuationState uint8
+
+const (
+	// EvacuationRunning means that job is in progress.
+	EvacuationRunning EvacuationState = iota + 1
+	// EvacuationCompleted means that all objects were evacuated.
+	EvacuationCompleted
+	// EvacuationStopped means that job was stopped by request or node
+	// shutdown, it can be continued.
+	EvacuationStopped
+	// EvacuationFailed means that job was aborted because of an error, it
+	// can be continued.
+	EvacuationFailed
+)
+
+// EvacuationStatus describes the background evacuation job.
+type EvacuationStatus struct {
+	// ID is a unique identifier of the job.
+	ID string
+	// State is a current state of the job, zero if no evacuation has been
+	// started.
+	State EvacuationState
+	// Shards are IDs of the evacuated shards.
+	Shards []*shard.ID
+	// Evacuated is the number of objects moved to other shards or replicated.
+	Evacuated uint64
+	// Skipped is the number of objects skipped because of read errors.
+	Skipped uint64
+	// StartedAt is the time the job was started at.
+	StartedAt time.Time
+	// FinishedAt is the time the job was finished at, zero for running job.
+	FinishedAt time.Time
+	// Error is a reason of the job failure.
+	Error string
+}
+
+// evacuationRecord is a persisted state of the evacuation job. Shard IDs are
+// base58-encoded.
+type evacuationRecord struct {
+	ID           string            `json:"id"`
+	State        EvacuationState   `json:"state"`
+	Shards       []string          `json:"shards"`
+	IgnoreErrors bool              `json:"ignore_errors"`
+	Evacuated    uint64            `json:"evacuated"`
+	Skipped      uint64            `json:"skipped"`
+	StartedAt    int64             `json:"started_at"`
+	FinishedAt   int64             `json:"finished_at,omitempty"`
+	Error        string            `json:"error,omitempty"`
+	Cursors      map[string][]byte `json:"cursors,omitempty"`
+	Done         []string          `json:"done,omitempty"`
+}
+
+// evacuationJob is the only background evacuation job of the engine.
+type evacuationJob struct {
+	mtx sync.Mutex
+	// whether last job was loaded from the state storage
+	loaded bool
+	rec    *evacuationRecord
+
+	// set for running job only
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// StartEvacuation starts background evacuation of the given shards with the
+// same rules as for [StorageEngine.Evacuate] and returns ID of the job.
+// Only one evacuation job can run at a time, ErrEvacuationInProgress is
+// returned otherwise.
+//
+// Progress of the job is saved after each batch of objects in the storage
+// set by [WithStateStorage], so if the last job for the same shards was not
+// completed (stopped, failed or interrupted by node restart), it is
+// continued from the saved position keeping its ID.
+func (e *StorageEngine) StartEvacuation(shardIDs []*shard.ID, ignoreErrors bool, faultHandler func(oid.Address, *objectSDK.Object) error) (string, error) {
+	ev, err := e.newEvacuator(shardIDs, ignoreErrors, faultHandler)
+	if err != nil {
+		return "", err
+	}
+
+	j := &e.evacJob
+
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	if err = e.loadEvacuationJob(); err != nil {
+		return "", err
+	}
+
+	rec := j.rec
+	if rec != nil && rec.State == EvacuationRunning {
+		return "", ErrEvacuationInProgress
+	}
+
+	if rec == nil || rec.State == EvacuationCompleted || !sameShards(rec.Shards, ev.sidList) {
+		rec = &evacuationRecord{
+			ID:        uuid.NewString(),
+			Shards:    ev.sidList,
+			StartedAt: time.Now().Unix(),
+			Cursors:   make(map[string][]byte),
+		}
+
+		e.log.Info("started shards evacuation job",
+			zap.String("id", rec.ID),
+			zap.Strings("shard_ids", rec.Shards))
+	} else {
+		rec = rec.clone()
+
+		e.log.Info("continuing shards evacuation job",
+			zap.String("id", rec.ID),
+			zap.Strings("shard_ids", rec.Shards),
+			zap.Uint64("evacuated", rec.Evacuated))
+	}
+
+	rec.State = EvacuationRunning
+	rec.IgnoreErrors = ignoreErrors
+	rec.FinishedAt = 0
+	rec.Error = ""
+
+	if err = e.saveEvacuationJob(rec); err != nil {
+		return "", err
+	}
+
+	ev.evacuated, ev.skipped = rec.Evacuated, rec.Skipped
+
+	ctx, cancel := context.WithCancel(context.Background())
+	j.rec, j.cancel, j.done = rec, cancel, make(chan struct{})
+
+	go e.runEvacuation(ctx, ev, j.done)
+
+	return rec.ID, nil
+}
+
+// StopEvacuation stops running evacuation job and waits for it to finish.
+// Stopped job can be continued by [StorageEngine.StartEvacuation] for the
+// same shards. Returns ErrNoEvacuationInProgress if there is no running job.
+func (e *StorageEngine) StopEvacuation() error {
+	j := &e.evacJob
+
+	j.mtx.Lock()
+	if j.rec == nil || j.rec.State != EvacuationRunning {
+		j.mtx.Unlock()
+		return ErrNoEvacuationInProgress
+	}
+	cancel, done := j.cancel, j.done
+	j.mtx.Unlock()
+
+	cancel()
+	<-done
+
+	return nil
+}
+
+// EvacuationStatus returns status of the last evacuation job. Zero status is
+// returned if no job has been started yet.
+func (e *StorageEngine) EvacuationStatus() (EvacuationStatus, error) {
+	var st EvacuationStatus
+
+	j := &e.evacJob
+
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	if err := e.loadEvacuationJob(); err != nil {
+		return st, err
+	}
+
+	if j.rec == nil {
+		return st, nil
+	}
+
+	st.ID = j.rec.ID
+	st.State = j.rec.State
+	st.Evacuated = j.rec.Evacuated
+	st.Skipped = j.rec.Skipped
+	st.StartedAt = time.Unix(j.rec.StartedAt, 0)
+	if j.rec.FinishedAt != 0 {
+		st.FinishedAt = time.Unix(j.rec.FinishedAt, 0)
+	}
+	st.Error = j.rec.Error
+
+	st.Shards = make([]*shard.ID, 0, len(j.rec.Shards))
+	for _, sid := range j.rec.Shards {
+		id, err := base58.Decode(sid)
+		if err != nil {
+			return st, fmt.Errorf("decode shard ID %q: %w", sid, err)
+		}
+		st.Shards = append(st.Shards, shard.NewIDFromBytes(id))
+	}
+
+	return st, nil
+}
+
+func (e *StorageEngine) runEvacuation(ctx context.Context, ev *evacuator, done chan struct{}) {
+	defer close(done)
+
+	j := &e.evacJob
+
+	var err error
+	for _, sid := range ev.sidList {
+		j.mtx.Lock()
+		finished := slices.Contains(j.rec.Done, sid)
+		var c *meta.Cursor
+		if data := j.rec.Cursors[sid]; data != nil {
+			c = new(meta.Cursor)
+			err = c.UnmarshalBinary(data)
+		}
+		j.mtx.Unlock()
+
+		if err != nil {
+			err = fmt.Errorf("decode saved cursor of shard %s: %w", sid, err)
+			break
+		}
+		if finished {
+			continue
+		}
+
+		err = ev.evacuateShard(ctx, sid, c, func(c *meta.Cursor) error {
+			return e.updateEvacuationJob(ev, func(rec *evacuationRecord) error {
+				data, err := c.MarshalBinary()
+				if err != nil {
+					return err
+				}
+				rec.Cursors[sid] = data
+				return nil
+			})
+		})
+		if err != nil {
+			break
+		}
+
+		err = e.updateEvacuationJob(ev, func(rec *evacuationRecord) error {
+			delete(rec.Cursors, sid)
+			rec.Done = append(rec.Done, sid)
+			return nil
+		})
+		if err != nil {
+			break
+		}
+	}
+
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	rec := j.rec.clone()
+	rec.Evacuated, rec.Skipped = ev.evacuated, ev.skipped
+	rec.FinishedAt = time.Now().Unix()
+
+	switch {
+	case err == nil:
+		rec.State = EvacuationCompleted
+		rec.Cursors, rec.Done = nil, nil
+	case errors.Is(err, context.Canceled):
+		rec.State = EvacuationStopped
+	default:
+		rec.State = EvacuationFailed
+		rec.Error = err.Error()
+	}
+
+	if sErr := e.saveEvacuationJob(rec); sErr != nil {
+		e.log.Warn("could not save evacuation job state", zap.String("id", rec.ID), zap.Error(sErr))
+	}
+
+	j.rec, j.cancel, j.done = rec, nil, nil
+
+	e.log.Info("finished shards evacuation job",
+		zap.String("id", rec.ID),
+		zap.Uint8("state", uint8(rec.State)),
+		zap.Uint64("evacuated", rec.Evacuated),
+		zap.Uint64("skipped", rec.Skipped),
+		zap.Error(err))
+}
+
+// updateEvacuationJob applies f to the copy of the running job record with
+// actual counters, saves and sets it as the current one.
+func (e *StorageEngine) updateEvacuationJob(ev *evacuator, f func(*evacuationRecord) error) error {
+	j := &e.evacJob
+
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	rec := j.rec.clone()
+	rec.Evacuated, rec.Skipped = ev.evacuated, ev.skipped
+
+	if err := f(rec); err != nil {
+		return err
+	}
+
+	if err := e.saveEvacuationJob(rec); err != nil {
+		return err
+	}
+
+	j.rec = rec
+	return nil
+}
+
+// loadEvacuationJob reads the last job from the state storage once. Must be
+// called under the job mutex.
+func (e *StorageEngine) loadEvacuationJob() error {
+	j := &e.evacJob
+	if j.loaded || e.stateStorage == nil {
+		return nil
+	}
+
+	data, err := e.stateStorage.Bytes(evacuationStateKey)
+	if err != nil {
+		return fmt.Errorf("read evacuation job state: %w", err)
+	}
+
+	if data != nil {
+		rec := new(evacuationRecord)
+		if err = json.Unmarshal(data, rec); err != nil {
+			return fmt.Errorf("decode evacuation job state: %w", err)
+		}
+
+		if rec.Cursors == nil {
+			rec.Cursors = make(map[string][]byte)
+		}
+
+		if rec.State == EvacuationRunning {
+			// node was stopped during evacuation
+			rec.State = EvacuationStopped
+		}
+
+		j.rec = rec
+	}
+
+	j.loaded = true
+	return nil
+}
+
+func (e *StorageEngine) saveEvacuationJob(rec *evacuationRecord) error {
+	if e.stateStorage == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("encode evacuation job state: %w", err)
+	}
+
+	if err = e.stateStorage.SetBytes(evacuationStateKey, data); err != nil {
+		return fmt.Errorf("save evacuation job state: %w", err)
+	}
+
+	return nil
+}
+
+func (r *evacuationRecord) clone() *evacuationRecord {
+	res := *r
+	res.Shards = slices.Clone(r.Shards)
+	res.Done = slices.Clone(r.Done)
+	res.Cursors = make(map[string][]byte, len(r.Cursors))
+	for k, v := range r.Cursors {
+		res.Cursors[k] = v
+	}
+	return &res
+}
+
+func sameShards(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+type testStateStorage map[string][]byte
+
+func (s testStateStorage) SetBytes(key []byte, value []byte) error {
+	s[string(key)] = value
+	return nil
+}
+
+func (s testStateStorage) Bytes(key []byte) ([]byte, error) {
+	return s[string(key)], nil
+}
+
+func waitEvacuation(t *testing.T, e *StorageEngine) EvacuationStatus {
+	var st EvacuationStatus
+	require.Eventually(t, func() bool {
+		var err error
+		st, err = e.EvacuationStatus()
+		require.NoError(t, err)
+		return st.State != EvacuationRunning
+	}, 5*time.Second, 10*time.Millisecond)
+	return st
+}
+
+func TestStartEvacuation(t *testing.T) {
+	const objPerShard = 3
+
+	t.Run("completed", func(t *testing.T) {
+		e, ids, objects := newEngineEvacuate(t, 3, objPerShard)
+
+		st, err := e.EvacuationStatus()
+		require.NoError(t, err)
+		require.Zero(t, st.State)
+
+		_, err = e.StartEvacuation(ids[2:3], false, nil)
+		require.ErrorIs(t, err, shard.ErrMustBeReadOnly)
+
+		require.NoError(t, e.shards[ids[2].String()].SetMode(mode.ReadOnly))
+
+		id, err := e.StartEvacuation(ids[2:3], false, nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, id)
+
+		st = waitEvacuation(t, e)
+		require.Equal(t, EvacuationCompleted, st.State, st.Error)
+		require.Equal(t, id, st.ID)
+		require.Equal(t, ids[2:3], st.Shards)
+		require.EqualValues(t, objPerShard, st.Evacuated)
+		require.Zero(t, st.Skipped)
+		require.False(t, st.FinishedAt.IsZero())
+
+		for i := range objects {
+			_, err := e.Get(context.Background(), objectCore.AddressOf(objects[i]))
+			require.NoError(t, err)
+		}
+
+		require.ErrorIs(t, e.StopEvacuation(), ErrNoEvacuationInProgress)
+
+		// completed job is not continued
+		newID, err := e.StartEvacuation(ids[2:3], false, nil)
+		require.NoError(t, err)
+		require.NotEqual(t, id, newID)
+		require.Equal(t, EvacuationCompleted, waitEvacuation(t, e).State)
+	})
+
+	t.Run("stop and continue", func(t *testing.T) {
+		e, ids, objects := newEngineEvacuate(t, 1, objPerShard)
+		ss := make(testStateStorage)
+		e.stateStorage = ss
+
+		require.NoError(t, e.shards[ids[0].String()].SetMode(mode.ReadOnly))
+
+		var (
+			called  = make(chan struct{}, objPerShard)
+			release = make(chan struct{})
+			handled []oid.Address
+		)
+		handler := func(addr oid.Address, _ *objectSDK.Object) error {
+			called <- struct{}{}
+			<-release
+			handled = append(handled, addr)
+			return nil
+		}
+
+		id, err := e.StartEvacuation(ids, false, handler)
+		require.NoError(t, err)
+
+		<-called
+
+		_, err = e.StartEvacuation(ids, false, handler)
+		require.ErrorIs(t, err, ErrEvacuationInProgress)
+
+		st, err := e.EvacuationStatus()
+		require.NoError(t, err)
+		require.Equal(t, EvacuationRunning, st.State)
+
+		close(release)
+		require.NoError(t, e.StopEvacuation())
+
+		// the whole batch is processed before stop
+		st, err = e.EvacuationStatus()
+		require.NoError(t, err)
+		require.Equal(t, EvacuationStopped, st.State)
+		require.EqualValues(t, objPerShard, st.Evacuated)
+		require.Len(t, handled, objPerShard)
+
+		// state is restored after restart
+		e2 := New(WithStateStorage(ss))
+		st2, err := e2.EvacuationStatus()
+		require.NoError(t, err)
+		require.Equal(t, st, st2)
+
+		// job is continued from the saved position
+		contID, err := e.StartEvacuation(ids, false, handler)
+		require.NoError(t, err)
+		require.Equal(t, id, contID)
+
+		st = waitEvacuation(t, e)
+		require.Equal(t, EvacuationCompleted, st.State, st.Error)
+		require.EqualValues(t, objPerShard, st.Evacuated)
+		require.Len(t, handled, len(objects))
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		errReplication := errors.New("handler error")
+
+		e, ids, _ := newEngineEvacuate(t, 1, objPerShard)
+		require.NoError(t, e.shards[ids[0].String()].SetMode(mode.ReadOnly))
+
+		_, err := e.StartEvacuation(ids, false, func(oid.Address, *objectSDK.Object) error {
+			return errReplication
+		})
+		require.NoError(t, err)
+
+		st := waitEvacuation(t, e)
+		require.Equal(t, EvacuationFailed, st.State)
+		require.Contains(t, st.Error, errReplication.Error())
+	})
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -52,7 +53,7 @@ func newEngineEvacuate(t *testing.T, shardNum int, objPerShard int) (*StorageEng
 	for i := 0; ; i++ {
 		objects = append(objects, generateObjectWithCID(cidtest.ID()))
 
-		err := e.Put(objects[i], nil)
+		err := e.Put(context.Background(), objects[i], nil)
 		require.NoError(t, err)
 
 		res, err := e.shards[ids[len(ids)-1].String()].List()
@@ -73,7 +74,7 @@ func TestEvacuateShard(t *testing.T) {
 
 	checkHasObjects := func(t *testing.T) {
 		for i := range objects {
-			_, err := e.Get(objectCore.AddressOf(objects[i]))
+			_, err := e.Get(context.Background(), objectCore.AddressOf(objects[i]))
 			require.NoError(t, err)
 		}
 	}
@@ -0,0 +1,19 @@
+package engine
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+)
+
+// RunShardGC runs GC cycle on a single shard with the given ID right away and
+// returns its statistics.
+func (e *StorageEngine) RunShardGC(id *shard.ID) (shard.GCStats, error) {
+	e.mtx.RLock()
+	sh, ok := e.shards[id.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return shard.GCStats{}, ErrShardNotFound
+	}
+
+	return sh.RunGC()
+}
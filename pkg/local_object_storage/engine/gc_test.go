@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -86,10 +87,10 @@ func TestChildrenExpiration(t *testing.T) {
 		link.SetChildren(child1ID, child2ID, child3ID)
 		link.SetSplitID(splitID)
 
-		require.NoError(t, e.Put(child1, nil))
-		require.NoError(t, e.Put(child2, nil))
-		require.NoError(t, e.Put(child3, nil))
-		require.NoError(t, e.Put(link, nil))
+		require.NoError(t, e.Put(context.Background(), child1, nil))
+		require.NoError(t, e.Put(context.Background(), child2, nil))
+		require.NoError(t, e.Put(context.Background(), child3, nil))
+		require.NoError(t, e.Put(context.Background(), link, nil))
 
 		e.HandleNewEpoch(currEpoch + 1)
 
@@ -137,10 +138,10 @@ func TestChildrenExpiration(t *testing.T) {
 		linkObj.CalculateAndSetPayloadChecksum()
 		require.NoError(t, linkObj.CalculateAndSetID())
 
-		require.NoError(t, e.Put(child1, nil))
-		require.NoError(t, e.Put(child2, nil))
-		require.NoError(t, e.Put(child3, nil))
-		require.NoError(t, e.Put(&linkObj, nil))
+		require.NoError(t, e.Put(context.Background(), child1, nil))
+		require.NoError(t, e.Put(context.Background(), child2, nil))
+		require.NoError(t, e.Put(context.Background(), child3, nil))
+		require.NoError(t, e.Put(context.Background(), &linkObj, nil))
 
 		e.HandleNewEpoch(currEpoch + 1)
 
@@ -156,7 +157,7 @@ func checkObjectsAsyncRemoval(t *testing.T, e *StorageEngine, cnr cid.ID, objs .
 		for _, obj := range objs {
 			addr.SetObject(obj)
 
-			_, err := e.Get(addr)
+			_, err := e.Get(context.Background(), addr)
 			if !errors.As(err, new(statusSDK.ObjectNotFound)) {
 				return false
 			}
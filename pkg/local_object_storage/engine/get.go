@@ -1,14 +1,17 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -21,11 +24,19 @@ import (
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the object has been marked as removed.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) Get(addr oid.Address) (*objectSDK.Object, error) {
+func (e *StorageEngine) Get(ctx context.Context, addr oid.Address) (*objectSDK.Object, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddGetDuration)()
 	}
 
+	var (
+		err error
+		obj *objectSDK.Object
+	)
+
+	ctx, span := tracing.StartChild(ctx, "engine.Get", attribute.Stringer("address", addr))
+	defer func() { tracing.End(span, err) }()
+
 	e.blockMtx.RLock()
 	defer e.blockMtx.RUnlock()
 
@@ -33,15 +44,20 @@ func (e *StorageEngine) Get(addr oid.Address) (*objectSDK.Object, error) {
 		return nil, e.blockErr
 	}
 
-	var (
-		err error
-		obj *objectSDK.Object
-	)
+	if cached, ok := e.getCached(cacheOpGet, addr, true, true); ok {
+		e.touchObject(addr, true)
+		return cached, nil
+	}
 
+	gen := e.cacheGeneration()
 	err = e.get(addr, func(s *shard.Shard, ignoreMetadata bool) error {
-		obj, err = s.Get(addr, ignoreMetadata)
+		obj, err = s.Get(ctx, addr, ignoreMetadata)
 		return err
 	})
+	if err == nil {
+		e.cacheObject(gen, addr, obj, true, true)
+		e.touchObject(addr, true)
+	}
 	return obj, err
 }
 
@@ -132,7 +148,7 @@ func (e *StorageEngine) get(addr oid.Address, shardFunc func(s *shard.Shard, ign
 // GetBytes reads object from the StorageEngine by address into memory buffer in
 // a canonical NeoFS binary format. Returns [apistatus.ObjectNotFound] if object
 // is missing.
-func (e *StorageEngine) GetBytes(addr oid.Address) ([]byte, error) {
+func (e *StorageEngine) GetBytes(ctx context.Context, addr oid.Address) ([]byte, error) {
 	e.blockMtx.RLock()
 	defer e.blockMtx.RUnlock()
 
@@ -146,9 +162,9 @@ func (e *StorageEngine) GetBytes(addr oid.Address) ([]byte, error) {
 	)
 	err = e.get(addr, func(s *shard.Shard, ignoreMetadata bool) error {
 		if ignoreMetadata {
-			b, err = s.GetBytes(addr)
+			b, err = s.GetBytes(ctx, addr)
 		} else {
-			b, err = s.GetBytesWithMetadataLookup(addr)
+			b, err = s.GetBytesWithMetadataLookup(ctx, addr)
 		}
 		return err
 	})
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -15,10 +16,10 @@ func TestStorageEngine_GetBytes(t *testing.T) {
 
 	objBin := obj.Marshal()
 
-	err := e.Put(obj, nil)
+	err := e.Put(context.Background(), obj, nil)
 	require.NoError(t, err)
 
-	b, err := e.GetBytes(addr)
+	b, err := e.GetBytes(context.Background(), addr)
 	require.NoError(t, err)
 	require.Equal(t, objBin, b)
 }
@@ -1,14 +1,17 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -22,11 +25,14 @@ import (
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object was inhumed.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) Head(addr oid.Address, raw bool) (*objectSDK.Object, error) {
+func (e *StorageEngine) Head(ctx context.Context, addr oid.Address, raw bool) (res *objectSDK.Object, err error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddHeadDuration)()
 	}
 
+	ctx, span := tracing.StartChild(ctx, "engine.Head", attribute.Stringer("address", addr), attribute.Bool("raw", raw))
+	defer func() { tracing.End(span, err) }()
+
 	e.blockMtx.RLock()
 	defer e.blockMtx.RUnlock()
 
@@ -34,10 +40,17 @@ func (e *StorageEngine) Head(addr oid.Address, raw bool) (*objectSDK.Object, err
 		return nil, e.blockErr
 	}
 
-	var splitInfo *objectSDK.SplitInfo
+	if cached, ok := e.getCached(cacheOpHead, addr, false, raw); ok {
+		return cached, nil
+	}
+
+	var (
+		splitInfo *objectSDK.SplitInfo
+		gen       = e.cacheGeneration()
+	)
 
 	for _, sh := range e.sortedShards(addr) {
-		res, err := sh.Head(addr, raw)
+		res, err := sh.Head(ctx, addr, raw)
 		if err != nil {
 			var siErr *objectSDK.SplitInfoError
 
@@ -68,6 +81,7 @@ func (e *StorageEngine) Head(addr oid.Address, raw bool) (*objectSDK.Object, err
 			}
 		}
 
+		e.cacheObject(gen, addr, res, false, raw)
 		return res, nil
 	}
 
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -47,15 +48,15 @@ func TestHeadRaw(t *testing.T) {
 		defer e.Close()
 
 		// put most left object in one shard
-		err := s1.Put(child, nil)
+		err := s1.Put(context.Background(), child, nil)
 		require.NoError(t, err)
 
 		// put link object in another shard
-		err = s2.Put(link, nil)
+		err = s2.Put(context.Background(), link, nil)
 		require.NoError(t, err)
 
 		// head with raw flag should return SplitInfoError
-		_, err = e.Head(parentAddr, true)
+		_, err = e.Head(context.Background(), parentAddr, true)
 		require.Error(t, err)
 
 		var si *object.SplitInfoError
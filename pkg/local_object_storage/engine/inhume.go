@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
@@ -76,6 +77,8 @@ func (e *StorageEngine) InhumeContainer(cID cid.ID) error {
 	if e.blockErr != nil {
 		return e.blockErr
 	}
+	defer e.purgeCache()
+
 	for _, sh := range e.unsortedShards() {
 		err := sh.InhumeContainer(cID)
 		if err != nil {
@@ -132,7 +135,7 @@ func (e *StorageEngine) inhumeAddr(addr oid.Address, force bool, tombstone *oid.
 			linkAddr.SetContainer(addr.Container())
 			linkAddr.SetObject(linkID)
 
-			linkObj, err := e.Get(linkAddr)
+			linkObj, err := e.Get(context.Background(), linkAddr)
 			if err != nil {
 				e.log.Error("inhuming root object but no link object is found",
 					zap.Stringer("linkAddr", linkAddr),
@@ -177,6 +180,8 @@ func (e *StorageEngine) inhumeAddr(addr oid.Address, force bool, tombstone *oid.
 	}
 
 	var addrs = append(children, addr)
+	finishCacheRemoval := e.startCacheRemoval(addrs...)
+	defer finishCacheRemoval()
 
 	if shardWithObject != "" {
 		sh := e.getShard(shardWithObject)
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -43,13 +44,13 @@ func TestStorageEngine_Inhume(t *testing.T) {
 		e := testNewEngineWithShardNum(t, 1)
 		defer e.Close()
 
-		err := e.Put(parent, nil)
+		err := e.Put(context.Background(), parent, nil)
 		require.NoError(t, err)
 
 		err = e.Inhume(tombstoneID, 0, object.AddressOf(parent))
 		require.NoError(t, err)
 
-		addrs, err := e.Select(cnr, fs)
+		addrs, err := e.Select(context.Background(), cnr, fs)
 		require.NoError(t, err)
 		require.Empty(t, addrs)
 	})
@@ -61,23 +62,23 @@ func TestStorageEngine_Inhume(t *testing.T) {
 		e := testNewEngineWithShards(s1, s2)
 		defer e.Close()
 
-		err := s1.Put(child, nil)
+		err := s1.Put(context.Background(), child, nil)
 		require.NoError(t, err)
 
-		err = s2.Put(link, nil)
+		err = s2.Put(context.Background(), link, nil)
 		require.NoError(t, err)
 
 		err = e.Inhume(tombstoneID, 0, object.AddressOf(parent))
 		require.NoError(t, err)
 
 		t.Run("empty search should fail", func(t *testing.T) {
-			addrs, err := e.Select(cnr, objectSDK.SearchFilters{})
+			addrs, err := e.Select(context.Background(), cnr, objectSDK.SearchFilters{})
 			require.NoError(t, err)
 			require.Empty(t, addrs)
 		})
 
 		t.Run("root search should fail", func(t *testing.T) {
-			addrs, err := e.Select(cnr, fs)
+			addrs, err := e.Select(context.Background(), cnr, fs)
 			require.NoError(t, err)
 			require.Empty(t, addrs)
 		})
@@ -87,18 +88,18 @@ func TestStorageEngine_Inhume(t *testing.T) {
 			addr.SetContainer(cnr)
 			addr.SetObject(idChild)
 
-			_, err = e.Get(addr)
+			_, err = e.Get(context.Background(), addr)
 			require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
 
 			linkID := link.GetID()
 			addr.SetObject(linkID)
 
-			_, err = e.Get(addr)
+			_, err = e.Get(context.Background(), addr)
 			require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
 		})
 
 		t.Run("parent get should claim deletion", func(t *testing.T) {
-			_, err = e.Get(object.AddressOf(parent))
+			_, err = e.Get(context.Background(), object.AddressOf(parent))
 			require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
 		})
 	})
@@ -120,17 +121,17 @@ func TestStorageEngine_Inhume(t *testing.T) {
 
 		wrongShard := e.getShard(wrongShardID)
 
-		err := wrongShard.Put(obj, nil)
+		err := wrongShard.Put(context.Background(), obj, nil)
 		require.NoError(t, err)
 
-		_, err = wrongShard.Get(addr, false)
+		_, err = wrongShard.Get(context.Background(), addr, false)
 		require.NoError(t, err)
 
 		err = e.Delete(addr)
 		require.NoError(t, err)
 
 		// object was on the wrong (according to hash sorting) shard but is removed anyway
-		_, err = wrongShard.Get(addr, false)
+		_, err = wrongShard.Get(context.Background(), addr, false)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 
@@ -27,6 +27,14 @@ func (e *StorageEngine) Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error
 		return e.blockErr
 	}
 
+	addrs := make([]oid.Address, len(locked))
+	for i := range locked {
+		addrs[i].SetContainer(idCnr)
+		addrs[i].SetObject(locked[i])
+	}
+	finishCacheRemoval := e.startCacheRemoval(addrs...)
+	defer finishCacheRemoval()
+
 	for i := range locked {
 		switch e.lockSingle(idCnr, locker, locked[i], true) {
 		case 1:
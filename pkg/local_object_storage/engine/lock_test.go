@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"os"
 	"strconv"
 	"testing"
@@ -81,7 +82,7 @@ func TestLockUserScenario(t *testing.T) {
 	id := obj.GetID()
 	objAddr.SetObject(id)
 
-	err = e.Put(obj, nil)
+	err = e.Put(context.Background(), obj, nil)
 	require.NoError(t, err)
 
 	// 2.
@@ -89,7 +90,7 @@ func TestLockUserScenario(t *testing.T) {
 	locker.WriteMembers([]oid.ID{id})
 	lockerObj.WriteLock(locker)
 
-	err = e.Put(lockerObj, nil)
+	err = e.Put(context.Background(), lockerObj, nil)
 	require.NoError(t, err)
 
 	err = e.Lock(cnr, lockerID, []oid.ID{id})
@@ -104,7 +105,7 @@ func TestLockUserScenario(t *testing.T) {
 	tombObj.SetID(tombForLockID)
 	tombObj.SetAttributes(a)
 
-	err = e.Put(tombObj, nil)
+	err = e.Put(context.Background(), tombObj, nil)
 	require.NoError(t, err)
 
 	err = e.Inhume(tombForLockAddr, 0, lockerAddr)
@@ -149,7 +150,7 @@ func TestLockExpiration(t *testing.T) {
 	// 1.
 	obj := generateObjectWithCID(cnr)
 
-	err = e.Put(obj, nil)
+	err = e.Put(context.Background(), obj, nil)
 	require.NoError(t, err)
 
 	// 2.
@@ -161,7 +162,7 @@ func TestLockExpiration(t *testing.T) {
 	lock.SetType(object.TypeLock)
 	lock.SetAttributes(a)
 
-	err = e.Put(lock, nil)
+	err = e.Put(context.Background(), lock, nil)
 	require.NoError(t, err)
 
 	id := obj.GetID()
@@ -215,14 +216,14 @@ func TestLockForceRemoval(t *testing.T) {
 	// 1.
 	obj := generateObjectWithCID(cnr)
 
-	err = e.Put(obj, nil)
+	err = e.Put(context.Background(), obj, nil)
 	require.NoError(t, err)
 
 	// 2.
 	lock := generateObjectWithCID(cnr)
 	lock.SetType(object.TypeLock)
 
-	err = e.Put(lock, nil)
+	err = e.Put(context.Background(), lock, nil)
 	require.NoError(t, err)
 
 	id := obj.GetID()
@@ -24,6 +24,29 @@ type MetricRegister interface {
 
 	AddToContainerSize(cnrID string, size int64)
 	AddToPayloadCounter(shardID string, size int64)
+	SetCapacitySize(shardID string, capacity uint64)
+	SetFreeSpace(shardID string, size uint64)
+
+	AddToCompressionSaved(shardID string, size int64)
+	IncCompressionSkipped(shardID string)
+
+	AddGCRemovedObjects(shardID, category string, count uint64)
+	AddGCRunDuration(shardID string, d time.Duration)
+	SetGCBacklog(shardID string, count uint64)
+	SetGCLastRun(shardID string, t time.Time)
+
+	AddScrubProblems(shardID, kind string, count uint64)
+	AddScrubCheckedObjects(shardID string, count uint64)
+	SetScrubLastRun(shardID string, t time.Time)
+	AddPayloadScrubBytes(shardID string, size uint64)
+	IncCorruptedObjects(shardID string)
+	SetResyncProgress(shardID string, done, total uint64)
+	AddResyncObjects(shardID string, count uint64)
+
+	AddTierMovedObjects(direction string, count uint64)
+
+	IncObjectCacheHit(op string)
+	IncObjectCacheMiss(op string)
 }
 
 func elapsed(addFunc func(d time.Duration)) func() {
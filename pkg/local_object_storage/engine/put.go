@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -8,9 +9,11 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/panjf2000/ants/v2"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -26,11 +29,16 @@ var errPutShard = errors.New("could not put object to any shard")
 // Returns an error if executions are blocked (see BlockExecution).
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the object has been marked as removed.
-func (e *StorageEngine) Put(obj *objectSDK.Object, objBin []byte) error {
+func (e *StorageEngine) Put(ctx context.Context, obj *objectSDK.Object, objBin []byte) (err error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddPutDuration)()
 	}
 
+	addr := object.AddressOf(obj)
+
+	ctx, span := tracing.StartChild(ctx, "engine.Put", attribute.Stringer("address", addr))
+	defer func() { tracing.End(span, err) }()
+
 	e.blockMtx.RLock()
 	defer e.blockMtx.RUnlock()
 
@@ -38,11 +46,9 @@ func (e *StorageEngine) Put(obj *objectSDK.Object, objBin []byte) error {
 		return e.blockErr
 	}
 
-	addr := object.AddressOf(obj)
-
 	// In #1146 this check was parallelized, however, it became
 	// much slower on fast machines for 4 shards.
-	_, err := e.exists(addr)
+	_, err = e.exists(addr)
 	if err != nil {
 		return err
 	}
@@ -50,7 +56,7 @@ func (e *StorageEngine) Put(obj *objectSDK.Object, objBin []byte) error {
 	var bestShard shardWrapper
 	var bestPool util.WorkerPool
 
-	for i, sh := range e.sortedShards(addr) {
+	for i, sh := range e.placementShards(addr) {
 		e.mtx.RLock()
 		pool, ok := e.shardPools[sh.ID().String()]
 		if ok && bestPool == nil {
@@ -63,8 +69,9 @@ func (e *StorageEngine) Put(obj *objectSDK.Object, objBin []byte) error {
 			continue
 		}
 
-		putDone, exists, _ := e.putToShard(sh, i, pool, addr, obj, objBin)
+		putDone, exists, _ := e.putToShard(ctx, sh, i, pool, addr, obj, objBin)
 		if putDone || exists {
+			e.touchObject(addr, false)
 			return nil
 		}
 	}
@@ -72,7 +79,8 @@ func (e *StorageEngine) Put(obj *objectSDK.Object, objBin []byte) error {
 	e.log.Debug("failed to put object to shards, trying the best one more",
 		zap.Stringer("addr", addr), zap.Stringer("best shard", bestShard.ID()))
 
-	if e.objectPutTimeout > 0 && e.putToShardWithDeadLine(bestShard, 0, bestPool, addr, obj, objBin) {
+	if e.objectPutTimeout > 0 && e.putToShardWithDeadLine(ctx, bestShard, 0, bestPool, addr, obj, objBin) {
+		e.touchObject(addr, false)
 		return nil
 	}
 
@@ -83,7 +91,7 @@ func (e *StorageEngine) Put(obj *objectSDK.Object, objBin []byte) error {
 // First return value is true iff put has been successfully done.
 // Second return value is true iff object already exists.
 // Third return value is true iff object cannot be put because of max concurrent load.
-func (e *StorageEngine) putToShard(sh shardWrapper, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object, objBin []byte) (bool, bool, bool) {
+func (e *StorageEngine) putToShard(ctx context.Context, sh shardWrapper, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object, objBin []byte) (bool, bool, bool) {
 	var (
 		alreadyExists bool
 		err           error
@@ -131,7 +139,7 @@ func (e *StorageEngine) putToShard(sh shardWrapper, ind int, pool util.WorkerPoo
 			return
 		}
 
-		err = sh.Put(obj, objBin)
+		err = sh.Put(ctx, obj, objBin)
 		if err != nil {
 			if errors.Is(err, shard.ErrReadOnlyMode) || errors.Is(err, common.ErrReadOnly) ||
 				errors.Is(err, common.ErrNoSpace) {
@@ -158,7 +166,7 @@ func (e *StorageEngine) putToShard(sh shardWrapper, ind int, pool util.WorkerPoo
 	return putSuccess, alreadyExists, overloaded
 }
 
-func (e *StorageEngine) putToShardWithDeadLine(sh shardWrapper, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object, objBin []byte) bool {
+func (e *StorageEngine) putToShardWithDeadLine(ctx context.Context, sh shardWrapper, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object, objBin []byte) bool {
 	timer := time.NewTimer(e.cfg.objectPutTimeout)
 
 	const putCooldown = 100 * time.Millisecond
@@ -170,7 +178,7 @@ func (e *StorageEngine) putToShardWithDeadLine(sh shardWrapper, ind int, pool ut
 			e.log.Error("could not put object", zap.Stringer("addr", addr), zap.Duration("deadline", e.cfg.objectPutTimeout))
 			return false
 		case <-ticker.C:
-			putDone, exists, overloaded := e.putToShard(sh, ind, pool, addr, obj, objBin)
+			putDone, exists, overloaded := e.putToShard(ctx, sh, ind, pool, addr, obj, objBin)
 			if overloaded {
 				ticker.Reset(putCooldown)
 				continue
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"testing"
 
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
@@ -23,14 +24,14 @@ func TestStorageEngine_PutBinary(t *testing.T) {
 
 	e, _, _ := newEngine(t, t.TempDir())
 
-	err := e.Put(&obj, objBin)
+	err := e.Put(context.Background(), &obj, objBin)
 	require.NoError(t, err)
 
-	gotObj, err := e.Get(addr)
+	gotObj, err := e.Get(context.Background(), addr)
 	require.NoError(t, err)
 	require.Equal(t, &obj, gotObj)
 
-	b, err := e.GetBytes(addr)
+	b, err := e.GetBytes(context.Background(), addr)
 	require.NoError(t, err)
 	require.Equal(t, objBin, b)
 
@@ -38,13 +39,13 @@ func TestStorageEngine_PutBinary(t *testing.T) {
 	addr.SetObject(oidtest.ID())
 	obj.SetID(addr.Object()) // to avoid 'already exists' outcome
 	invalidObjBin := []byte("definitely not an object")
-	err = e.Put(&obj, invalidObjBin)
+	err = e.Put(context.Background(), &obj, invalidObjBin)
 	require.NoError(t, err)
 
-	b, err = e.GetBytes(addr)
+	b, err = e.GetBytes(context.Background(), addr)
 	require.NoError(t, err)
 	require.Equal(t, invalidObjBin, b)
 
-	_, err = e.Get(addr)
+	_, err = e.Get(context.Background(), addr)
 	require.Error(t, err)
 }
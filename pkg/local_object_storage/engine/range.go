@@ -1,8 +1,12 @@
 package engine
 
 import (
+	"context"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // GetRange reads a part of an object from local storage. Zero length is
@@ -16,10 +20,20 @@ import (
 // Returns ErrRangeOutOfBounds if the requested object range is out of bounds.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) GetRange(addr oid.Address, offset uint64, length uint64) ([]byte, error) {
+func (e *StorageEngine) GetRange(ctx context.Context, addr oid.Address, offset uint64, length uint64) ([]byte, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddRangeDuration)()
 	}
+
+	var (
+		err  error
+		data []byte
+	)
+
+	ctx, span := tracing.StartChild(ctx, "engine.GetRange", attribute.Stringer("address", addr),
+		attribute.Int64("offset", int64(offset)), attribute.Int64("length", int64(length)))
+	defer func() { tracing.End(span, err) }()
+
 	e.blockMtx.RLock()
 	defer e.blockMtx.RUnlock()
 
@@ -27,17 +41,15 @@ func (e *StorageEngine) GetRange(addr oid.Address, offset uint64, length uint64)
 		return nil, e.blockErr
 	}
 
-	var (
-		err  error
-		data []byte
-	)
-
 	err = e.get(addr, func(sh *shard.Shard, ignoreMetadata bool) error {
-		res, err := sh.GetRange(addr, offset, length, ignoreMetadata)
+		res, err := sh.GetRange(ctx, addr, offset, length, ignoreMetadata)
 		if err == nil {
 			data = res.Payload()
 		}
 		return err
 	})
+	if err == nil {
+		e.touchObject(addr, true)
+	}
 	return data, err
 }
@@ -0,0 +1,223 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+const defaultRebalanceBatchSize = 100
+
+var errRebalanceInProgress = errors.New("rebalance is already in progress")
+
+// moveCtx is a context of the shard operations moving objects between shards,
+// they are scheduled as replication ones.
+var moveCtx = iosched.WithClass(context.Background(), iosched.Replication)
+
+// RebalanceStatus describes progress of the shards rebalance.
+type RebalanceStatus struct {
+	// Shard is an ID of the shard being processed.
+	Shard *shard.ID
+	// Processed is the number of checked objects.
+	Processed uint64
+	// Moved is the number of objects moved to other shards.
+	Moved uint64
+	// Failed is the number of objects that were not checked or moved
+	// because of errors.
+	Failed uint64
+}
+
+// Rebalance moves objects from the given shards (all shards if the list is
+// empty) to other shards so that every object ends up in the shard with the
+// highest HRW weight for its address that is able to accept it (the same one
+// [StorageEngine.Put] selects), so data is redistributed after adding new
+// shards. If tiering is enabled (see [WithTiering]), objects are moved only
+// between shards of the same tier. Objects are moved only if they can be put
+// into the better shard and removed from the original one, so only shards in
+// read-write mode are processed. Shards are processed while the engine is in
+// use.
+//
+// rateLimit limits the number of objects moved per second, zero means no
+// limit. progress is called after each batch of objects is processed, if set.
+//
+// Listing cursor is saved in the metabase of the processed shard after each
+// batch, so rebalance interrupted by ctx or node restart is continued from
+// the saved position by the next call. Only one rebalance can run at a time.
+// Returns the final status (which can be non-empty even in case of error).
+func (e *StorageEngine) Rebalance(ctx context.Context, shardIDs []*shard.ID, rateLimit uint32, progress func(RebalanceStatus)) (RebalanceStatus, error) {
+	var st RebalanceStatus
+
+	if !e.rebalancing.CompareAndSwap(false, true) {
+		return st, errRebalanceInProgress
+	}
+	defer e.rebalancing.Store(false)
+
+	var shards []shardWrapper
+	if len(shardIDs) == 0 {
+		shards = e.unsortedShards()
+	} else {
+		shards = make([]shardWrapper, 0, len(shardIDs))
+		for i := range shardIDs {
+			sh := e.getShard(shardIDs[i].String())
+			if sh.Shard == nil {
+				return st, ErrShardNotFound
+			}
+			shards = append(shards, sh)
+		}
+	}
+
+	var limiter <-chan time.Time
+	if rateLimit > 0 {
+		t := time.NewTicker(time.Second / time.Duration(rateLimit))
+		defer t.Stop()
+		limiter = t.C
+	}
+
+	e.log.Info("started shards rebalance")
+
+	for _, sh := range shards {
+		st.Shard = sh.ID()
+
+		if m := sh.GetMode(); m != mode.ReadWrite {
+			e.log.Info("skipping shard rebalance due to its mode",
+				zap.Stringer("shard", st.Shard),
+				zap.Stringer("mode", m))
+			continue
+		}
+
+		c, err := sh.RebalanceCursor()
+		if err != nil {
+			return st, err
+		}
+
+		for {
+			if err = ctx.Err(); err != nil {
+				return st, err
+			}
+
+			lst, next, err := sh.ListWithCursor(defaultRebalanceBatchSize, c)
+			if err != nil {
+				if errors.Is(err, ErrEndOfListing) {
+					break
+				}
+				return st, err
+			}
+
+			for i := range lst {
+				st.Processed++
+
+				moved, err := e.rebalanceObject(sh, lst[i].Address)
+				if err != nil {
+					e.log.Debug("could not rebalance object",
+						zap.Stringer("shard", st.Shard),
+						zap.Stringer("addr", lst[i].Address),
+						zap.Error(err))
+
+					st.Failed++
+					continue
+				}
+
+				if !moved {
+					continue
+				}
+
+				st.Moved++
+
+				if limiter != nil {
+					select {
+					case <-ctx.Done():
+						return st, ctx.Err()
+					case <-limiter:
+					}
+				}
+			}
+
+			c = next
+			if err = sh.SetRebalanceCursor(c); err != nil {
+				return st, err
+			}
+
+			if progress != nil {
+				progress(st)
+			}
+		}
+
+		if err = sh.SetRebalanceCursor(nil); err != nil {
+			return st, err
+		}
+
+		e.log.Info("shard is rebalanced", zap.Stringer("shard", st.Shard))
+	}
+
+	e.log.Info("finished shards rebalance",
+		zap.Uint64("processed", st.Processed),
+		zap.Uint64("moved", st.Moved),
+		zap.Uint64("failed", st.Failed))
+
+	return st, nil
+}
+
+// rebalanceObject moves the object from src to the first shard accepting it
+// among ones having higher HRW weight for the object address. Returns true
+// if the object was moved.
+func (e *StorageEngine) rebalanceObject(src shardWrapper, addr oid.Address) (bool, error) {
+	var (
+		obj   *objectSDK.Object
+		srcID = src.ID().String()
+	)
+
+	for i, sh := range e.sortedShards(addr) {
+		id := sh.ID().String()
+		if id == srcID {
+			// no better shard can accept the object
+			return false, nil
+		}
+
+		if sh.GetMode() != mode.ReadWrite || e.tieringEnabled() && sh.Tier() != src.Tier() {
+			continue
+		}
+
+		e.mtx.RLock()
+		pool, ok := e.shardPools[id]
+		e.mtx.RUnlock()
+		if !ok {
+			// Shard was concurrently removed, skip.
+			continue
+		}
+
+		if obj == nil {
+			var err error
+			obj, err = src.Get(moveCtx, addr, false)
+			if err != nil {
+				return false, fmt.Errorf("get object: %w", err)
+			}
+		}
+
+		putDone, exists, _ := e.putToShard(moveCtx, sh, i, pool, addr, obj, nil)
+		if !putDone && !exists {
+			continue
+		}
+
+		err := src.Delete(moveCtx, []oid.Address{addr})
+		if err != nil {
+			return false, fmt.Errorf("delete moved object from the shard: %w", err)
+		}
+
+		e.log.Debug("object is moved to another shard",
+			zap.String("from", srcID),
+			zap.String("to", id),
+			zap.Stringer("addr", addr))
+
+		return true, nil
+	}
+
+	return false, nil
+}
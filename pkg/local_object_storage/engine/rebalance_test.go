@@ -0,0 +1,143 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func newEngineRebalance(t *testing.T, shardNum int) (*StorageEngine, []*shard.ID) {
+	dir := t.TempDir()
+
+	e := New(WithLogger(zaptest.NewLogger(t)))
+
+	ids := make([]*shard.ID, shardNum)
+	for i := range ids {
+		var err error
+		ids[i], err = e.AddShard(
+			shard.WithLogger(zaptest.NewLogger(t)),
+			shard.WithBlobstor(newStorage(filepath.Join(dir, fmt.Sprintf("fstree%d", i)))),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(dir, fmt.Sprintf("metabase%d", i))),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{}),
+			))
+		require.NoError(t, err)
+	}
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+	t.Cleanup(func() { _ = e.Close() })
+
+	return e, ids
+}
+
+// putToFirstShard puts objects to the first shard ignoring their HRW weights
+// like it happens for shards added to the engine after objects were stored.
+func putToFirstShard(t *testing.T, e *StorageEngine, id *shard.ID, num int) []*objectSDK.Object {
+	sh := e.getShard(id.String())
+
+	objs := make([]*objectSDK.Object, num)
+	for i := range objs {
+		objs[i] = generateObjectWithCID(cidtest.ID())
+		require.NoError(t, sh.Put(context.Background(), objs[i], nil))
+	}
+
+	return objs
+}
+
+func checkRebalanced(t *testing.T, e *StorageEngine, objs []*objectSDK.Object) {
+	for _, obj := range objs {
+		addr := objectCore.AddressOf(obj)
+
+		for i, sh := range e.sortedShards(addr) {
+			exists, err := sh.Exists(addr, false)
+			require.NoError(t, err)
+			require.Equal(t, i == 0, exists, "object %s in shard #%d", addr, i)
+		}
+
+		got, err := e.Get(context.Background(), addr)
+		require.NoError(t, err)
+		require.Equal(t, obj, got)
+	}
+}
+
+func TestRebalance(t *testing.T) {
+	const objNum = 2*defaultRebalanceBatchSize + 1
+
+	t.Run("all shards", func(t *testing.T) {
+		e, ids := newEngineRebalance(t, 3)
+		objs := putToFirstShard(t, e, ids[0], objNum)
+
+		var reports int
+		st, err := e.Rebalance(context.Background(), nil, 0, func(RebalanceStatus) { reports++ })
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, st.Processed, uint64(objNum)) // moved objects can be checked twice
+		require.Zero(t, st.Failed)
+		require.NotZero(t, st.Moved)
+		require.NotZero(t, reports)
+
+		checkRebalanced(t, e, objs)
+
+		// nothing to do for balanced shards
+		st, err = e.Rebalance(context.Background(), nil, 0, nil)
+		require.NoError(t, err)
+		require.Zero(t, st.Moved)
+		require.EqualValues(t, objNum, st.Processed)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		e, ids := newEngineRebalance(t, 2)
+		objs := putToFirstShard(t, e, ids[0], objNum)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		st, err := e.Rebalance(ctx, ids[:1], 0, func(RebalanceStatus) { cancel() })
+		require.ErrorIs(t, err, context.Canceled)
+		require.EqualValues(t, defaultRebalanceBatchSize, st.Processed)
+
+		c, err := e.getShard(ids[0].String()).RebalanceCursor()
+		require.NoError(t, err)
+		require.NotNil(t, c)
+
+		st, err = e.Rebalance(context.Background(), ids[:1], 0, nil)
+		require.NoError(t, err)
+		require.EqualValues(t, objNum-defaultRebalanceBatchSize, st.Processed)
+
+		checkRebalanced(t, e, objs)
+
+		c, err = e.getShard(ids[0].String()).RebalanceCursor()
+		require.NoError(t, err)
+		require.Nil(t, c)
+	})
+
+	t.Run("read-only shard", func(t *testing.T) {
+		e, ids := newEngineRebalance(t, 2)
+		putToFirstShard(t, e, ids[0], 10)
+
+		require.NoError(t, e.SetShardMode(ids[1], mode.ReadOnly, false))
+
+		st, err := e.Rebalance(context.Background(), nil, 0, nil)
+		require.NoError(t, err)
+		require.Zero(t, st.Moved)
+
+		res, err := e.getShard(ids[0].String()).List()
+		require.NoError(t, err)
+		require.Len(t, res, 10)
+	})
+
+	t.Run("missing shard", func(t *testing.T) {
+		e, _ := newEngineRebalance(t, 1)
+
+		_, err := e.Rebalance(context.Background(), []*shard.ID{shard.NewIDFromBytes([]byte{1})}, 0, nil)
+		require.ErrorIs(t, err, ErrShardNotFound)
+	})
+}
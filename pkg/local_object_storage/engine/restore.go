@@ -4,20 +4,22 @@ import (
 	"io"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
 )
 
-// RestoreShard restores objects from dump to the shard with provided identifier.
+// RestoreShard restores objects matching the filter from dump to the shard
+// with provided identifier.
 //
 // Returns an error if shard is not read-only.
-func (e *StorageEngine) RestoreShard(id *shard.ID, r io.Reader, ignoreErrors bool) error {
+func (e *StorageEngine) RestoreShard(id *shard.ID, r io.Reader, flt dump.Filter, ignoreErrors bool) error {
 	e.mtx.RLock()
 	defer e.mtx.RUnlock()
 
 	sh, ok := e.shards[id.String()]
 	if !ok {
-		return errShardNotFound
+		return ErrShardNotFound
 	}
 
-	_, _, err := sh.Restore(r, ignoreErrors)
+	_, _, err := sh.Restore(r, flt, ignoreErrors)
 	return err
 }
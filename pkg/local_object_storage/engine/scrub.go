@@ -0,0 +1,21 @@
+package engine
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+)
+
+// RunShardScrub checks and repairs metabase of a single shard with the given
+// ID right away and returns scrub statistics.
+func (e *StorageEngine) RunShardScrub(ctx context.Context, id *shard.ID) (shard.ScrubStats, error) {
+	e.mtx.RLock()
+	sh, ok := e.shards[id.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return shard.ScrubStats{}, ErrShardNotFound
+	}
+
+	return sh.Scrub(ctx)
+}
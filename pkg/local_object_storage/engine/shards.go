@@ -3,6 +3,7 @@ package engine
 import (
 	"fmt"
 	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nspcc-dev/hrw/v2"
@@ -13,7 +14,9 @@ import (
 	"go.uber.org/zap"
 )
 
-var errShardNotFound = logicerr.New("shard not found")
+// ErrShardNotFound is returned when the requested shard is not attached to the
+// engine.
+var ErrShardNotFound = logicerr.New("shard not found")
 
 type metricsWithID struct {
 	id string
@@ -54,6 +57,58 @@ func (m *metricsWithID) AddToPayloadSize(size int64) {
 	m.mw.AddToPayloadCounter(m.id, size)
 }
 
+func (m *metricsWithID) AddToCompressionSaved(size int64) {
+	m.mw.AddToCompressionSaved(m.id, size)
+}
+
+func (m *metricsWithID) IncCompressionSkipped() {
+	m.mw.IncCompressionSkipped(m.id)
+}
+
+func (m *metricsWithID) AddGCRemovedObjects(category string, count uint64) {
+	m.mw.AddGCRemovedObjects(m.id, category, count)
+}
+
+func (m *metricsWithID) AddGCRunDuration(d time.Duration) {
+	m.mw.AddGCRunDuration(m.id, d)
+}
+
+func (m *metricsWithID) SetGCBacklog(count uint64) {
+	m.mw.SetGCBacklog(m.id, count)
+}
+
+func (m *metricsWithID) SetGCLastRun(t time.Time) {
+	m.mw.SetGCLastRun(m.id, t)
+}
+
+func (m *metricsWithID) AddScrubProblems(kind string, count uint64) {
+	m.mw.AddScrubProblems(m.id, kind, count)
+}
+
+func (m *metricsWithID) AddScrubCheckedObjects(count uint64) {
+	m.mw.AddScrubCheckedObjects(m.id, count)
+}
+
+func (m *metricsWithID) SetScrubLastRun(t time.Time) {
+	m.mw.SetScrubLastRun(m.id, t)
+}
+
+func (m *metricsWithID) AddPayloadScrubBytes(size uint64) {
+	m.mw.AddPayloadScrubBytes(m.id, size)
+}
+
+func (m *metricsWithID) IncCorruptedObjects() {
+	m.mw.IncCorruptedObjects(m.id)
+}
+
+func (m *metricsWithID) SetResyncProgress(done, total uint64) {
+	m.mw.SetResyncProgress(m.id, done, total)
+}
+
+func (m *metricsWithID) AddResyncObjects(count uint64) {
+	m.mw.AddResyncObjects(m.id, count)
+}
+
 // AddShard adds a new shard to the storage engine.
 //
 // Returns any error encountered that did not allow adding a shard.
@@ -76,6 +131,74 @@ func (e *StorageEngine) AddShard(opts ...shard.Option) (*shard.ID, error) {
 	return sh.ID(), nil
 }
 
+// AttachShard creates a new shard, opens, initializes and adds it to the
+// running storage engine. The shard must not use the same blobstor path as
+// any of the existing ones.
+//
+// Returns the ID of the attached shard.
+func (e *StorageEngine) AttachShard(opts ...shard.Option) (*shard.ID, error) {
+	sh, err := e.createShard(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create a shard: %w", err)
+	}
+
+	err = e.attachShard(sh)
+	if err != nil {
+		return nil, err
+	}
+
+	e.log.Info("attached new shard", zap.Stringer("id", sh.ID()))
+
+	return sh.ID(), nil
+}
+
+// attachShard opens, initializes and adds the created shard to the storage
+// engine. The shard is closed on failure.
+func (e *StorageEngine) attachShard(sh *shard.Shard) error {
+	idStr := sh.ID().String()
+
+	err := sh.Open()
+	if err == nil {
+		err = sh.Init()
+	}
+	if err != nil {
+		_ = sh.Close()
+		return fmt.Errorf("could not init %s shard: %w", idStr, err)
+	}
+
+	err = e.addShard(sh)
+	if err != nil {
+		_ = sh.Close()
+		return fmt.Errorf("could not add %s shard: %w", idStr, err)
+	}
+
+	if e.metrics != nil {
+		e.metrics.SetReadonly(idStr, sh.GetMode() != mode.ReadWrite)
+	}
+
+	return nil
+}
+
+// DetachShard removes the shard from the running storage engine and closes
+// it. Objects of the shard are not moved anywhere, use [StorageEngine.Evacuate]
+// before detaching to keep them available.
+func (e *StorageEngine) DetachShard(id *shard.ID) error {
+	idStr := id.String()
+
+	e.mtx.RLock()
+	_, ok := e.shards[idStr]
+	e.mtx.RUnlock()
+	if !ok {
+		return ErrShardNotFound
+	}
+
+	e.removeShards(idStr)
+
+	e.log.Info("detached shard", zap.String("id", idStr))
+
+	return nil
+}
+
 func (e *StorageEngine) createShard(opts []shard.Option) (*shard.Shard, error) {
 	id, err := generateShardID()
 	if err != nil {
@@ -101,8 +224,18 @@ func (e *StorageEngine) createShard(opts []shard.Option) (*shard.Shard, error) {
 		shard.WithExpiredLocksCallback(e.processExpiredLocks),
 		shard.WithDeletedLockCallback(e.processDeletedLocks),
 		shard.WithReportErrorFunc(e.reportShardErrorBackground),
+		shard.WithCorruptedObjectCallback(e.handleCorruptedObject),
 	)...)
 
+	// UpdateID below opens the metabase of the shard, so the shard must not
+	// reuse the storage path of any attached shard
+	cfgID := calculateShardID(sh.DumpInfo())
+	for _, s := range e.unsortedShards() {
+		if calculateShardID(s.DumpInfo()) == cfgID {
+			return nil, fmt.Errorf("shard %s already uses %s path", s.ID(), cfgID)
+		}
+	}
+
 	if err := sh.UpdateID(); err != nil {
 		return nil, fmt.Errorf("could not update shard ID: %w", err)
 	}
@@ -126,6 +259,7 @@ func (e *StorageEngine) addShard(sh *shard.Shard) error {
 
 	e.shards[strID] = shardWrapper{
 		errorCount: new(atomic.Uint32),
+		space:      new(shardSpace),
 		Shard:      sh,
 	}
 
@@ -164,6 +298,9 @@ func (e *StorageEngine) removeShards(ids ...string) {
 	}
 	e.mtx.Unlock()
 
+	// objects of the removed shards must not be served anymore
+	e.purgeCache()
+
 	for _, sh := range ss {
 		err := sh.Close()
 		if err != nil {
@@ -233,13 +370,16 @@ func (e *StorageEngine) SetShardMode(id *shard.ID, m mode.Mode, resetErrorCounte
 		}
 	}
 
-	return errShardNotFound
+	return ErrShardNotFound
 }
 
 // HandleNewEpoch notifies every shard about NewEpoch event.
 func (e *StorageEngine) HandleNewEpoch(epoch uint64) {
 	ev := shard.EventNewEpoch(epoch)
 
+	// cached objects could expire
+	e.purgeCache()
+
 	e.mtx.RLock()
 	defer e.mtx.RUnlock()
 
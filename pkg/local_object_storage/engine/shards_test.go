@@ -1,10 +1,18 @@
 package engine
 
 import (
+	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 )
 
 func TestRemoveShard(t *testing.T) {
@@ -44,3 +52,59 @@ func TestRemoveShard(t *testing.T) {
 		require.True(t, ok != removed)
 	}
 }
+
+func TestAttachDetachShard(t *testing.T) {
+	e, ids := newEngineRebalance(t, 1)
+	dir := t.TempDir()
+
+	shardOpts := func(name string) []shard.Option {
+		return []shard.Option{
+			shard.WithLogger(zaptest.NewLogger(t)),
+			shard.WithBlobstor(newStorage(filepath.Join(dir, name+".fstree"))),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(dir, name+".metabase")),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{}),
+			),
+		}
+	}
+
+	id, err := e.AttachShard(shardOpts("new")...)
+	require.NoError(t, err)
+	require.Len(t, e.DumpInfo().Shards, 2)
+
+	sh := e.getShard(id.String())
+	require.NotNil(t, sh.Shard)
+	require.Equal(t, mode.ReadWrite, sh.GetMode())
+
+	obj := generateObjectWithCID(cidtest.ID())
+	addr := objectCore.AddressOf(obj)
+	require.NoError(t, sh.Put(context.Background(), obj, nil))
+
+	got, err := e.Get(context.Background(), addr)
+	require.NoError(t, err)
+	require.Equal(t, obj, got)
+
+	t.Run("same path", func(t *testing.T) {
+		_, err := e.AttachShard(shardOpts("new")...)
+		require.Error(t, err)
+		require.Len(t, e.DumpInfo().Shards, 2)
+	})
+
+	require.NoError(t, e.DetachShard(id))
+	require.Len(t, e.DumpInfo().Shards, 1)
+	require.Equal(t, ids[0], e.DumpInfo().Shards[0].ID)
+	require.ErrorIs(t, e.DetachShard(id), ErrShardNotFound)
+
+	_, err = e.Get(context.Background(), addr)
+	require.Error(t, err)
+
+	// shard keeps its ID and objects when attached again
+	reID, err := e.AttachShard(shardOpts("new")...)
+	require.NoError(t, err)
+	require.Equal(t, id, reID)
+
+	got, err = e.Get(context.Background(), addr)
+	require.NoError(t, err)
+	require.Equal(t, obj, got)
+}
@@ -0,0 +1,148 @@
+package engine
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"go.uber.org/zap"
+)
+
+// SpacePolicy configures free space monitoring of the shards.
+type SpacePolicy struct {
+	// NearFull is the share of the used disk space (from 0 to 1) above which
+	// the shard is tried last on PUT and a warning is logged. Zero disables
+	// the watermark.
+	NearFull float64
+	// ReadOnly is the share of the used disk space (from 0 to 1) above which
+	// the shard is moved to read-only mode before the disk is full. The shard
+	// is moved back to read-write mode when the usage drops below NearFull
+	// (ReadOnly if NearFull is not set). Zero disables the watermark.
+	ReadOnly float64
+	// Interval is the time between disk space checks.
+	Interval time.Duration
+}
+
+// WithSpacePolicy returns an option to monitor free disk space of the shards
+// according to the given policy. Disabled by default.
+func WithSpacePolicy(p SpacePolicy) Option {
+	return func(c *cfg) {
+		c.space = p
+	}
+}
+
+func (e *StorageEngine) spaceMonitorEnabled() bool {
+	return (e.space.NearFull > 0 || e.space.ReadOnly > 0) && e.space.Interval > 0
+}
+
+// shardSpace is the disk space state of the shard.
+type shardSpace struct {
+	// nearFull is set if the used space is above the NearFull watermark.
+	nearFull atomic.Bool
+	// readOnly is set if the shard is moved to read-only mode by the engine
+	// because of the lack of space.
+	readOnly atomic.Bool
+}
+
+// spaceMonitorLoop periodically checks free space of the shards until the
+// engine is closed.
+func (e *StorageEngine) spaceMonitorLoop() {
+	defer e.wg.Done()
+
+	t := time.NewTicker(e.space.Interval)
+	defer t.Stop()
+
+	for {
+		e.checkSpace()
+
+		select {
+		case <-e.closeCh:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// checkSpace updates disk space state of all the shards.
+func (e *StorageEngine) checkSpace() {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	for _, sh := range e.shards {
+		e.checkShardSpace(sh)
+	}
+}
+
+// checkShardSpace updates disk space state of the shard. Must be called
+// under e.mtx read lock.
+func (e *StorageEngine) checkShardSpace(sh shardWrapper) {
+	var (
+		id   = sh.ID()
+		path = sh.DumpInfo().BlobStorInfo.Path
+	)
+
+	u, err := e.diskUsage(path)
+	if err != nil {
+		e.log.Warn("could not get shard disk usage", zap.Stringer("shard_id", id), zap.Error(err))
+		return
+	}
+
+	if e.metrics != nil {
+		e.metrics.SetCapacitySize(id.String(), u.Total)
+		e.metrics.SetFreeSpace(id.String(), u.Available)
+	}
+
+	var (
+		used     = u.UsedRatio()
+		fields   = []zap.Field{zap.Stringer("shard_id", id), zap.Float64("used", used), zap.Uint64("available", u.Available)}
+		nearFull = e.space.NearFull > 0 && used >= e.space.NearFull
+	)
+
+	if sh.space.nearFull.Swap(nearFull) != nearFull {
+		if nearFull {
+			e.log.Warn("shard is nearly full, it is used for new objects only if other shards can't store them", fields...)
+		} else {
+			e.log.Info("shard has enough free space again", fields...)
+		}
+	}
+
+	if e.space.ReadOnly <= 0 {
+		return
+	}
+
+	if used >= e.space.ReadOnly {
+		if sh.GetMode() != mode.ReadWrite {
+			return
+		}
+
+		err = sh.SetMode(mode.ReadOnly)
+		if err != nil {
+			e.log.Error("could not move full shard to read-only mode", append(fields, zap.Error(err))...)
+			return
+		}
+		sh.space.readOnly.Store(true)
+		e.log.Warn("shard is moved to read-only mode due to lack of free space", fields...)
+		return
+	}
+
+	resume := e.space.NearFull
+	if resume <= 0 {
+		resume = e.space.ReadOnly
+	}
+	if !sh.space.readOnly.Load() || used >= resume {
+		return
+	}
+
+	sh.space.readOnly.Store(false)
+	if sh.GetMode() != mode.ReadOnly {
+		// mode was changed since then
+		return
+	}
+
+	err = sh.SetMode(mode.ReadWrite)
+	if err != nil {
+		e.log.Error("could not move shard back to read-write mode", append(fields, zap.Error(err))...)
+		return
+	}
+	e.log.Info("shard is moved back to read-write mode since it has enough free space", fields...)
+}
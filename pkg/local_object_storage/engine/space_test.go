@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/diskspace"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSpaceMonitor(t *testing.T) {
+	const total = 1000
+
+	var (
+		dir   = t.TempDir()
+		mtx   sync.Mutex
+		usage = make(map[string]uint64) // path -> used
+		e     = New(WithLogger(zaptest.NewLogger(t)), WithSpacePolicy(SpacePolicy{
+			NearFull: 0.9,
+			ReadOnly: 0.95,
+			Interval: time.Hour,
+		}))
+	)
+	e.diskUsage = func(path string) (diskspace.Usage, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return diskspace.Usage{Total: total, Available: total - usage[path]}, nil
+	}
+	setUsed := func(sh shardWrapper, used uint64) {
+		mtx.Lock()
+		usage[sh.DumpInfo().BlobStorInfo.Path] = used
+		mtx.Unlock()
+		e.checkSpace()
+	}
+
+	for i := range 3 {
+		_, err := e.AddShard(
+			shard.WithLogger(zaptest.NewLogger(t)),
+			shard.WithBlobstor(newStorage(filepath.Join(dir, fmt.Sprintf("fstree%d", i)))),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(dir, fmt.Sprintf("metabase%d", i))),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{}),
+			))
+		require.NoError(t, err)
+	}
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+	t.Cleanup(func() { _ = e.Close() })
+
+	obj := generateObjectWithCID(cidtest.ID())
+	addr := objectCore.AddressOf(obj)
+	sorted := e.sortedShards(addr)
+	best := sorted[0]
+
+	for _, sh := range sorted {
+		setUsed(sh, total/2)
+	}
+	require.Equal(t, sorted, e.placementShards(addr))
+
+	// nearly full shard is tried last
+	setUsed(best, 920)
+	require.Equal(t, append(sorted[1:], best), e.placementShards(addr))
+	require.Equal(t, mode.ReadWrite, best.GetMode())
+
+	require.NoError(t, e.Put(context.Background(), obj, nil))
+	exists, err := best.Exists(addr, false)
+	require.NoError(t, err)
+	require.False(t, exists)
+	exists, err = sorted[1].Exists(addr, false)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// full shard is moved to read-only mode
+	setUsed(best, 960)
+	require.Equal(t, mode.ReadOnly, best.GetMode())
+
+	// but not back until it has enough free space
+	setUsed(best, 930)
+	require.Equal(t, mode.ReadOnly, best.GetMode())
+
+	setUsed(best, 500)
+	require.Equal(t, mode.ReadWrite, best.GetMode())
+	require.Equal(t, sorted, e.placementShards(addr))
+
+	t.Run("mode set by operator", func(t *testing.T) {
+		sh := sorted[2]
+		require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+		setUsed(sh, 960)
+		require.Equal(t, mode.ReadOnly, sh.GetMode())
+		setUsed(sh, 500)
+		require.Equal(t, mode.ReadOnly, sh.GetMode())
+	})
+}
@@ -0,0 +1,401 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/hrw/v2"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTierMoveInterval = time.Hour
+	defaultTierBatchSize    = 100
+
+	// maxTrackedObjects limits the number of objects accesses to which are
+	// tracked in memory. Accesses to other objects are not counted until
+	// some tracked objects are forgotten.
+	maxTrackedObjects = 1 << 20
+)
+
+// Tier move directions.
+const (
+	tierDemote  = "demote"
+	tierPromote = "promote"
+)
+
+// TieringPolicy describes placement of the objects to the shards of different
+// storage tiers (see [shard.WithTier]) and their migration between the tiers.
+type TieringPolicy struct {
+	// HotTier is a name of the fast tier new objects are put to. Shards of
+	// other tiers are used only if no hot one accepts the object. Empty name
+	// disables tiering.
+	HotTier string
+	// ColdTier is a name of the slow tier objects are demoted to.
+	ColdTier string
+	// DemoteAfter is the time since the last object access after which it
+	// is moved from the hot tier to the cold one. Zero disables demotion.
+	DemoteAfter time.Duration
+	// PromoteReads is the number of object reads from the cold tier after
+	// which it is moved back to the hot tier. Reads are counted until the
+	// object is not accessed for DemoteAfter (or Interval if demotion is
+	// disabled). Zero disables promotion.
+	PromoteReads uint32
+	// Interval is the period of the background tier mover. Defaults to one
+	// hour.
+	Interval time.Duration
+}
+
+// WithTiering returns an option to specify storage tiers policy. Tiering is
+// disabled by default, all shards are used alike.
+func WithTiering(p TieringPolicy) Option {
+	return func(c *cfg) {
+		c.tiering = p
+	}
+}
+
+func (e *StorageEngine) tieringEnabled() bool {
+	return e.tiering.HotTier != ""
+}
+
+// objectAccess is the last access time in Unix nanoseconds and the number of
+// reads since the object was not accessed for the tiering window.
+type objectAccess struct {
+	last  int64
+	reads uint32
+}
+
+// accessTracker tracks accesses to the stored objects in memory, objects
+// not accessed since the tracker start are considered accessed at start.
+type accessTracker struct {
+	mtx   sync.Mutex
+	since time.Time
+	m     map[oid.Address]objectAccess
+}
+
+func (x *accessTracker) start() {
+	x.mtx.Lock()
+	x.since = time.Now()
+	x.m = make(map[oid.Address]objectAccess)
+	x.mtx.Unlock()
+}
+
+// touch records access to the object, read is set for payload reads.
+func (x *accessTracker) touch(addr oid.Address, read bool) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	a, ok := x.m[addr]
+	if !ok && len(x.m) >= maxTrackedObjects {
+		return
+	}
+
+	a.last = time.Now().UnixNano()
+	if read {
+		a.reads++
+	}
+	x.m[addr] = a
+}
+
+// lastAccess returns the time of the last access to the object.
+func (x *accessTracker) lastAccess(addr oid.Address) time.Time {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if a, ok := x.m[addr]; ok {
+		return time.Unix(0, a.last)
+	}
+	return x.since
+}
+
+// forget drops objects not accessed since the given time. It does not change
+// lastAccess results for them as long as they are older than tracker start.
+func (x *accessTracker) forget(before time.Time) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	b := before.UnixNano()
+	for addr, a := range x.m {
+		if a.last < b {
+			delete(x.m, addr)
+		}
+	}
+}
+
+// frequent returns objects read at least n times and resets their counters.
+func (x *accessTracker) frequent(n uint32) []oid.Address {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	var res []oid.Address
+	for addr, a := range x.m {
+		if a.reads >= n {
+			res = append(res, addr)
+			a.reads = 0
+			x.m[addr] = a
+		}
+	}
+	return res
+}
+
+// touchObject records access to the object if tiering is enabled.
+func (e *StorageEngine) touchObject(addr oid.Address, read bool) {
+	if e.tieringEnabled() {
+		e.access.touch(addr, read)
+	}
+}
+
+// placementShards returns shards in the order they are tried to store the new
+// object: HRW-sorted shards of the hot tier go first if tiering is enabled,
+// nearly full shards go last if free space is monitored.
+func (e *StorageEngine) placementShards(addr oid.Address) []shardWrapper {
+	shards := e.sortedShards(addr)
+	if !e.tieringEnabled() && !e.spaceMonitorEnabled() {
+		return shards
+	}
+
+	slices.SortStableFunc(shards, func(a, b shardWrapper) int {
+		return e.placementOrder(a) - e.placementOrder(b)
+	})
+	return shards
+}
+
+// placementOrder returns the group of the shard in the PUT order: shards
+// with enough free space go before nearly full ones, hot tier shards go
+// before the other ones within each group.
+func (e *StorageEngine) placementOrder(sh shardWrapper) int {
+	var order int
+	if sh.space.nearFull.Load() {
+		order += 2
+	}
+	if e.tieringEnabled() && sh.Tier() != e.tiering.HotTier {
+		order++
+	}
+	return order
+}
+
+// tierMoveLoop periodically moves objects between the storage tiers until
+// the engine is closed.
+func (e *StorageEngine) tierMoveLoop() {
+	defer e.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-e.closeCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	interval := e.tiering.Interval
+	if interval <= 0 {
+		interval = defaultTierMoveInterval
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			e.moveTiers(ctx)
+		}
+	}
+}
+
+// moveTiers promotes objects read often from the cold tier to the hot one and
+// demotes regular objects not accessed for a long time from the hot tier to
+// the cold one. Returns the number of moved objects.
+func (e *StorageEngine) moveTiers(ctx context.Context) (promoted, demoted uint64) {
+	window := e.tiering.DemoteAfter
+	if window <= 0 {
+		window = e.tiering.Interval
+	}
+	if window > 0 {
+		e.access.forget(time.Now().Add(-window))
+	}
+
+	var hot, cold []shardWrapper
+	for _, sh := range e.unsortedShards() {
+		switch sh.Tier() {
+		case e.tiering.HotTier:
+			hot = append(hot, sh)
+		case e.tiering.ColdTier:
+			cold = append(cold, sh)
+		}
+	}
+	if len(hot) == 0 || len(cold) == 0 {
+		return 0, 0
+	}
+
+	if e.tiering.PromoteReads > 0 {
+		promoted = e.promoteObjects(ctx, hot, cold)
+	}
+	if e.tiering.DemoteAfter > 0 {
+		demoted = e.demoteObjects(ctx, hot, cold)
+	}
+
+	if promoted > 0 || demoted > 0 {
+		e.log.Info("objects are moved between storage tiers",
+			zap.Uint64("promoted", promoted),
+			zap.Uint64("demoted", demoted))
+	}
+
+	return promoted, demoted
+}
+
+func (e *StorageEngine) promoteObjects(ctx context.Context, hot, cold []shardWrapper) uint64 {
+	var count uint64
+
+	for _, addr := range e.access.frequent(e.tiering.PromoteReads) {
+		if ctx.Err() != nil {
+			break
+		}
+
+		for _, sh := range cold {
+			exists, err := sh.Exists(addr, false)
+			if err != nil || !exists {
+				continue
+			}
+
+			moved, err := e.moveObject(sh, addr, hot)
+			if err != nil {
+				e.log.Debug("could not promote object",
+					zap.Stringer("shard", sh.ID()),
+					zap.Stringer("addr", addr),
+					zap.Error(err))
+			}
+			if moved {
+				count++
+				e.reportTierMove(tierPromote)
+			}
+			break
+		}
+	}
+
+	return count
+}
+
+func (e *StorageEngine) demoteObjects(ctx context.Context, hot, cold []shardWrapper) uint64 {
+	var (
+		count     uint64
+		threshold = time.Now().Add(-e.tiering.DemoteAfter)
+	)
+
+	for _, sh := range hot {
+		if sh.GetMode() != mode.ReadWrite {
+			continue
+		}
+
+		var c *shard.Cursor
+		for ctx.Err() == nil {
+			lst, next, err := sh.ListWithCursor(defaultTierBatchSize, c)
+			if err != nil {
+				if !errors.Is(err, ErrEndOfListing) {
+					e.log.Warn("could not list objects to demote",
+						zap.Stringer("shard", sh.ID()),
+						zap.Error(err))
+				}
+				break
+			}
+
+			for i := range lst {
+				if lst[i].Type != objectSDK.TypeRegular || !e.access.lastAccess(lst[i].Address).Before(threshold) {
+					continue
+				}
+
+				moved, err := e.moveObject(sh, lst[i].Address, cold)
+				if err != nil {
+					e.log.Debug("could not demote object",
+						zap.Stringer("shard", sh.ID()),
+						zap.Stringer("addr", lst[i].Address),
+						zap.Error(err))
+				}
+				if moved {
+					count++
+					e.reportTierMove(tierDemote)
+				}
+			}
+
+			c = next
+		}
+	}
+
+	return count
+}
+
+func (e *StorageEngine) reportTierMove(direction string) {
+	if e.metrics != nil {
+		e.metrics.AddTierMovedObjects(direction, 1)
+	}
+}
+
+// moveObject moves the object from src to the first shard among dst in HRW
+// order accepting it. Returns true if the object was moved.
+func (e *StorageEngine) moveObject(src shardWrapper, addr oid.Address, dst []shardWrapper) (bool, error) {
+	e.blockMtx.RLock()
+	defer e.blockMtx.RUnlock()
+
+	if e.blockErr != nil {
+		return false, e.blockErr
+	}
+
+	var obj *objectSDK.Object
+
+	dst = slices.Clone(dst)
+	hrw.Sort(dst, hrw.WrapBytes([]byte(addr.EncodeToString())))
+
+	for _, sh := range dst {
+		if sh.GetMode() != mode.ReadWrite {
+			continue
+		}
+
+		id := sh.ID().String()
+		e.mtx.RLock()
+		pool, ok := e.shardPools[id]
+		e.mtx.RUnlock()
+		if !ok {
+			// Shard was concurrently removed, skip.
+			continue
+		}
+
+		if obj == nil {
+			var err error
+			obj, err = src.Get(moveCtx, addr, false)
+			if err != nil {
+				return false, fmt.Errorf("get object: %w", err)
+			}
+		}
+
+		putDone, exists, _ := e.putToShard(moveCtx, sh, 0, pool, addr, obj, nil)
+		if !putDone && !exists {
+			continue
+		}
+
+		err := src.Delete(moveCtx, []oid.Address{addr})
+		if err != nil {
+			return false, fmt.Errorf("delete moved object from the shard: %w", err)
+		}
+
+		e.log.Debug("object is moved to another storage tier",
+			zap.Stringer("from", src.ID()),
+			zap.String("to", id),
+			zap.Stringer("addr", addr))
+
+		return true, nil
+	}
+
+	return false, nil
+}
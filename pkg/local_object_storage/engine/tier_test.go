@@ -0,0 +1,137 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func newEngineTiers(t *testing.T, p TieringPolicy, tiers ...string) *StorageEngine {
+	dir := t.TempDir()
+
+	e := New(WithLogger(zaptest.NewLogger(t)), WithTiering(p))
+
+	for i, tier := range tiers {
+		_, err := e.AddShard(
+			shard.WithLogger(zaptest.NewLogger(t)),
+			shard.WithTier(tier),
+			shard.WithBlobstor(newStorage(filepath.Join(dir, fmt.Sprintf("fstree%d", i)))),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(dir, fmt.Sprintf("metabase%d", i))),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{}),
+			))
+		require.NoError(t, err)
+	}
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+	t.Cleanup(func() { _ = e.Close() })
+
+	return e
+}
+
+func objectTier(t *testing.T, e *StorageEngine, addr oid.Address) string {
+	var tier string
+	for _, sh := range e.unsortedShards() {
+		exists, err := sh.Exists(addr, false)
+		require.NoError(t, err)
+		if exists {
+			require.Empty(t, tier, "object is stored twice")
+			tier = sh.Tier()
+		}
+	}
+	return tier
+}
+
+func TestTiering(t *testing.T) {
+	const objNum = 10
+
+	p := TieringPolicy{
+		HotTier:      "nvme",
+		ColdTier:     "hdd",
+		DemoteAfter:  time.Hour,
+		PromoteReads: 2,
+		Interval:     time.Hour,
+	}
+	e := newEngineTiers(t, p, "hdd", "nvme", "hdd")
+
+	addrs := make([]oid.Address, objNum)
+	for i := range addrs {
+		obj := generateObjectWithCID(cidtest.ID())
+		require.NoError(t, e.Put(context.Background(), obj, nil))
+		addrs[i] = objectCore.AddressOf(obj)
+
+		require.Equal(t, "nvme", objectTier(t, e, addrs[i]))
+	}
+
+	// recently put objects are not demoted
+	promoted, demoted := e.moveTiers(context.Background())
+	require.Zero(t, promoted)
+	require.Zero(t, demoted)
+
+	// objects are idle
+	e.access.start()
+	e.access.since = time.Now().Add(-2 * p.DemoteAfter)
+
+	// but the first one is accessed
+	_, err := e.Get(context.Background(), addrs[0])
+	require.NoError(t, err)
+
+	promoted, demoted = e.moveTiers(context.Background())
+	require.Zero(t, promoted)
+	require.EqualValues(t, objNum-1, demoted)
+
+	require.Equal(t, "nvme", objectTier(t, e, addrs[0]))
+	for _, addr := range addrs[1:] {
+		require.Equal(t, "hdd", objectTier(t, e, addr))
+	}
+
+	// cold object becomes hot
+	for range p.PromoteReads {
+		_, err = e.Get(context.Background(), addrs[1])
+		require.NoError(t, err)
+	}
+
+	promoted, demoted = e.moveTiers(context.Background())
+	require.EqualValues(t, 1, promoted)
+	require.Zero(t, demoted)
+	require.Equal(t, "nvme", objectTier(t, e, addrs[1]))
+
+	for _, addr := range addrs {
+		_, err = e.Get(context.Background(), addr)
+		require.NoError(t, err)
+	}
+}
+
+func TestTiering_Rebalance(t *testing.T) {
+	e := newEngineTiers(t, TieringPolicy{HotTier: "nvme", ColdTier: "hdd"}, "nvme", "hdd", "hdd")
+
+	// cold objects are not moved to the hot tier even if hot shard has the
+	// highest HRW weight
+	var addrs []oid.Address
+	for _, sh := range e.unsortedShards() {
+		if sh.Tier() != "hdd" {
+			continue
+		}
+		for _, obj := range putToFirstShard(t, e, sh.ID(), 10) {
+			addrs = append(addrs, objectCore.AddressOf(obj))
+		}
+	}
+
+	_, err := e.Rebalance(context.Background(), nil, 0, nil)
+	require.NoError(t, err)
+
+	for _, addr := range addrs {
+		require.Equal(t, "hdd", objectTier(t, e, addr))
+	}
+}
@@ -11,7 +11,7 @@ func (e *StorageEngine) FlushWriteCache(id *shard.ID) error {
 	e.mtx.RUnlock()
 
 	if !ok {
-		return errShardNotFound
+		return ErrShardNotFound
 	}
 
 	return sh.FlushWriteCache(false)
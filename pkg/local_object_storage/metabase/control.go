@@ -64,7 +64,7 @@ func (db *DB) openBolt() error {
 // Does nothing if metabase has already been initialized and filled. To roll back the database to its initial state,
 // use Reset.
 func (db *DB) Init() error {
-	return db.init(false)
+	return db.init(false, false)
 }
 
 // Reset resets metabase. Works similar to Init but cleans up all static buckets and
@@ -77,10 +77,10 @@ func (db *DB) Reset() error {
 		return ErrDegradedMode
 	}
 
-	return db.init(true)
+	return db.init(true, false)
 }
 
-func (db *DB) init(reset bool) error {
+func (db *DB) init(reset, resync bool) error {
 	if db.mode.NoMetabase() || db.mode.ReadOnly() {
 		return nil
 	}
@@ -103,7 +103,14 @@ func (db *DB) init(reset bool) error {
 	}
 
 	return db.boltDB.Update(func(tx *bbolt.Tx) error {
-		var err error
+		var (
+			err  error
+			kept map[string][]byte
+		)
+		if resync {
+			kept = keptOnResync(tx)
+		}
+
 		for k := range mStaticBuckets {
 			name := []byte(k)
 			if reset {
@@ -139,6 +146,19 @@ func (db *DB) init(reset bool) error {
 			if err != nil {
 				return err
 			}
+			if resync {
+				b := tx.Bucket(shardInfoBucket)
+				for k, v := range kept {
+					err = b.Put([]byte(k), v)
+					if err != nil {
+						return fmt.Errorf("could not restore %q shard info: %w", k, err)
+					}
+				}
+				err = b.Put(resyncKey, []byte{})
+				if err != nil {
+					return fmt.Errorf("could not mark resync start: %w", err)
+				}
+			}
 		} else {
 			err = syncCounter(tx, false)
 			if err != nil {
@@ -0,0 +1,67 @@
+package meta
+
+import (
+	"bytes"
+	"fmt"
+
+	"go.etcd.io/bbolt"
+)
+
+var encryptionKeyKey = []byte("encryption_key")
+
+// ReadEncryptionKeyID reads from db ID of the key all shard objects are
+// encrypted with. If ID is missing (objects are stored unencrypted), returns
+// nil, nil.
+func (db *DB) ReadEncryptionKeyID() ([]byte, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	var id []byte
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(shardInfoBucket)
+		if b == nil {
+			return nil
+		}
+
+		id = bytes.Clone(b.Get(encryptionKeyKey))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read encryption key ID: %w", err)
+	}
+
+	return id, nil
+}
+
+// WriteEncryptionKeyID saves to db ID of the key all shard objects are
+// encrypted with. Empty ID means objects are stored unencrypted.
+func (db *DB) WriteEncryptionKeyID(id []byte) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+		if len(id) == 0 {
+			b := tx.Bucket(shardInfoBucket)
+			if b == nil {
+				return nil
+			}
+			return b.Delete(encryptionKeyKey)
+		}
+
+		b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+		if err != nil {
+			return fmt.Errorf("can't create auxiliary bucket: %w", err)
+		}
+		return b.Put(encryptionKeyKey, id)
+	})
+}
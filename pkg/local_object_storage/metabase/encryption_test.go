@@ -0,0 +1,35 @@
+package meta_test
+
+import (
+	"testing"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_EncryptionKeyID(t *testing.T) {
+	db := newDB(t)
+
+	id, err := db.ReadEncryptionKeyID()
+	require.NoError(t, err)
+	require.Empty(t, id)
+
+	// removal of the missing ID is OK
+	require.NoError(t, db.WriteEncryptionKeyID(nil))
+
+	require.NoError(t, db.WriteEncryptionKeyID([]byte{1, 2, 3, 4}))
+	id, err = db.ReadEncryptionKeyID()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3, 4}, id)
+
+	require.NoError(t, db.WriteEncryptionKeyID(nil))
+	id, err = db.ReadEncryptionKeyID()
+	require.NoError(t, err)
+	require.Empty(t, id)
+
+	t.Run("read-only", func(t *testing.T) {
+		require.NoError(t, db.Close())
+		require.NoError(t, db.Open(true))
+		require.ErrorIs(t, db.WriteEncryptionKeyID([]byte{1}), meta.ErrReadOnlyMode)
+	})
+}
@@ -265,6 +265,28 @@ func (db *DB) DropExpiredTSMarks(epoch uint64) (int, error) {
 	return counter, nil
 }
 
+// GarbageCount returns number of objects marked as garbage and waiting to
+// be removed by GC. Objects of the removed containers are not counted. It
+// traverses all the garbage records, so it must not be called frequently.
+func (db *DB) GarbageCount() (uint64, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	}
+
+	var res uint64
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		bkt := tx.Bucket(garbageObjectsBucketName)
+		if bkt != nil {
+			res = uint64(bkt.Stats().KeyN)
+		}
+		return nil
+	})
+	return res, err
+}
+
 // GetGarbage returns garbage according to the metabase state. Garbage includes
 // objects marked with GC mark (expired, tombstoned but not deleted from disk,
 // extra replicated, etc.) and removed containers.
@@ -419,3 +419,25 @@ func TestDropExpiredTSMarks(t *testing.T) {
 		require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
 	}
 }
+
+func TestDB_GarbageCount(t *testing.T) {
+	db := newDB(t)
+
+	n, err := db.GarbageCount()
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	var addrs []oid.Address
+	for range 3 {
+		obj := generateObject(t)
+		require.NoError(t, putBig(db, obj))
+		addrs = append(addrs, object.AddressOf(obj))
+	}
+
+	_, _, err = db.MarkGarbage(false, false, addrs[:2]...)
+	require.NoError(t, err)
+
+	n, err = db.GarbageCount()
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+}
@@ -0,0 +1,205 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// ContainerSizeMismatch describes container size estimation that does not
+// match the objects stored in the metabase.
+type ContainerSizeMismatch struct {
+	Container cid.ID
+	// Stored is the size estimation kept in the metabase.
+	Stored uint64
+	// Actual is the total payload size of the available regular objects.
+	Actual uint64
+}
+
+// CountersCheck describes results of [DB.CheckCounters].
+type CountersCheck struct {
+	// Stored are the object counters kept in the metabase.
+	Stored ObjectCounters
+	// Actual are the object counters calculated from the metabase indexes.
+	Actual ObjectCounters
+	// ContainerSizes are the wrong container size estimations.
+	ContainerSizes []ContainerSizeMismatch
+}
+
+// Consistent checks whether the stored counters and container sizes match the
+// metabase indexes.
+func (x CountersCheck) Consistent() bool {
+	return x.Stored == x.Actual && len(x.ContainerSizes) == 0
+}
+
+// HasPhysicalRecord checks whether metabase has a record of the physically
+// stored object regardless of its status: removed, expired and garbage objects
+// are also reported.
+func (db *DB) HasPhysicalRecord(addr oid.Address) (bool, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return false, ErrDegradedMode
+	}
+
+	var res bool
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(metaBucketKey(addr.Container()))
+		if b == nil {
+			return nil
+		}
+
+		id := addr.Object()
+		key := append(mkFilterPhysicalPrefix(), id[:]...)
+		k, _ := b.Cursor().Seek(key)
+		res = bytes.Equal(k, key)
+		return nil
+	})
+
+	return res, err
+}
+
+// CheckCounters compares object counters and container size estimations with
+// the objects indexed in the metabase. If repair is set, wrong values are
+// overwritten with the actual ones.
+func (db *DB) CheckCounters(repair bool) (CountersCheck, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return CountersCheck{}, ErrDegradedMode
+	} else if repair && db.mode.ReadOnly() {
+		return CountersCheck{}, ErrReadOnlyMode
+	}
+
+	var (
+		res CountersCheck
+		err error
+	)
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		res, err = checkCounters(tx)
+		return err
+	})
+	if err != nil || !repair || res.Consistent() {
+		return res, err
+	}
+
+	// objects could be changed after the check, so write lock is taken only
+	// now and the values are recalculated
+	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+		res, err = checkCounters(tx)
+		if err != nil || res.Consistent() {
+			return err
+		}
+		return repairCounters(tx, res)
+	})
+
+	return res, err
+}
+
+func checkCounters(tx *bbolt.Tx) (CountersCheck, error) {
+	var (
+		res                  CountersCheck
+		addr                 oid.Address
+		key                  = make([]byte, addressKeySize)
+		sizes                = make(map[cid.ID]uint64)
+		graveyardBKT         = tx.Bucket(graveyardBucketName)
+		garbageObjectsBKT    = tx.Bucket(garbageObjectsBucketName)
+		garbageContainersBKT = tx.Bucket(garbageContainersBucketName)
+	)
+
+	res.Stored.phy, res.Stored.logic = getCounters(tx)
+
+	err := iteratePhyObjects(tx, func(cnr cid.ID, obj oid.ID) error {
+		res.Actual.phy++
+
+		addr.SetContainer(cnr)
+		addr.SetObject(obj)
+
+		if inGraveyardWithKey(addressKey(addr, key), graveyardBKT, garbageObjectsBKT, garbageContainersBKT) != statusAvailable {
+			return nil
+		}
+
+		res.Actual.logic++
+
+		hdr, err := get(tx, addr, false, false, 0)
+		if err != nil {
+			return fmt.Errorf("could not get %s object header: %w", addr, err)
+		}
+		if hdr.Type() == objectSDK.TypeRegular {
+			sizes[cnr] += hdr.PayloadSize()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return res, fmt.Errorf("could not iterate objects: %w", err)
+	}
+
+	containerVolume := tx.Bucket(containerVolumeBucketName)
+	if containerVolume != nil {
+		var cnr cid.ID
+		err = containerVolume.ForEach(func(k, v []byte) error {
+			if cnr.Decode(k) != nil {
+				return nil
+			}
+			if stored := parseContainerSize(v); stored != sizes[cnr] {
+				res.ContainerSizes = append(res.ContainerSizes, ContainerSizeMismatch{Container: cnr, Stored: stored, Actual: sizes[cnr]})
+			}
+			delete(sizes, cnr)
+			return nil
+		})
+		if err != nil {
+			return res, fmt.Errorf("could not iterate container sizes: %w", err)
+		}
+	}
+
+	for cnr, size := range sizes {
+		if size != 0 {
+			res.ContainerSizes = append(res.ContainerSizes, ContainerSizeMismatch{Container: cnr, Actual: size})
+		}
+	}
+
+	return res, nil
+}
+
+func repairCounters(tx *bbolt.Tx, res CountersCheck) error {
+	b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+	if err != nil {
+		return fmt.Errorf("could not get shard info bucket: %w", err)
+	}
+
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, res.Actual.phy)
+	if err = b.Put(objectPhyCounterKey, data); err != nil {
+		return fmt.Errorf("could not update phy object counter: %w", err)
+	}
+
+	data = make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, res.Actual.logic)
+	if err = b.Put(objectLogicCounterKey, data); err != nil {
+		return fmt.Errorf("could not update logic object counter: %w", err)
+	}
+
+	containerVolume, err := tx.CreateBucketIfNotExists(containerVolumeBucketName)
+	if err != nil {
+		return fmt.Errorf("could not get container volume bucket: %w", err)
+	}
+
+	for _, m := range res.ContainerSizes {
+		data = make([]byte, 8)
+		binary.LittleEndian.PutUint64(data, m.Actual)
+		if err = containerVolume.Put(m.Container[:], data); err != nil {
+			return fmt.Errorf("could not update %s container size: %w", m.Container, err)
+		}
+	}
+
+	return nil
+}
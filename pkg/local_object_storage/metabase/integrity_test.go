@@ -0,0 +1,110 @@
+package meta
+
+import (
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	checksumtest "github.com/nspcc-dev/neofs-sdk-go/checksum/test"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestDB_HasPhysicalRecord(t *testing.T) {
+	db := newDB(t)
+
+	var obj object.Object
+	obj.SetContainerID(cidtest.ID())
+	obj.SetID(oidtest.ID())
+	obj.SetOwner(usertest.ID())
+	obj.SetPayloadChecksum(checksumtest.Checksum())
+
+	addr := objectcore.AddressOf(&obj)
+
+	ok, err := db.HasPhysicalRecord(addr)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, db.Put(&obj))
+
+	ok, err = db.HasPhysicalRecord(addr)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, _, err = db.MarkGarbage(false, false, addr)
+	require.NoError(t, err)
+
+	ok, err = db.HasPhysicalRecord(addr)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestDB_CheckCounters(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+	objs := make([]object.Object, 3)
+	for i := range objs {
+		objs[i].SetContainerID(cnr)
+		objs[i].SetID(oidtest.ID())
+		objs[i].SetOwner(usertest.ID())
+		objs[i].SetPayloadChecksum(checksum.NewSHA256([32]byte{}))
+		objs[i].SetPayloadSize(10)
+		require.NoError(t, db.Put(&objs[i]))
+	}
+
+	res, err := db.CheckCounters(false)
+	require.NoError(t, err)
+	require.True(t, res.Consistent())
+	require.EqualValues(t, 3, res.Actual.Phy())
+	require.EqualValues(t, 3, res.Actual.Logic())
+
+	otherCnr := cidtest.ID()
+	require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+		require.NoError(t, db.updateCounter(tx, phy, 2, true))
+		require.NoError(t, db.updateCounter(tx, logical, 1, false))
+		require.NoError(t, changeContainerSize(tx, cnr, 5, true))
+		return changeContainerSize(tx, otherCnr, 7, true)
+	}))
+
+	check := func(t *testing.T, res CountersCheck) {
+		require.False(t, res.Consistent())
+		require.EqualValues(t, 5, res.Stored.Phy())
+		require.EqualValues(t, 2, res.Stored.Logic())
+		require.EqualValues(t, 3, res.Actual.Phy())
+		require.EqualValues(t, 3, res.Actual.Logic())
+		require.ElementsMatch(t, []ContainerSizeMismatch{
+			{Container: cnr, Stored: 35, Actual: 30},
+			{Container: otherCnr, Stored: 7, Actual: 0},
+		}, res.ContainerSizes)
+	}
+
+	res, err = db.CheckCounters(false)
+	require.NoError(t, err)
+	check(t, res)
+
+	res, err = db.CheckCounters(true)
+	require.NoError(t, err)
+	check(t, res)
+
+	res, err = db.CheckCounters(false)
+	require.NoError(t, err)
+	require.True(t, res.Consistent())
+
+	c, err := db.ObjectCounters()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, c.Phy())
+	require.EqualValues(t, 3, c.Logic())
+
+	size, err := db.ContainerSize(cnr)
+	require.NoError(t, err)
+	require.EqualValues(t, 30, size)
+
+	size, err = db.ContainerSize(otherCnr)
+	require.NoError(t, err)
+	require.Zero(t, size)
+}
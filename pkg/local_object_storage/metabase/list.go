@@ -2,6 +2,8 @@ package meta
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
 
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
@@ -21,6 +23,29 @@ type Cursor struct {
 	inBucketOffset []byte
 }
 
+// MarshalBinary encodes cursor into a binary form suitable for persistent
+// storage, it's a length-prefixed bucket name followed by the in-bucket
+// offset. Implements [encoding.BinaryMarshaler].
+func (c *Cursor) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, binary.MaxVarintLen64+len(c.bucketName)+len(c.inBucketOffset))
+	data = binary.AppendUvarint(data, uint64(len(c.bucketName)))
+	data = append(data, c.bucketName...)
+	return append(data, c.inBucketOffset...), nil
+}
+
+// UnmarshalBinary decodes cursor encoded with [Cursor.MarshalBinary].
+// Implements [encoding.BinaryUnmarshaler].
+func (c *Cursor) UnmarshalBinary(data []byte) error {
+	l, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < l {
+		return errors.New("invalid cursor encoding")
+	}
+
+	c.bucketName = bytes.Clone(data[n : n+int(l)])
+	c.inBucketOffset = bytes.Clone(data[n+int(l):])
+	return nil
+}
+
 // ListWithCursor lists physical objects available in metabase starting from
 // cursor. Includes objects of all types. Does not include inhumed objects.
 // Use cursor value from response for consecutive requests.
@@ -57,6 +82,9 @@ func (db *DB) listWithCursor(tx *bbolt.Tx, result []objectcore.AddressWithType,
 
 	if !threshold {
 		name, _ = c.Seek(cursor.bucketName)
+		// bucket could be removed since the previous call, in-bucket offset
+		// is useless then
+		threshold = !bytes.Equal(name, cursor.bucketName)
 	}
 
 	var containerID cid.ID
@@ -139,8 +167,10 @@ func selectNFromBucket(bkt *bbolt.Bucket, // main bucket
 	}
 	k, _ := c.Seek(offset)
 
-	if !threshold {
-		k, _ = c.Next() // we are looking for objects _after_ the cursor
+	if !threshold && bytes.Equal(k, offset) {
+		// we are looking for objects _after_ the cursor, the cursor object
+		// itself could be removed since the previous call
+		k, _ = c.Next()
 	}
 
 	for ; bytes.HasPrefix(k, phyPrefix); k, _ = c.Next() {
@@ -9,6 +9,7 @@ import (
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/bbolt"
@@ -208,6 +209,43 @@ func TestAddObjectDuringListingWithCursor(t *testing.T) {
 	}
 }
 
+func TestRemoveDuringListingWithCursor(t *testing.T) {
+	db := newDB(t)
+
+	const total = 5
+
+	cnr := cidtest.ID()
+	expected := make(map[oid.Address]int, total)
+
+	for range total {
+		obj := generateObjectWithCID(t, cnr)
+		require.NoError(t, putBig(db, obj))
+		expected[object.AddressOf(obj)] = 0
+	}
+
+	var cursor *meta.Cursor
+	for {
+		got, c, err := metaListWithCursor(db, 1, cursor)
+		if errors.Is(err, meta.ErrEndOfListing) {
+			break
+		}
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+
+		expected[got[0].Address]++
+
+		// cursor object is removed before the next call
+		_, err = db.Delete([]oid.Address{got[0].Address})
+		require.NoError(t, err)
+
+		cursor = c
+	}
+
+	for _, v := range expected {
+		require.Equal(t, 1, v)
+	}
+}
+
 func sortAddresses(addrWithType []object.AddressWithType) []object.AddressWithType {
 	sort.Slice(addrWithType, func(i, j int) bool {
 		return addrWithType[i].Address.EncodeToString() < addrWithType[j].Address.EncodeToString()
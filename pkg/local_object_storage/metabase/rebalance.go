@@ -0,0 +1,78 @@
+package meta
+
+import (
+	"fmt"
+
+	"go.etcd.io/bbolt"
+)
+
+var rebalanceCursorKey = []byte("rebalance_cursor")
+
+// ReadRebalanceCursor reads from db listing cursor of the interrupted
+// rebalance of the shard objects. If cursor is missing, returns nil, nil.
+func (db *DB) ReadRebalanceCursor() (*Cursor, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	var (
+		c   *Cursor
+		err error
+	)
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(shardInfoBucket)
+		if b == nil {
+			return nil
+		}
+
+		data := b.Get(rebalanceCursorKey)
+		if data == nil {
+			return nil
+		}
+
+		c = new(Cursor)
+		return c.UnmarshalBinary(data)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read rebalance cursor: %w", err)
+	}
+
+	return c, nil
+}
+
+// WriteRebalanceCursor saves listing cursor of the shard objects rebalance to
+// db, so that it can be continued after restart. Nil cursor removes the saved
+// one.
+func (db *DB) WriteRebalanceCursor(c *Cursor) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+		if c == nil {
+			b := tx.Bucket(shardInfoBucket)
+			if b == nil {
+				return nil
+			}
+			return b.Delete(rebalanceCursorKey)
+		}
+
+		b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+		if err != nil {
+			return fmt.Errorf("can't create auxiliary bucket: %w", err)
+		}
+		data, err := c.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		return b.Put(rebalanceCursorKey, data)
+	})
+}
@@ -0,0 +1,55 @@
+package meta_test
+
+import (
+	"testing"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_RebalanceCursor(t *testing.T) {
+	db := newDB(t)
+
+	c, err := db.ReadRebalanceCursor()
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	// removal of the missing cursor is OK
+	require.NoError(t, db.WriteRebalanceCursor(nil))
+
+	const total = 4
+	for range total {
+		require.NoError(t, putBig(db, generateObject(t)))
+	}
+
+	first, c, err := db.ListWithCursor(total/2, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.WriteRebalanceCursor(c))
+
+	c, err = db.ReadRebalanceCursor()
+	require.NoError(t, err)
+	require.NotNil(t, c)
+
+	second, _, err := db.ListWithCursor(total, c)
+	require.NoError(t, err)
+	require.Len(t, second, total-len(first))
+	for i := range second {
+		require.NotContains(t, first, second[i])
+	}
+
+	require.NoError(t, db.WriteRebalanceCursor(nil))
+
+	c, err = db.ReadRebalanceCursor()
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	t.Run("read-only", func(t *testing.T) {
+		var cur *meta.Cursor
+		_, cur, err = db.ListWithCursor(1, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, db.Close())
+		require.NoError(t, db.Open(true))
+		require.ErrorIs(t, db.WriteRebalanceCursor(cur), meta.ErrReadOnlyMode)
+	})
+}
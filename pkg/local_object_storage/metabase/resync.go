@@ -0,0 +1,139 @@
+package meta
+
+import (
+	"bytes"
+	"fmt"
+
+	"go.etcd.io/bbolt"
+)
+
+var (
+	resyncKey                = []byte("resync")
+	resyncPartitionKeyPrefix = []byte("resync_partition:")
+)
+
+// ResyncState describes the state of the metabase resynchronization.
+type ResyncState struct {
+	// InProgress is set if resynchronization was started but not finished yet.
+	InProgress bool
+	// DonePartitions are names of the storage partitions already
+	// resynchronized.
+	DonePartitions []string
+}
+
+// StartResync resets metabase like [DB.Reset] keeping shard ID and encryption
+// key ID, and marks it as being resynchronized. The mark is kept until
+// [DB.FinishResync], so the interrupted resynchronization can be resumed after
+// restart using [DB.ResyncState] without resetting metabase again.
+func (db *DB) StartResync() error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	return db.init(true, true)
+}
+
+// keptOnResync returns shard info not related to the stored objects that must
+// survive metabase reset on resynchronization start.
+func keptOnResync(tx *bbolt.Tx) map[string][]byte {
+	b := tx.Bucket(shardInfoBucket)
+	if b == nil {
+		return nil
+	}
+
+	res := make(map[string][]byte)
+	for _, k := range [][]byte{shardIDKey, encryptionKeyKey} {
+		if v := b.Get(k); v != nil {
+			res[string(k)] = bytes.Clone(v)
+		}
+	}
+	return res
+}
+
+// ResyncState reads from db the state of the metabase resynchronization.
+func (db *DB) ResyncState() (ResyncState, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ResyncState{}, ErrDegradedMode
+	}
+
+	var res ResyncState
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(shardInfoBucket)
+		if b == nil || b.Get(resyncKey) == nil {
+			return nil
+		}
+
+		res.InProgress = true
+
+		c := b.Cursor()
+		for k, _ := c.Seek(resyncPartitionKeyPrefix); bytes.HasPrefix(k, resyncPartitionKeyPrefix); k, _ = c.Next() {
+			res.DonePartitions = append(res.DonePartitions, string(k[len(resyncPartitionKeyPrefix):]))
+		}
+		return nil
+	})
+	if err != nil {
+		return ResyncState{}, fmt.Errorf("read resync state: %w", err)
+	}
+
+	return res, nil
+}
+
+// MarkResyncPartitionDone saves to db that the storage partition has been
+// resynchronized and must be skipped when resynchronization is resumed.
+func (db *DB) MarkResyncPartitionDone(partition string) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	return db.boltDB.Batch(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+		if err != nil {
+			return fmt.Errorf("can't create auxiliary bucket: %w", err)
+		}
+		return b.Put(append(bytes.Clone(resyncPartitionKeyPrefix), partition...), []byte{})
+	})
+}
+
+// FinishResync removes the resynchronization state saved in db.
+func (db *DB) FinishResync() error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(shardInfoBucket)
+		if b == nil {
+			return nil
+		}
+
+		var keys [][]byte
+		c := b.Cursor()
+		for k, _ := c.Seek(resyncPartitionKeyPrefix); bytes.HasPrefix(k, resyncPartitionKeyPrefix); k, _ = c.Next() {
+			keys = append(keys, bytes.Clone(k))
+		}
+		for i := range keys {
+			if err := b.Delete(keys[i]); err != nil {
+				return err
+			}
+		}
+		return b.Delete(resyncKey)
+	})
+}
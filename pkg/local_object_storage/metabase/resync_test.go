@@ -0,0 +1,65 @@
+package meta_test
+
+import (
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Resync(t *testing.T) {
+	db := newDB(t)
+
+	st, err := db.ResyncState()
+	require.NoError(t, err)
+	require.False(t, st.InProgress)
+	require.Empty(t, st.DonePartitions)
+
+	obj := generateObject(t)
+	require.NoError(t, metaPut(db, obj))
+	require.NoError(t, db.WriteShardID([]byte("shard")))
+
+	require.NoError(t, db.StartResync())
+
+	id, err := db.ReadShardID()
+	require.NoError(t, err)
+	require.Equal(t, []byte("shard"), id)
+
+	exists, err := metaExists(db, objectcore.AddressOf(obj))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	st, err = db.ResyncState()
+	require.NoError(t, err)
+	require.True(t, st.InProgress)
+	require.Empty(t, st.DonePartitions)
+
+	require.NoError(t, db.MarkResyncPartitionDone("ab"))
+	require.NoError(t, db.MarkResyncPartitionDone("cd"))
+
+	// state survives restart
+	require.NoError(t, db.Close())
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+
+	st, err = db.ResyncState()
+	require.NoError(t, err)
+	require.True(t, st.InProgress)
+	require.ElementsMatch(t, []string{"ab", "cd"}, st.DonePartitions)
+
+	require.NoError(t, db.FinishResync())
+
+	st, err = db.ResyncState()
+	require.NoError(t, err)
+	require.False(t, st.InProgress)
+	require.Empty(t, st.DonePartitions)
+
+	// new resync starts from scratch
+	require.NoError(t, db.MarkResyncPartitionDone("ab"))
+	require.NoError(t, db.StartResync())
+
+	st, err = db.ResyncState()
+	require.NoError(t, err)
+	require.True(t, st.InProgress)
+	require.Empty(t, st.DonePartitions)
+}
@@ -0,0 +1,234 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// payloadScrubPassInterval is the minimum interval between the starts of
+// payload scrub passes.
+const payloadScrubPassInterval = time.Hour
+
+// CorruptedObjectCallback is a callback handling address of the corrupted
+// object removed from the shard.
+type CorruptedObjectCallback func(oid.Address)
+
+// payloadScrubber is a background routine re-reading stored objects to detect
+// their silent corruption.
+type payloadScrubber struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (s *Shard) startPayloadScrubber() {
+	if s.payloadScrubRate == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ps := &payloadScrubber{cancel: cancel}
+	ps.wg.Add(1)
+	s.payloadScrubber = ps
+
+	go func() {
+		defer ps.wg.Done()
+
+		for {
+			start := time.Now()
+			if s.GetMode() == mode.ReadWrite {
+				s.scrubPayloads(ctx)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(payloadScrubPassInterval - time.Since(start)):
+			}
+		}
+	}()
+}
+
+func (s *Shard) stopPayloadScrubber() {
+	if s.payloadScrubber == nil {
+		return
+	}
+
+	s.payloadScrubber.cancel()
+	s.payloadScrubber.wg.Wait()
+	s.payloadScrubber = nil
+}
+
+// scrubPayloads checks all objects stored in the blobstor not exceeding the
+// configured read rate. Corrupted objects are quarantined.
+func (s *Shard) scrubPayloads(ctx context.Context) {
+	var (
+		start                      = time.Now()
+		read                       uint64
+		checked, corrupted, failed int
+	)
+
+	s.log.Debug("started payload scrub")
+
+	err := s.blobStor.Iterate(func(addr oid.Address, data []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		release, err := s.ioSched.Acquire(ctx, iosched.Scrub, 0)
+		if err != nil {
+			return err
+		}
+		err = verifyObject(addr, data)
+		release(uint64(len(data)))
+
+		checked++
+		read += uint64(len(data))
+		if s.cfg.metricsWriter != nil {
+			s.cfg.metricsWriter.AddPayloadScrubBytes(uint64(len(data)))
+		}
+
+		// slot is released since quarantine acquires its own one
+		if err != nil {
+			ok, err := s.quarantineCorrupted(ctx, addr)
+			if err != nil {
+				s.log.Error("could not quarantine corrupted object", zap.Stringer("address", addr), zap.Error(err))
+				failed++
+			} else if ok {
+				corrupted++
+			}
+		}
+
+		// keep the read rate
+		wait := time.Duration(float64(read)/float64(s.payloadScrubRate)*float64(time.Second)) - time.Since(start)
+		if wait > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+		return nil
+	}, func(addr oid.Address, err error) error {
+		s.log.Warn("could not read object for payload scrub", zap.Stringer("address", addr), zap.Error(err))
+		failed++
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			s.log.Error("payload scrub failed", zap.Int("checked", checked), zap.Error(err))
+		}
+		return
+	}
+
+	s.log.Info("finished payload scrub", zap.Int("checked", checked), zap.Uint64("bytes", read),
+		zap.Int("corrupted", corrupted), zap.Int("failed", failed), zap.Duration("duration", time.Since(start)))
+}
+
+// verifyObject checks that the stored object has the expected address, correct
+// ID, signature and payload checksum.
+func verifyObject(addr oid.Address, data []byte) error {
+	var obj objectSDK.Object
+	if err := obj.Unmarshal(data); err != nil {
+		return fmt.Errorf("decode object: %w", err)
+	}
+	if obj.GetContainerID() != addr.Container() || obj.GetID() != addr.Object() {
+		return errors.New("object address mismatch")
+	}
+	if err := obj.VerifyID(); err != nil {
+		return fmt.Errorf("verify ID: %w", err)
+	}
+
+	sig := obj.Signature()
+	if sig == nil {
+		return errors.New("missing signature")
+	}
+	// N3 witness can only be checked against the FS chain
+	if sig.Scheme() != neofscrypto.N3 && !obj.VerifySignature() {
+		return errors.New("invalid signature")
+	}
+
+	if err := obj.VerifyPayloadChecksum(); err != nil {
+		return fmt.Errorf("verify payload checksum: %w", err)
+	}
+
+	return nil
+}
+
+// quarantineCorrupted re-reads the object and, if it is still corrupted, saves
+// it to the quarantine directory and removes it from the shard. Shard is
+// locked, so the object can't be rewritten concurrently. I/O slot is acquired
+// before the lock, so other operations don't wait for the scheduler. Returns
+// false if the object is not corrupted or already removed.
+func (s *Shard) quarantineCorrupted(ctx context.Context, addr oid.Address) (bool, error) {
+	release, err := s.ioSched.Acquire(ctx, iosched.Scrub, 0)
+	if err != nil {
+		return false, err
+	}
+	defer release(0)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.info.Mode != mode.ReadWrite {
+		return false, ErrReadOnlyMode
+	}
+
+	data, err := s.blobStor.GetBytes(addr)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("read object: %w", err)
+	}
+
+	verr := verifyObject(addr, data)
+	if verr == nil {
+		return false, nil
+	}
+
+	s.log.Error("stored object is corrupted, quarantining it", zap.Stringer("address", addr), zap.Error(verr))
+
+	path := filepath.Join(s.quarantinePath(), addr.Container().EncodeToString())
+	err = util.MkdirAllX(path, 0o750)
+	if err == nil {
+		err = os.WriteFile(filepath.Join(path, addr.Object().EncodeToString()), data, 0o640)
+	}
+	if err != nil {
+		// corrupted copy is useless anyway, it must not be served
+		s.log.Error("could not save corrupted object to quarantine", zap.Stringer("address", addr), zap.Error(err))
+	}
+
+	err = s.deleteObjs(ctx, []oid.Address{addr}, true, true)
+	if err != nil {
+		return false, fmt.Errorf("remove object: %w", err)
+	}
+
+	if s.cfg.metricsWriter != nil {
+		s.cfg.metricsWriter.IncCorruptedObjects()
+	}
+	if s.corruptedObjectCallback != nil {
+		s.corruptedObjectCallback(addr)
+	}
+
+	return true, nil
+}
+
+func (s *Shard) quarantinePath() string {
+	if s.quarantineDir != "" {
+		return s.quarantineDir
+	}
+	return s.blobStor.Path() + ".quarantine"
+}
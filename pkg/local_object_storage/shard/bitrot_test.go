@@ -0,0 +1,75 @@
+package shard_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_PayloadScrub(t *testing.T) {
+	dir := t.TempDir()
+	sh, _ := shardWithMetrics(t, dir)
+
+	healthy := generateObject()
+	require.NoError(t, healthy.SetIDWithSignature(neofscryptotest.Signer()))
+	require.NoError(t, sh.Put(context.Background(), healthy, nil))
+
+	corrupted := generateObject()
+	require.NoError(t, corrupted.SetIDWithSignature(neofscryptotest.Signer()))
+	require.NoError(t, sh.Put(context.Background(), corrupted, nil))
+	corruptedAddr := objectCore.AddressOf(corrupted)
+
+	require.NoError(t, sh.Close())
+
+	// flip payload bits bypassing the shard
+	data := corrupted.Marshal()
+	payload := corrupted.Payload()
+	payload[0] ^= 0xff
+	corrupted.SetPayload(payload)
+	corruptedData := corrupted.Marshal()
+	require.NotEqual(t, data, corruptedData)
+
+	fsTree := fstree.New(
+		fstree.WithDirNameLen(2),
+		fstree.WithPath(filepath.Join(dir, "fstree")),
+		fstree.WithDepth(1))
+	require.NoError(t, fsTree.Open(false))
+	require.NoError(t, fsTree.Init())
+	require.NoError(t, fsTree.Delete(corruptedAddr))
+	require.NoError(t, fsTree.Put(corruptedAddr, corruptedData))
+	require.NoError(t, fsTree.Close())
+
+	quarantine := filepath.Join(dir, "quarantine")
+	restore := make(chan oid.Address, 1)
+	sh, mm := shardWithMetrics(t, dir,
+		shard.WithPayloadScrubRate(1<<30),
+		shard.WithQuarantinePath(quarantine),
+		shard.WithCorruptedObjectCallback(func(addr oid.Address) { restore <- addr }))
+
+	select {
+	case addr := <-restore:
+		require.Equal(t, corruptedAddr, addr)
+	case <-time.After(10 * time.Second):
+		t.Fatal("corrupted object was not found")
+	}
+	require.Equal(t, 1, mm.corruptedObjects)
+
+	quarantined, err := os.ReadFile(filepath.Join(quarantine, corruptedAddr.Container().EncodeToString(), corruptedAddr.Object().EncodeToString()))
+	require.NoError(t, err)
+	require.Equal(t, corruptedData, quarantined)
+
+	_, err = sh.Get(context.Background(), corruptedAddr, false)
+	require.True(t, shard.IsErrNotFound(err))
+
+	_, err = sh.Get(context.Background(), objectCore.AddressOf(healthy), false)
+	require.NoError(t, err)
+}
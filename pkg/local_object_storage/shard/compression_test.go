@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -40,29 +41,29 @@ func TestCompression(t *testing.T) {
 	}
 
 	testGet := func(t *testing.T, s *shard.Shard, i int) {
-		res1, err := s.Get(object.AddressOf(smallObj[i]), true)
+		res1, err := s.Get(context.Background(), object.AddressOf(smallObj[i]), true)
 		require.NoError(t, err)
 		require.Equal(t, smallObj[i], res1)
 
-		res2, err := s.Get(object.AddressOf(bigObj[i]), true)
+		res2, err := s.Get(context.Background(), object.AddressOf(bigObj[i]), true)
 		require.NoError(t, err)
 		require.Equal(t, bigObj[i], res2)
 	}
 
 	testPut := func(t *testing.T, s *shard.Shard, i int) {
-		err = s.Put(smallObj[i], nil)
+		err = s.Put(context.Background(), smallObj[i], nil)
 		require.NoError(t, err)
 
-		err = s.Put(bigObj[i], nil)
+		err = s.Put(context.Background(), bigObj[i], nil)
 		require.NoError(t, err)
 	}
 
 	testHead := func(t *testing.T, s *shard.Shard, i int) {
-		res1, err := s.Head(object.AddressOf(smallObj[i]), false)
+		res1, err := s.Head(context.Background(), object.AddressOf(smallObj[i]), false)
 		require.NoError(t, err)
 		require.Equal(t, smallObj[i].CutPayload(), res1)
 
-		res2, err := s.Head(object.AddressOf(bigObj[i]), false)
+		res2, err := s.Head(context.Background(), object.AddressOf(bigObj[i]), false)
 		require.NoError(t, err)
 		require.Equal(t, bigObj[i].CutPayload(), res2)
 	}
@@ -16,12 +16,12 @@ func TestShard_DeleteContainer(t *testing.T) {
 	cID := cidtest.ID()
 
 	o1 := generateObjectWithCID(cID)
-	err := sh.Put(o1, nil)
+	err := sh.Put(context.Background(), o1, nil)
 	require.NoError(t, err)
 
 	o3 := generateObjectWithCID(cID)
 	o3.SetType(objectSDK.TypeLock)
-	err = sh.Put(o3, nil)
+	err = sh.Put(context.Background(), o3, nil)
 	require.NoError(t, err)
 
 	err = sh.DeleteContainer(context.Background(), cID)
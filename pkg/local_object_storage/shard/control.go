@@ -70,12 +70,6 @@ func (s *Shard) Open() error {
 	return nil
 }
 
-type metabaseSynchronizer Shard
-
-func (x *metabaseSynchronizer) Init() error {
-	return (*Shard)(x).resyncMetabase()
-}
-
 // Init initializes all Shard's components.
 func (s *Shard) Init() error {
 	type initializer interface {
@@ -85,26 +79,24 @@ func (s *Shard) Init() error {
 	var components = []initializer{&s.compression, s.blobStor}
 
 	if !s.GetMode().NoMetabase() {
-		var initMetabase initializer
-
-		if s.needResyncMetabase() {
-			initMetabase = (*metabaseSynchronizer)(s)
-		} else {
-			initMetabase = s.metaBase
-		}
-
-		components = append(components, initMetabase)
+		components = append(components, s.metaBase)
 	}
 
 	if s.hasWriteCache() {
 		components = append(components, s.writeCache)
 	}
 
+	var outdatedMetabase bool
 	for _, component := range components {
 		if err := component.Init(); err != nil {
 			if component == s.metaBase {
 				if errors.Is(err, meta.ErrOutdatedVersion) {
-					return fmt.Errorf("metabase initialization: %w", err)
+					if s.GetMode() != mode.ReadWrite {
+						return fmt.Errorf("metabase initialization: %w", err)
+					}
+					s.log.Warn("metabase version is not supported, it will be resynchronized", zap.Error(err))
+					outdatedMetabase = true
+					continue
 				}
 
 				err = s.handleMetabaseFailure("init", err)
@@ -122,7 +114,20 @@ func (s *Shard) Init() error {
 		}
 	}
 
-	s.initMetrics()
+	var resync bool
+	if s.GetMode() == mode.ReadWrite {
+		var err error
+		resync, err = s.needResync(outdatedMetabase)
+		if err != nil {
+			return fmt.Errorf("metabase resync: %w", err)
+		}
+	}
+
+	if resync {
+		s.startResync()
+	} else {
+		s.initMetrics()
+	}
 
 	s.gc = &gc{
 		gcCfg:       &s.gcCfg,
@@ -142,40 +147,9 @@ func (s *Shard) Init() error {
 
 	s.gc.init()
 
-	return nil
-}
-
-func (s *Shard) resyncMetabase() error {
-	err := s.metaBase.Reset()
-	if err != nil {
-		return fmt.Errorf("could not reset metabase: %w", err)
-	}
-
-	if s.writeCache != nil {
-		// ensure there will not be any raсes in write-cache -> blobstor object
-		// background flushing while iterating blobstor
-		err = s.writeCache.Flush(true)
-		if err != nil {
-			s.log.Warn("could not flush write-cache while resyncing metabase", zap.Error(err))
-		}
-	}
-
-	var errorHandler = func(addr oid.Address, err error) error {
-		s.log.Warn("error occurred during the iteration",
-			zap.Stringer("address", addr),
-			zap.String("err", err.Error()))
-		return nil
-	}
-
-	err = s.blobStor.Iterate(s.resyncObjectHandler, errorHandler)
-	if err != nil {
-		return fmt.Errorf("could not put objects to the meta from blobstor: %w", err)
-	}
-
-	err = s.metaBase.SyncCounters()
-	if err != nil {
-		return fmt.Errorf("could not sync object counters: %w", err)
-	}
+	s.startEncryptionRotation()
+	s.startScrubber()
+	s.startPayloadScrubber()
 
 	return nil
 }
@@ -243,6 +217,11 @@ func (s *Shard) resyncObjectHandler(addr oid.Address, data []byte) error {
 
 // Close releases all Shard's components.
 func (s *Shard) Close() error {
+	s.stopResync()
+	s.stopEncryptionRotation()
+	s.stopScrubber()
+	s.stopPayloadScrubber()
+
 	components := []interface{ Close() error }{}
 
 	if s.hasWriteCache() {
@@ -280,6 +259,12 @@ func (s *Shard) Reload(opts ...Option) error {
 	s.m.Lock()
 	defer s.m.Unlock()
 
+	if r := s.runningResync(); r != nil {
+		s.log.Warn("metabase resync is in progress, only mode will be reloaded after it", zap.Stringer("mode", c.info.Mode))
+		r.target = c.info.Mode
+		return nil
+	}
+
 	ok, err := s.metaBase.Reload(c.metaOpts...)
 	if err != nil {
 		if errors.Is(err, meta.ErrDegradedMode) {
@@ -1,10 +1,12 @@
 package shard
 
 import (
+	"context"
 	"crypto/rand"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
@@ -94,7 +96,7 @@ func TestResyncMetabaseCorrupted(t *testing.T) {
 	obj.SetType(objectSDK.TypeRegular)
 	obj.SetPayload([]byte{0, 1, 2, 3, 4, 5})
 
-	err := sh.Put(&obj, nil)
+	err := sh.Put(context.Background(), &obj, nil)
 	require.NoError(t, err)
 	require.NoError(t, sh.Close())
 
@@ -111,12 +113,19 @@ func TestResyncMetabaseCorrupted(t *testing.T) {
 		WithResyncMetabase(true))
 	require.NoError(t, sh.Open())
 	require.NoError(t, sh.Init())
+	waitResync(t, sh)
 
-	_, err = sh.Get(addr, false)
+	_, err = sh.Get(context.Background(), addr, false)
 	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	require.NoError(t, sh.Close())
 }
 
+func waitResync(t *testing.T, sh *Shard) {
+	require.Eventually(t, func() bool {
+		return !sh.DumpInfo().Resync.InProgress
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
 func TestResyncMetabase(t *testing.T) {
 	p := t.Name()
 
@@ -203,11 +212,11 @@ func TestResyncMetabase(t *testing.T) {
 	}
 
 	for _, v := range mObjs {
-		err := sh.Put(v.obj, nil)
+		err := sh.Put(context.Background(), v.obj, nil)
 		require.NoError(t, err)
 	}
 
-	err := sh.Put(&tombObj, nil)
+	err := sh.Put(context.Background(), &tombObj, nil)
 	require.NoError(t, err)
 
 	// LOCK object handling
@@ -218,7 +227,7 @@ func TestResyncMetabase(t *testing.T) {
 	lockObj.SetContainerID(cnrLocked)
 	lockObj.WriteLock(lock)
 
-	err = sh.Put(&lockObj, nil)
+	err = sh.Put(context.Background(), &lockObj, nil)
 	require.NoError(t, err)
 
 	lockID := lockObj.GetID()
@@ -228,7 +237,7 @@ func TestResyncMetabase(t *testing.T) {
 	require.NoError(t, err)
 
 	checkObj := func(addr oid.Address, expObj *objectSDK.Object) {
-		res, err := sh.Head(addr, false)
+		res, err := sh.Head(context.Background(), addr, false)
 
 		if expObj == nil {
 			require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
@@ -251,7 +260,7 @@ func TestResyncMetabase(t *testing.T) {
 
 	checkTombMembers := func(exists bool) {
 		for _, member := range tombMembers {
-			_, err := sh.Head(member, false)
+			_, err := sh.Head(context.Background(), member, false)
 
 			if exists {
 				require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
@@ -9,15 +10,19 @@ import (
 )
 
 // Delete removes data from the shard's writeCache, metaBase and
-// blobStor.
-func (s *Shard) Delete(addrs []oid.Address) error {
+// blobStor. Removal is scheduled as an I/O operation of the class ctx is
+// tagged with.
+func (s *Shard) Delete(ctx context.Context, addrs []oid.Address) error {
 	s.m.RLock()
 	defer s.m.RUnlock()
 
-	return s.deleteObjs(addrs, false)
+	return s.deleteObjs(ctx, addrs, false, false)
 }
 
-func (s *Shard) deleteObjs(addrs []oid.Address, skipNotFoundError bool) error {
+// deleteObjs removes objects from the shard. Removal of each object from the
+// blobstor is scheduled as I/O operation of the class ctx is tagged with
+// unless scheduled is set meaning the caller has already acquired the slot.
+func (s *Shard) deleteObjs(ctx context.Context, addrs []oid.Address, skipNotFoundError, scheduled bool) error {
 	if s.info.Mode.ReadOnly() {
 		return ErrReadOnlyMode
 	} else if s.info.Mode.NoMetabase() {
@@ -52,7 +57,15 @@ func (s *Shard) deleteObjs(addrs []oid.Address, skipNotFoundError bool) error {
 	s.addToPayloadCounter(-int64(totalRemovedPayload))
 
 	for _, addr := range addrs {
+		release := func(uint64) {}
+		if !scheduled {
+			var err error
+			if release, err = s.acquireIO(ctx, 0); err != nil {
+				return err
+			}
+		}
 		err = s.blobStor.Delete(addr)
+		release(0)
 		if err == nil {
 			logOp(s.log, deleteOp, addr)
 		} else {
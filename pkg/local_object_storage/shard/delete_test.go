@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -32,16 +33,16 @@ func testShardDelete(t *testing.T, hasWriteCache bool) {
 	t.Run("big object", func(t *testing.T) {
 		addPayload(obj, 1<<20)
 
-		err := sh.Put(obj, nil)
+		err := sh.Put(context.Background(), obj, nil)
 		require.NoError(t, err)
 
 		_, err = testGet(t, sh, object.AddressOf(obj), hasWriteCache)
 		require.NoError(t, err)
 
-		err = sh.Delete([]oid.Address{object.AddressOf(obj)})
+		err = sh.Delete(context.Background(), []oid.Address{object.AddressOf(obj)})
 		require.NoError(t, err)
 
-		_, err = sh.Get(object.AddressOf(obj), false)
+		_, err = sh.Get(context.Background(), object.AddressOf(obj), false)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 
@@ -50,16 +51,16 @@ func testShardDelete(t *testing.T, hasWriteCache bool) {
 		addAttribute(obj, "foo", "bar")
 		addPayload(obj, 1<<5)
 
-		err := sh.Put(obj, nil)
+		err := sh.Put(context.Background(), obj, nil)
 		require.NoError(t, err)
 
-		_, err = sh.Get(object.AddressOf(obj), false)
+		_, err = sh.Get(context.Background(), object.AddressOf(obj), false)
 		require.NoError(t, err)
 
-		err = sh.Delete([]oid.Address{object.AddressOf(obj)})
+		err = sh.Delete(context.Background(), []oid.Address{object.AddressOf(obj)})
 		require.NoError(t, err)
 
-		_, err = sh.Get(object.AddressOf(obj), false)
+		_, err = sh.Get(context.Background(), object.AddressOf(obj), false)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 }
@@ -1,21 +1,23 @@
 package shard
 
 import (
-	"encoding/binary"
+	"fmt"
 	"io"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
-var dumpMagic = []byte("NEOF")
-
 var ErrMustBeReadOnly = logicerr.New("shard must be in read-only mode")
 
-// DumpToStream dumps all objects from the shard to a given stream.
+// Dump dumps objects matching the filter from the shard to a given stream
+// using the given format version (see [dump] package). Objects that can't
+// be decoded to apply the filter are skipped if ignoreErrors is set.
 //
 // Returns any error encountered and the number of objects written.
-func (s *Shard) Dump(w io.Writer, ignoreErrors bool) (int, error) {
+func (s *Shard) Dump(w io.Writer, v dump.Version, flt dump.Filter, ignoreErrors bool) (count int, err error) {
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -23,43 +25,35 @@ func (s *Shard) Dump(w io.Writer, ignoreErrors bool) (int, error) {
 		return 0, ErrMustBeReadOnly
 	}
 
-	_, err := w.Write(dumpMagic)
+	dw, err := dump.NewWriter(w, v)
 	if err != nil {
 		return 0, err
 	}
-
-	var count int
-
-	if s.hasWriteCache() {
-		var iterHandler = func(_ oid.Address, data []byte) error {
-			var size [4]byte
-			binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-			if _, err := w.Write(size[:]); err != nil {
-				return err
-			}
-
-			if _, err := w.Write(data); err != nil {
-				return err
-			}
-
-			count++
-			return nil
-		}
-
-		err := s.writeCache.Iterate(iterHandler, ignoreErrors)
+	defer func() {
 		if err != nil {
-			return count, err
+			dw.Abort()
 		}
-	}
+	}()
 
 	var objHandler = func(addr oid.Address, data []byte) error {
-		var size [4]byte
-		binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-		if _, err := w.Write(size[:]); err != nil {
-			return err
+		if !flt.MatchContainer(addr.Container()) {
+			return nil
 		}
 
-		if _, err := w.Write(data); err != nil {
+		if flt.NeedsHeader() {
+			var obj object.Object
+			if err := obj.Unmarshal(data); err != nil {
+				if ignoreErrors {
+					return nil
+				}
+				return fmt.Errorf("decode object %s: %w", addr, err)
+			}
+			if !flt.Match(&obj) {
+				return nil
+			}
+		}
+
+		if err := dw.Write(addr, data); err != nil {
 			return err
 		}
 
@@ -67,12 +61,23 @@ func (s *Shard) Dump(w io.Writer, ignoreErrors bool) (int, error) {
 		return nil
 	}
 
+	if s.hasWriteCache() {
+		err = s.writeCache.Iterate(objHandler, ignoreErrors)
+		if err != nil {
+			return count, err
+		}
+	}
+
 	var errorHandler func(oid.Address, error) error
 	if ignoreErrors {
 		errorHandler = func(oid.Address, error) error { return nil }
 	}
 
 	err = s.blobStor.Iterate(objHandler, errorHandler)
+	if err != nil {
+		return count, err
+	}
 
+	err = dw.Close()
 	return count, err
 }
@@ -0,0 +1,273 @@
+package dump
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testRecord struct {
+	addr oid.Address
+	data []byte
+}
+
+func randRecords(n int) []testRecord {
+	res := make([]testRecord, n)
+	for i := range res {
+		res[i].addr = oidtest.Address()
+		res[i].data = make([]byte, 100*(i+1))
+		_, _ = rand.Read(res[i].data)
+	}
+	return res
+}
+
+func writeDump(t *testing.T, v Version, recs []testRecord) []byte {
+	var buf bytes.Buffer
+
+	w, err := NewWriter(&buf, v)
+	require.NoError(t, err)
+	for _, r := range recs {
+		require.NoError(t, w.Write(r.addr, r.data))
+	}
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func readDump(data []byte) ([]testRecord, *Reader, error) {
+	r, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer r.Close()
+
+	var res []testRecord
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return res, r, nil
+		}
+		if err != nil {
+			return res, r, err
+		}
+		res = append(res, testRecord{addr: rec.Address, data: bytes.Clone(rec.Data)})
+	}
+}
+
+func TestDump(t *testing.T) {
+	recs := randRecords(10)
+
+	t.Run("v1", func(t *testing.T) {
+		data := writeDump(t, V1, recs)
+
+		res, r, err := readDump(data)
+		require.NoError(t, err)
+		require.Equal(t, V1, r.Version())
+		require.Len(t, res, len(recs))
+		for i := range recs {
+			require.True(t, res[i].addr.Object().IsZero())
+			require.Equal(t, recs[i].data, res[i].data)
+		}
+
+		_, ok := r.Trailer()
+		require.False(t, ok)
+	})
+
+	t.Run("v2", func(t *testing.T) {
+		data := writeDump(t, V2, recs)
+
+		res, r, err := readDump(data)
+		require.NoError(t, err)
+		require.Equal(t, V2, r.Version())
+		require.Equal(t, recs, res)
+
+		tr, ok := r.Trailer()
+		require.True(t, ok)
+		require.EqualValues(t, len(recs), tr.Objects)
+		var size uint64
+		for i := range recs {
+			size += uint64(len(recs[i].data))
+		}
+		require.Equal(t, size, tr.Size)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		for _, v := range []Version{V1, V2} {
+			res, _, err := readDump(writeDump(t, v, nil))
+			require.NoError(t, err)
+			require.Empty(t, res)
+		}
+	})
+}
+
+func TestReaderErrors(t *testing.T) {
+	recs := randRecords(5)
+
+	t.Run("invalid magic", func(t *testing.T) {
+		_, _, err := readDump([]byte{1, 2, 3, 4})
+		require.ErrorIs(t, err, ErrInvalidMagic)
+
+		_, _, err = readDump([]byte("NE"))
+		require.ErrorIs(t, err, ErrInvalidMagic)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := NewWriter(io.Discard, 3)
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+
+		data := writeDump(t, V2, recs)
+		data[len(magicV2)] = 3
+		_, _, err = readDump(data)
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		data := writeDump(t, V2, recs)
+
+		for _, n := range []int{len(magicV2) + 1, len(data) / 2, len(data) - 1} {
+			_, _, err := readDump(data[:n])
+			require.ErrorIs(t, err, ErrTruncated, n)
+		}
+	})
+
+	t.Run("no trailer", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		w, err := NewWriter(&buf, V2)
+		require.NoError(t, err)
+		for _, r := range recs {
+			require.NoError(t, w.Write(r.addr, r.data))
+		}
+		w.Abort()
+
+		res, _, err := readDump(buf.Bytes())
+		require.ErrorIs(t, err, ErrTruncated)
+		require.Equal(t, recs, res)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		w, err := NewWriter(&buf, V2)
+		require.NoError(t, err)
+		require.NoError(t, w.Write(recs[0].addr, recs[0].data))
+
+		// write broken record directly
+		w.hdr[0] = recordObject
+		putAddress(w.hdr[1:], recs[1].addr)
+		binary.LittleEndian.PutUint32(w.hdr[1+addressSize:], uint32(len(recs[1].data)))
+		binary.LittleEndian.PutUint32(w.hdr[1+addressSize+4:], 42)
+		_, err = w.enc.Write(w.hdr[:])
+		require.NoError(t, err)
+		_, err = w.enc.Write(recs[1].data)
+		require.NoError(t, err)
+		w.trailer.Objects++
+		w.trailer.Size += uint64(len(recs[1].data))
+
+		require.NoError(t, w.Write(recs[2].addr, recs[2].data))
+		require.NoError(t, w.Close())
+
+		r, err := NewReader(&buf)
+		require.NoError(t, err)
+		defer r.Close()
+
+		rec, err := r.Next()
+		require.NoError(t, err)
+		require.Equal(t, recs[0].addr, rec.Address)
+
+		rec, err = r.Next()
+		require.ErrorIs(t, err, ErrChecksumMismatch)
+		require.Equal(t, recs[1].addr, rec.Address)
+
+		// reading is continued
+		rec, err = r.Next()
+		require.NoError(t, err)
+		require.Equal(t, recs[2].addr, rec.Address)
+
+		_, err = r.Next()
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("object too big", func(t *testing.T) {
+		var hdr [4]byte
+		binary.LittleEndian.PutUint32(hdr[:], MaxObjectLen+1)
+
+		_, _, err := readDump(append(bytes.Clone(magicV1), hdr[:]...))
+		require.ErrorIs(t, err, ErrObjectTooBig)
+
+		var buf bytes.Buffer
+
+		w, err := NewWriter(&buf, V2)
+		require.NoError(t, err)
+		require.NoError(t, w.Write(recs[0].addr, recs[0].data))
+
+		w.hdr[0] = recordObject
+		putAddress(w.hdr[1:], recs[1].addr)
+		binary.LittleEndian.PutUint32(w.hdr[1+addressSize:], MaxObjectLen+1)
+		_, err = w.enc.Write(w.hdr[:])
+		require.NoError(t, err)
+		w.Abort()
+
+		res, _, err := readDump(buf.Bytes())
+		require.ErrorIs(t, err, ErrObjectTooBig)
+		require.Equal(t, recs[:1], res)
+	})
+
+	t.Run("trailer mismatch", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		w, err := NewWriter(&buf, V2)
+		require.NoError(t, err)
+		require.NoError(t, w.Write(recs[0].addr, recs[0].data))
+		w.trailer.Objects++
+		require.NoError(t, w.Close())
+
+		_, _, err = readDump(buf.Bytes())
+		require.ErrorContains(t, err, "trailer mismatch")
+	})
+}
+
+func TestFilter(t *testing.T) {
+	var obj object.Object
+	cnr := cidtest.ID()
+	obj.SetContainerID(cnr)
+	obj.SetType(object.TypeLock)
+	obj.SetCreationEpoch(10)
+
+	require.True(t, Filter{}.IsEmpty())
+	require.True(t, Filter{}.Match(&obj))
+
+	for _, tc := range []struct {
+		name  string
+		f     Filter
+		match bool
+	}{
+		{"container", Filter{Containers: []cid.ID{cidtest.ID(), cnr}}, true},
+		{"other container", Filter{Containers: []cid.ID{cidtest.ID()}}, false},
+		{"type", Filter{Types: []object.Type{object.TypeRegular, object.TypeLock}}, true},
+		{"other type", Filter{Types: []object.Type{object.TypeRegular}}, false},
+		{"epoch range", Filter{FromEpoch: 10, ToEpoch: 10}, true},
+		{"epoch lower bound", Filter{FromEpoch: 5}, true},
+		{"later epoch", Filter{FromEpoch: 11}, false},
+		{"earlier epoch", Filter{ToEpoch: 9}, false},
+		{"all", Filter{Containers: []cid.ID{cnr}, Types: []object.Type{object.TypeLock}, FromEpoch: 1, ToEpoch: 20}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.False(t, tc.f.IsEmpty())
+			require.Equal(t, tc.match, tc.f.Match(&obj))
+		})
+	}
+
+	require.False(t, Filter{Containers: []cid.ID{cnr}}.NeedsHeader())
+	require.True(t, Filter{ToEpoch: 1}.NeedsHeader())
+}
@@ -0,0 +1,52 @@
+package dump
+
+import (
+	"slices"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// Filter selects objects to dump or restore. Zero Filter matches any object.
+type Filter struct {
+	// Containers are IDs of containers objects must belong to, any container
+	// if empty.
+	Containers []cid.ID
+	// Types are allowed object types, any type if empty.
+	Types []object.Type
+	// FromEpoch is a minimum object creation epoch, inclusive.
+	FromEpoch uint64
+	// ToEpoch is a maximum object creation epoch, inclusive. Zero means no
+	// upper limit.
+	ToEpoch uint64
+}
+
+// IsEmpty checks whether f matches any object.
+func (f Filter) IsEmpty() bool {
+	return len(f.Containers) == 0 && !f.NeedsHeader()
+}
+
+// NeedsHeader checks whether object header is required to apply f, i.e.
+// [Filter.MatchContainer] is not enough.
+func (f Filter) NeedsHeader() bool {
+	return len(f.Types) != 0 || f.FromEpoch != 0 || f.ToEpoch != 0
+}
+
+// MatchContainer checks whether objects of the given container can match f.
+func (f Filter) MatchContainer(cnr cid.ID) bool {
+	return len(f.Containers) == 0 || slices.Contains(f.Containers, cnr)
+}
+
+// Match checks whether the object matches f.
+func (f Filter) Match(obj *object.Object) bool {
+	if !f.MatchContainer(obj.GetContainerID()) {
+		return false
+	}
+
+	if len(f.Types) != 0 && !slices.Contains(f.Types, obj.Type()) {
+		return false
+	}
+
+	epoch := obj.CreationEpoch()
+	return epoch >= f.FromEpoch && (f.ToEpoch == 0 || epoch <= f.ToEpoch)
+}
@@ -0,0 +1,90 @@
+/*
+Package dump implements encoding of the shard dump files.
+
+Two formats are supported. Version 1 is the legacy one: "NEOF" magic followed
+by objects each prefixed with its 4-byte little-endian length. It has neither
+checksums nor end mark, so corrupted or truncated dump can't be detected.
+
+Version 2 starts with "NEOD" magic and a version byte, the rest of the file is
+a zstd stream of records. Each object record consists of a type byte, object
+address (32-byte container ID followed by 32-byte object ID), 4-byte
+little-endian length of the object, 4-byte little-endian CRC32 (Castagnoli)
+of the address and object bytes and the object itself. The stream is
+finished with a trailer record containing 8-byte little-endian number of
+objects, their total size and CRC32 of these two fields. Dump without the
+trailer is considered truncated.
+*/
+package dump
+
+import (
+	"fmt"
+	"hash/crc32"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// Version is a version of the dump format.
+type Version uint8
+
+const (
+	// V1 is the legacy format without compression and checksums.
+	V1 Version = iota + 1
+	// V2 is zstd-compressed format with checksummed records and trailer.
+	V2
+
+	// Latest is the version used by default.
+	Latest = V2
+)
+
+var (
+	// ErrInvalidMagic is returned when data is not a dump of any known format.
+	ErrInvalidMagic = logicerr.New("invalid magic")
+	// ErrUnsupportedVersion is returned for unknown format versions.
+	ErrUnsupportedVersion = logicerr.New("unsupported dump version")
+	// ErrChecksumMismatch is returned when record checksum doesn't match its
+	// data. The rest of the dump can still be read.
+	ErrChecksumMismatch = logicerr.New("checksum mismatch")
+	// ErrTruncated is returned when dump ends before the trailer.
+	ErrTruncated = logicerr.New("dump is truncated")
+	// ErrObjectTooBig is returned when declared object length exceeds
+	// [MaxObjectLen].
+	ErrObjectTooBig = logicerr.New("object is too big")
+)
+
+// MaxObjectLen is the maximum length of the binary object accepted by
+// [Reader]: the biggest object header and 64 MiB payload.
+const MaxObjectLen = object.MaxHeaderLen + 64<<20
+
+var (
+	magicV1 = []byte("NEOF")
+	magicV2 = []byte("NEOD")
+)
+
+const (
+	recordObject  byte = 1
+	recordTrailer byte = 2
+
+	addressSize = 64
+	// type, address, size, checksum
+	objectHeaderSize = 1 + addressSize + 4 + 4
+	// objects, size, checksum
+	trailerSize = 8 + 8 + 4
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Trailer is a summary of the dump stored in its end.
+type Trailer struct {
+	// Objects is the number of objects in the dump.
+	Objects uint64
+	// Size is the total size of the objects in bytes.
+	Size uint64
+}
+
+func (v Version) check() error {
+	if v != V1 && v != V2 {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, v)
+	}
+	return nil
+}
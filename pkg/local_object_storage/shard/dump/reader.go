@@ -0,0 +1,237 @@
+package dump
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Record is an object read from the dump.
+type Record struct {
+	// Address is the object address, zero for [V1] dumps.
+	Address oid.Address
+	// Data is the binary object. It is valid until the next
+	// [Reader.Next] call.
+	Data []byte
+}
+
+// Reader reads objects from the dump of any supported version.
+type Reader struct {
+	version Version
+	r       io.Reader
+	dec     *zstd.Decoder
+
+	// read stats
+	objects uint64
+	size    uint64
+
+	trailer *Trailer
+	hdr     [objectHeaderSize]byte
+	data    []byte
+}
+
+// NewReader reads dump header from r and returns [Reader] for the objects.
+// Returns [ErrInvalidMagic] if r is not a dump and [ErrUnsupportedVersion]
+// if the dump version is unknown. [Reader.Close] must be called to release
+// resources.
+func NewReader(r io.Reader) (*Reader, error) {
+	var m [4]byte
+	if _, err := io.ReadFull(r, m[:]); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, ErrInvalidMagic
+		}
+		return nil, err
+	}
+
+	switch {
+	case bytes.Equal(m[:], magicV1):
+		return &Reader{version: V1, r: r}, nil
+	case bytes.Equal(m[:], magicV2):
+	default:
+		return nil, ErrInvalidMagic
+	}
+
+	var v [1]byte
+	if _, err := io.ReadFull(r, v[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, ErrTruncated
+		}
+		return nil, err
+	}
+
+	if Version(v[0]) != V2 {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, v[0])
+	}
+
+	dec, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, fmt.Errorf("create zstd decoder: %w", err)
+	}
+
+	return &Reader{version: V2, r: dec, dec: dec}, nil
+}
+
+// Version returns version of the dump format.
+func (r *Reader) Version() Version {
+	return r.version
+}
+
+// Trailer returns the dump trailer. It is available for [V2] dumps only after
+// [Reader.Next] returned [io.EOF].
+func (r *Reader) Trailer() (Trailer, bool) {
+	if r.trailer == nil {
+		return Trailer{}, false
+	}
+	return *r.trailer, true
+}
+
+// Next reads the next object from the dump. Returns [io.EOF] when there are
+// no more objects. For [V2] dumps the trailer is verified against the read
+// objects, [ErrTruncated] is returned if it is missing.
+//
+// If the record checksum doesn't match, Next returns the record along with
+// [ErrChecksumMismatch], reading can be continued in this case. Any other
+// error is fatal.
+func (r *Reader) Next() (Record, error) {
+	if r.version == V1 {
+		return r.nextV1()
+	}
+	return r.nextV2()
+}
+
+// Close releases resources allocated by the [Reader]. It doesn't close
+// the underlying reader.
+func (r *Reader) Close() {
+	if r.dec != nil {
+		r.dec.Close()
+	}
+}
+
+func (r *Reader) nextV1() (Record, error) {
+	// If there are less than 4 bytes left, `Read` returns nil error instead of
+	// io.ErrUnexpectedEOF, thus `ReadFull` is used.
+	_, err := io.ReadFull(r.r, r.hdr[:4])
+	if err != nil {
+		return Record{}, err
+	}
+
+	data, err := r.readData(binary.LittleEndian.Uint32(r.hdr[:4]))
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return Record{}, err
+	}
+
+	r.objects++
+	r.size += uint64(len(data))
+
+	return Record{Data: data}, nil
+}
+
+func (r *Reader) nextV2() (Record, error) {
+	if r.trailer != nil {
+		return Record{}, io.EOF
+	}
+
+	_, err := io.ReadFull(r.r, r.hdr[:1])
+	if err != nil {
+		return Record{}, truncated(err)
+	}
+
+	switch r.hdr[0] {
+	case recordTrailer:
+		return Record{}, r.readTrailer()
+	case recordObject:
+	default:
+		return Record{}, fmt.Errorf("invalid record type %d", r.hdr[0])
+	}
+
+	_, err = io.ReadFull(r.r, r.hdr[1:])
+	if err != nil {
+		return Record{}, truncated(err)
+	}
+
+	var rec Record
+	rec.Address.SetContainer(cid.ID(r.hdr[1:33]))
+	rec.Address.SetObject(oid.ID(r.hdr[33:65]))
+
+	rec.Data, err = r.readData(binary.LittleEndian.Uint32(r.hdr[1+addressSize:]))
+	if err != nil {
+		return Record{}, truncated(err)
+	}
+
+	r.objects++
+	r.size += uint64(len(rec.Data))
+
+	if sum := binary.LittleEndian.Uint32(r.hdr[1+addressSize+4:]); sum != recordChecksum(r.hdr[1:1+addressSize], rec.Data) {
+		return rec, fmt.Errorf("%w: object %s", ErrChecksumMismatch, rec.Address)
+	}
+
+	return rec, nil
+}
+
+func (r *Reader) readTrailer() error {
+	var buf [trailerSize]byte
+	if _, err := io.ReadFull(r.r, buf[:]); err != nil {
+		return truncated(err)
+	}
+
+	if crc32.Checksum(buf[:16], crcTable) != binary.LittleEndian.Uint32(buf[16:]) {
+		return fmt.Errorf("%w: trailer", ErrChecksumMismatch)
+	}
+
+	t := Trailer{
+		Objects: binary.LittleEndian.Uint64(buf[:]),
+		Size:    binary.LittleEndian.Uint64(buf[8:]),
+	}
+
+	if t.Objects != r.objects || t.Size != r.size {
+		return fmt.Errorf("trailer mismatch: %d objects of %d bytes declared, %d objects of %d bytes read",
+			t.Objects, t.Size, r.objects, r.size)
+	}
+
+	if _, err := io.ReadFull(r.r, buf[:1]); !errors.Is(err, io.EOF) {
+		if err == nil {
+			err = errors.New("unexpected data after trailer")
+		}
+		return err
+	}
+
+	r.trailer = &t
+
+	return io.EOF
+}
+
+func (r *Reader) readData(sz uint32) ([]byte, error) {
+	if sz > MaxObjectLen {
+		return nil, fmt.Errorf("%w: %d bytes", ErrObjectTooBig, sz)
+	}
+
+	if uint32(cap(r.data)) < sz {
+		r.data = make([]byte, sz)
+	} else {
+		r.data = r.data[:sz]
+	}
+
+	_, err := io.ReadFull(r.r, r.data)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.data, nil
+}
+
+func truncated(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ErrTruncated
+	}
+	return err
+}
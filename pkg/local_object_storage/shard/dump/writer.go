@@ -0,0 +1,124 @@
+package dump
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Writer writes objects to the dump.
+type Writer struct {
+	version Version
+	w       io.Writer
+	enc     *zstd.Encoder
+
+	trailer Trailer
+	hdr     [objectHeaderSize]byte
+}
+
+// NewWriter writes dump header of the given version to w and returns
+// [Writer] for the objects. [Writer.Close] must be called after the last
+// object to finalize the dump.
+func NewWriter(w io.Writer, v Version) (*Writer, error) {
+	if err := v.check(); err != nil {
+		return nil, err
+	}
+
+	res := &Writer{version: v, w: w}
+
+	if v == V1 {
+		if _, err := w.Write(magicV1); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	var hdr = make([]byte, 0, len(magicV2)+1)
+	hdr = append(hdr, magicV2...)
+	hdr = append(hdr, byte(v))
+	if _, err := w.Write(hdr); err != nil {
+		return nil, err
+	}
+
+	enc, err := zstd.NewWriter(w)
+	if err != nil {
+		return nil, fmt.Errorf("create zstd encoder: %w", err)
+	}
+	res.enc = enc
+
+	return res, nil
+}
+
+// Write writes object with the given address to the dump.
+func (w *Writer) Write(addr oid.Address, data []byte) error {
+	if w.version == V1 {
+		binary.LittleEndian.PutUint32(w.hdr[:4], uint32(len(data)))
+		if _, err := w.w.Write(w.hdr[:4]); err != nil {
+			return err
+		}
+		if _, err := w.w.Write(data); err != nil {
+			return err
+		}
+	} else {
+		w.hdr[0] = recordObject
+		putAddress(w.hdr[1:], addr)
+		binary.LittleEndian.PutUint32(w.hdr[1+addressSize:], uint32(len(data)))
+		binary.LittleEndian.PutUint32(w.hdr[1+addressSize+4:], recordChecksum(w.hdr[1:1+addressSize], data))
+
+		if _, err := w.enc.Write(w.hdr[:]); err != nil {
+			return err
+		}
+		if _, err := w.enc.Write(data); err != nil {
+			return err
+		}
+	}
+
+	w.trailer.Objects++
+	w.trailer.Size += uint64(len(data))
+	return nil
+}
+
+// Close writes the trailer and flushes the buffered data. It doesn't close
+// the underlying writer.
+func (w *Writer) Close() error {
+	if w.version == V1 {
+		return nil
+	}
+
+	var buf [1 + trailerSize]byte
+	buf[0] = recordTrailer
+	binary.LittleEndian.PutUint64(buf[1:], w.trailer.Objects)
+	binary.LittleEndian.PutUint64(buf[9:], w.trailer.Size)
+	binary.LittleEndian.PutUint32(buf[17:], crc32.Checksum(buf[1:17], crcTable))
+
+	if _, err := w.enc.Write(buf[:]); err != nil {
+		_ = w.enc.Close()
+		return err
+	}
+
+	return w.enc.Close()
+}
+
+// Abort releases resources of the unfinished dump without writing the
+// trailer, so the dump is rejected by [Reader] as incomplete. It doesn't
+// close the underlying writer.
+func (w *Writer) Abort() {
+	if w.version != V1 {
+		_ = w.enc.Close()
+	}
+}
+
+func putAddress(b []byte, addr oid.Address) {
+	cnr := addr.Container()
+	obj := addr.Object()
+	copy(b, cnr[:])
+	copy(b[32:], obj[:])
+}
+
+func recordChecksum(addr, data []byte) uint32 {
+	return crc32.Update(crc32.Checksum(addr, crcTable), crcTable, data)
+}
@@ -2,6 +2,7 @@ package shard_test
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"io"
 	"os"
@@ -13,8 +14,10 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
@@ -56,7 +59,7 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 	require.NoError(t, err)
 
 	t.Run("must be read-only", func(t *testing.T) {
-		_, err := sh.Dump(f, false)
+		_, err := sh.Dump(f, dump.Latest, dump.Filter{}, false)
 		require.NoError(t, f.Close())
 		require.ErrorIs(t, err, shard.ErrMustBeReadOnly)
 	})
@@ -67,7 +70,7 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 	f, err = os.Create(outEmpty)
 	require.NoError(t, err)
 
-	res, err := sh.Dump(f, false)
+	res, err := sh.Dump(f, dump.Latest, dump.Filter{}, false)
 	require.NoError(t, f.Close())
 	require.NoError(t, err)
 	require.Equal(t, 0, res)
@@ -95,7 +98,7 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 		obj := generateObjectWithPayload(cnr, data)
 		objects[i] = obj
 
-		err := sh.Put(objects[i], nil)
+		err := sh.Put(context.Background(), objects[i], nil)
 		require.NoError(t, err)
 	}
 
@@ -103,7 +106,15 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 
 	f, err = os.Create(out)
 	require.NoError(t, err)
-	res, err = sh.Dump(f, false)
+	res, err = sh.Dump(f, dump.Latest, dump.Filter{}, false)
+	require.NoError(t, f.Close())
+	require.NoError(t, err)
+	require.Equal(t, objCount, res)
+
+	outV1 := out + ".v1"
+	f, err = os.Create(outV1)
+	require.NoError(t, err)
+	res, err = sh.Dump(f, dump.V1, dump.Filter{}, false)
 	require.NoError(t, f.Close())
 	require.NoError(t, err)
 	require.Equal(t, objCount, res)
@@ -130,7 +141,18 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 				require.Equal(t, 0, failed)
 			})
 
-			fileData, err := os.ReadFile(out)
+			t.Run("truncated", func(t *testing.T) {
+				fileData, err := os.ReadFile(out)
+				require.NoError(t, err)
+
+				out := out + ".truncated"
+				require.NoError(t, os.WriteFile(out, fileData[:len(fileData)-1], os.ModePerm))
+
+				_, _, err = restoreFile(t, sh, out, false)
+				require.ErrorIs(t, err, dump.ErrTruncated)
+			})
+
+			fileData, err := os.ReadFile(outV1)
 			require.NoError(t, err)
 
 			t.Run("incomplete size", func(t *testing.T) {
@@ -149,7 +171,7 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 				require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
 
 				count, failed, err := restoreFile(t, sh, out, false)
-				require.ErrorIs(t, err, io.EOF)
+				require.ErrorIs(t, err, io.ErrUnexpectedEOF)
 				require.Equal(t, objCount, count)
 				require.Equal(t, 0, failed)
 			})
@@ -187,6 +209,13 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 		require.NoError(t, sh.SetMode(mode.ReadWrite))
 
 		checkRestore(t, sh, out, nil, objects)
+
+		t.Run("v1", func(t *testing.T) {
+			sh := newShard(t, false)
+			defer releaseShard(sh, t)
+
+			checkRestore(t, sh, outV1, nil, objects)
+		})
 	})
 }
 
@@ -204,7 +233,7 @@ func TestStream(t *testing.T) {
 		obj := generateObjectWithCID(cnr)
 		objects[i] = obj
 
-		err := sh1.Put(objects[i], nil)
+		err := sh1.Put(context.Background(), objects[i], nil)
 		require.NoError(t, err)
 	}
 
@@ -214,7 +243,7 @@ func TestStream(t *testing.T) {
 	finish := make(chan struct{})
 
 	go func() {
-		res, err := sh1.Dump(w, false)
+		res, err := sh1.Dump(w, dump.Latest, dump.Filter{}, false)
 		require.NoError(t, err)
 		require.Equal(t, objCount, res)
 		require.NoError(t, w.Close())
@@ -235,7 +264,7 @@ func TestStream(t *testing.T) {
 func restoreFile(t *testing.T, sh *shard.Shard, path string, ignoreErrors bool) (int, int, error) {
 	f, err := os.Open(path)
 	require.NoError(t, err)
-	count, failed, err := sh.Restore(f, ignoreErrors)
+	count, failed, err := sh.Restore(f, dump.Filter{}, ignoreErrors)
 	f.Close()
 	return count, failed, err
 }
@@ -250,14 +279,14 @@ func checkRestore(t *testing.T, sh *shard.Shard, path string, r io.Reader, objec
 	if r == nil {
 		count, failed, err = restoreFile(t, sh, path, false)
 	} else {
-		count, failed, err = sh.Restore(r, false)
+		count, failed, err = sh.Restore(r, dump.Filter{}, false)
 	}
 	require.NoError(t, err)
 	require.Equal(t, len(objects), count)
 	require.Equal(t, 0, failed)
 
 	for i := range objects {
-		res, err := sh.Get(object.AddressOf(objects[i]), false)
+		res, err := sh.Get(context.Background(), object.AddressOf(objects[i]), false)
 		require.NoError(t, err)
 		require.Equal(t, objects[i], res)
 	}
@@ -296,7 +325,7 @@ func TestDumpIgnoreErrors(t *testing.T) {
 		obj := generateObjectWithPayload(cidtest.ID(), make([]byte, size))
 		objects[i] = obj
 
-		err := sh.Put(objects[i], nil)
+		err := sh.Put(context.Background(), objects[i], nil)
 		require.NoError(t, err)
 	}
 
@@ -349,8 +378,67 @@ func TestDumpIgnoreErrors(t *testing.T) {
 	out := filepath.Join(t.TempDir(), "out.dump")
 	f, err := os.Create(out)
 	require.NoError(t, err)
-	res, err := sh.Dump(f, true)
+	res, err := sh.Dump(f, dump.Latest, dump.Filter{}, true)
 	require.NoError(t, f.Close())
 	require.NoError(t, err)
 	require.Equal(t, objCount, res)
 }
+
+func TestDumpFilter(t *testing.T) {
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	cnrs := []cid.ID{cidtest.ID(), cidtest.ID()}
+
+	// 2 containers x 3 epochs
+	var objects []*objectSDK.Object
+	for _, cnr := range cnrs {
+		for epoch := range uint64(3) {
+			obj := generateObjectWithCID(cnr)
+			obj.SetCreationEpoch(epoch + 1)
+			require.NoError(t, sh.Put(context.Background(), obj, nil))
+			objects = append(objects, obj)
+		}
+	}
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+	var buf bytes.Buffer
+	res, err := sh.Dump(&buf, dump.Latest, dump.Filter{Containers: cnrs[1:]}, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, res)
+	data := buf.Bytes()
+
+	t.Run("restore", func(t *testing.T) {
+		sh := newShard(t, false)
+		defer releaseShard(sh, t)
+
+		count, failed, err := sh.Restore(bytes.NewReader(data), dump.Filter{FromEpoch: 2, ToEpoch: 2}, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.Zero(t, failed)
+
+		for _, obj := range objects {
+			_, err := sh.Get(context.Background(), object.AddressOf(obj), false)
+			if obj.GetContainerID() == cnrs[1] && obj.CreationEpoch() == 2 {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		}
+	})
+
+	t.Run("v1 by container", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := sh.Dump(&buf, dump.V1, dump.Filter{}, false)
+		require.NoError(t, err)
+
+		sh := newShard(t, false)
+		defer releaseShard(sh, t)
+
+		count, failed, err := sh.Restore(&buf, dump.Filter{Containers: cnrs[:1], FromEpoch: 2}, false)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.Zero(t, failed)
+	})
+}
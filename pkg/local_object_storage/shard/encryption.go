@@ -0,0 +1,170 @@
+package shard
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// encryptionRotation is a background re-encryption of the stored objects
+// with the active key.
+type encryptionRotation struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// EncryptionKeyID returns ID of the key all shard objects are encrypted with
+// according to the metabase. Empty ID means objects are stored unencrypted.
+func (s *Shard) EncryptionKeyID() ([]byte, error) {
+	if s.GetMode().NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	id, err := s.metaBase.ReadEncryptionKeyID()
+	if err != nil {
+		return nil, fmt.Errorf("could not read encryption key ID from metabase: %w", err)
+	}
+
+	return id, nil
+}
+
+// startEncryptionRotation starts re-encryption of the stored objects if they
+// are encrypted with a key other than the active one.
+func (s *Shard) startEncryptionRotation() {
+	c := s.compression.Encryption
+	if c == nil || s.GetMode() != mode.ReadWrite {
+		return
+	}
+
+	var target []byte
+	if id, ok := c.ActiveKeyID(); ok {
+		target = id[:]
+	}
+
+	current, err := s.metaBase.ReadEncryptionKeyID()
+	if err != nil {
+		s.log.Warn("could not read encryption key ID, objects are not re-encrypted", zap.Error(err))
+		return
+	}
+	if bytes.Equal(current, target) {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &encryptionRotation{cancel: cancel}
+	r.wg.Add(1)
+	s.rotation = r
+
+	go func() {
+		defer r.wg.Done()
+		s.rotateEncryptionKey(ctx, current, target)
+	}()
+}
+
+func (s *Shard) stopEncryptionRotation() {
+	if s.rotation == nil {
+		return
+	}
+
+	s.rotation.cancel()
+	s.rotation.wg.Wait()
+	s.rotation = nil
+}
+
+// rotateEncryptionKey rewrites all objects stored in the blobstor, so that
+// they are encrypted with the active key, and saves the key ID in the
+// metabase on success. Objects already encrypted with the active key, e.g.
+// by the interrupted rotation, are skipped.
+func (s *Shard) rotateEncryptionKey(ctx context.Context, from, to []byte) {
+	log := s.log.With(zap.String("from", fmt.Sprintf("%x", from)), zap.String("to", fmt.Sprintf("%x", to)))
+	log.Info("started re-encryption of the stored objects")
+
+	var (
+		rewritten, skipped, failed int
+
+		keyReader, _ = s.blobStor.(common.EncryptionKeyReader)
+	)
+
+	err := s.blobStor.Iterate(func(addr oid.Address, data []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if keyReader != nil {
+			id, ok, err := keyReader.EncryptionKeyID(addr)
+			if err == nil && (ok && bytes.Equal(id[:], to) || !ok && len(to) == 0) {
+				skipped++
+				return nil
+			}
+		}
+
+		ok, err := s.reencryptObject(addr, data)
+		if err != nil {
+			log.Warn("could not re-encrypt object", zap.Stringer("address", addr), zap.Error(err))
+			failed++
+			return nil
+		}
+
+		if ok {
+			rewritten++
+		}
+		return nil
+	}, func(addr oid.Address, err error) error {
+		log.Warn("could not read object for re-encryption", zap.Stringer("address", addr), zap.Error(err))
+		failed++
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			log.Info("re-encryption of the stored objects interrupted, it will be restarted",
+				zap.Int("rewritten", rewritten), zap.Int("skipped", skipped))
+			return
+		}
+		log.Error("re-encryption of the stored objects failed", zap.Int("rewritten", rewritten), zap.Int("skipped", skipped), zap.Error(err))
+		return
+	}
+
+	if failed > 0 {
+		log.Error("re-encryption of the stored objects finished with failures, it will be restarted",
+			zap.Int("rewritten", rewritten), zap.Int("skipped", skipped), zap.Int("failed", failed))
+		return
+	}
+
+	err = s.metaBase.WriteEncryptionKeyID(to)
+	if err != nil {
+		log.Error("could not save encryption key ID", zap.Error(err))
+		return
+	}
+
+	log.Info("finished re-encryption of the stored objects", zap.Int("rewritten", rewritten), zap.Int("skipped", skipped))
+}
+
+// reencryptObject rewrites the object with the current encryption settings.
+// Object removed from the metabase is not rewritten to not resurrect it, the
+// shard lock is held to not race with the removal. Returns false if the object
+// is not rewritten.
+func (s *Shard) reencryptObject(addr oid.Address, data []byte) (bool, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	exists, err := s.metaBase.Exists(addr, true)
+	if err != nil {
+		if meta.IsErrRemoved(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("could not check object existence: %w", err)
+	}
+	if !exists {
+		return false, nil
+	}
+
+	return true, s.blobStor.Rewrite(addr, data)
+}
@@ -0,0 +1,152 @@
+package shard_test
+
+import (
+	"context"
+	"crypto/rand"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func newEncryptionKey() encryption.Key {
+	var k encryption.Key
+	_, _ = rand.Read(k[:])
+	return k
+}
+
+// storedKeyIDs returns IDs of the keys files under the root are encrypted
+// with, zero ID for unencrypted files.
+func storedKeyIDs(t *testing.T, root string) []encryption.KeyID {
+	var res []encryption.KeyID
+	require.NoError(t, filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		// skip FSTree combined file prefix, see fstree.combinedDataOff
+		if len(data) > 38 && data[0] == 0x7f && data[1] == 0 {
+			data = data[38:]
+		}
+		id, _ := encryption.KeyIDOf(data)
+		res = append(res, id)
+		return nil
+	}))
+	return res
+}
+
+// storedFiles returns information about files under the root by their paths.
+func storedFiles(t *testing.T, root string) map[string]fs.FileInfo {
+	res := make(map[string]fs.FileInfo)
+	require.NoError(t, filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		res[path], err = os.Stat(path)
+		return err
+	}))
+	return res
+}
+
+func TestShard_Encryption(t *testing.T) {
+	root := t.TempDir()
+	fsTreePath := filepath.Join(root, "nowc", "fstree")
+	k1, k2 := newEncryptionKey(), newEncryptionKey()
+
+	objs := make([]*objectSDK.Object, 5)
+	sh := newCustomShard(t, root, false, nil)
+	for i := range objs {
+		objs[i] = generateObject()
+		require.NoError(t, sh.Put(context.Background(), objs[i], nil))
+	}
+	require.NoError(t, sh.Close())
+
+	checkRotation := func(t *testing.T, c *encryption.Cipher) {
+		sh := newCustomShard(t, root, false, nil, shard.WithEncryption(c))
+		t.Cleanup(func() { releaseShard(sh, t) })
+
+		var expected []byte
+		if id, ok := c.ActiveKeyID(); ok {
+			expected = id[:]
+		}
+		require.Eventually(t, func() bool {
+			id, err := sh.EncryptionKeyID()
+			require.NoError(t, err)
+			return string(id) == string(expected)
+		}, 5*time.Second, 10*time.Millisecond)
+
+		ids := storedKeyIDs(t, fsTreePath)
+		require.Len(t, ids, len(objs))
+		for _, id := range ids {
+			require.Equal(t, string(expected), string(id[:len(expected)]))
+		}
+
+		for _, obj := range objs {
+			res, err := sh.Get(context.Background(), object.AddressOf(obj), false)
+			require.NoError(t, err)
+			require.Equal(t, obj, res)
+		}
+	}
+
+	c1, err := encryption.New(&k1)
+	require.NoError(t, err)
+	c2, err := encryption.New(&k2, k1)
+	require.NoError(t, err)
+	c3, err := encryption.New(nil, k2)
+	require.NoError(t, err)
+
+	t.Run("encrypt", func(t *testing.T) { checkRotation(t, c1) })
+	t.Run("rotate", func(t *testing.T) { checkRotation(t, c2) })
+	t.Run("interrupted", func(t *testing.T) {
+		// objects are already rewritten, but the key ID is not saved
+		db := meta.New(meta.WithPath(filepath.Join(root, "nowc", "meta")), meta.WithEpochState(epochState{}))
+		require.NoError(t, db.Open(false))
+		id := k1.ID()
+		require.NoError(t, db.WriteEncryptionKeyID(id[:]))
+		require.NoError(t, db.Close())
+
+		files := storedFiles(t, fsTreePath)
+		checkRotation(t, c2)
+		for p, fi := range files {
+			st, err := os.Stat(p)
+			require.NoError(t, err)
+			require.True(t, os.SameFile(fi, st), "object is rewritten")
+		}
+	})
+	t.Run("decrypt", func(t *testing.T) {
+		checkRotation(t, c3)
+		for _, id := range storedKeyIDs(t, fsTreePath) {
+			require.Zero(t, id)
+		}
+	})
+
+	t.Run("write-cache", func(t *testing.T) {
+		root := t.TempDir()
+		sh := newCustomShard(t, root, true, nil, shard.WithEncryption(c1))
+		defer releaseShard(sh, t)
+
+		obj := generateObject()
+		require.NoError(t, sh.Put(context.Background(), obj, nil))
+
+		ids := storedKeyIDs(t, filepath.Join(root, "wc", "wcache"))
+		require.Contains(t, ids, k1.ID())
+
+		res, err := sh.Get(context.Background(), object.AddressOf(obj), false)
+		require.NoError(t, err)
+		require.Equal(t, obj, res)
+
+		require.NoError(t, sh.FlushWriteCache(true))
+		require.Equal(t, []encryption.KeyID{k1.ID()}, storedKeyIDs(t, filepath.Join(root, "wc", "fstree")))
+	})
+}
@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -30,7 +31,7 @@ func TestExists(t *testing.T) {
 	}
 
 	for i := range objects {
-		err = sh.Put(objects[i], nil)
+		err = sh.Put(context.Background(), objects[i], nil)
 		require.NoError(t, err)
 	}
 
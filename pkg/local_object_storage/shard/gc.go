@@ -1,11 +1,15 @@
 package shard
 
 import (
+	"context"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -58,6 +62,9 @@ type gc struct {
 
 	remover func()
 
+	// lastEpoch is the latest epoch handled by GC, zero if none.
+	lastEpoch atomic.Uint64
+
 	eventChan     chan Event
 	mEventHandler map[eventType]*eventHandlers
 }
@@ -106,6 +113,10 @@ func (gc *gc) listenEvents() {
 			return
 		}
 
+		if e, ok := event.(newEpoch); ok {
+			gc.lastEpoch.Store(e.epoch)
+		}
+
 		v, ok := gc.mEventHandler[event.typ()]
 		if !ok {
 			continue
@@ -165,15 +176,141 @@ func (gc *gc) stop() {
 	gc.wg.Wait()
 }
 
-// iterates over metabase and deletes objects
-// with GC-marked graves.
-// Does nothing if shard is in "read-only" mode.
+// Categories of objects removed by GC passed to [MetricsWriter].
+const (
+	GCCategoryGarbage           = "garbage"
+	GCCategoryExpiredObjects    = "expired_objects"
+	GCCategoryExpiredTombstones = "expired_tombstones"
+	GCCategoryExpiredLocks      = "expired_locks"
+)
+
+// GCStats describes results of a single GC cycle.
+type GCStats struct {
+	// RemovedGarbage is the number of objects marked as garbage and removed
+	// from the shard.
+	RemovedGarbage uint64
+	// ExpiredObjects is the number of expired objects handed over for removal.
+	ExpiredObjects uint64
+	// ExpiredLocks is the number of expired locks handed over for removal.
+	ExpiredLocks uint64
+	// DroppedTombstones is the number of expired tombstone marks dropped from
+	// the graveyard.
+	DroppedTombstones uint64
+	// Duration is the time spent on the cycle.
+	Duration time.Duration
+}
+
+// RunGC starts GC cycle right away and waits for it to finish. All the
+// objects marked as garbage are removed, and if any epoch has already been
+// handled by GC, expired objects, tombstones and locks are collected for it
+// as well. Returns [ErrReadOnlyMode] or [ErrDegradedMode] if shard is not in
+// read-write mode.
+func (s *Shard) RunGC() (GCStats, error) {
+	var (
+		res   GCStats
+		start = time.Now()
+	)
+
+	switch m := s.GetMode(); {
+	case m.NoMetabase():
+		return res, ErrDegradedMode
+	case m != mode.ReadWrite:
+		return res, ErrReadOnlyMode
+	}
+
+	backlog, err := s.metaBase.GarbageCount()
+	if err != nil {
+		return res, fmt.Errorf("count garbage objects: %w", err)
+	}
+
+	// garbage may be added concurrently, so limit the number of batches
+	// to the current backlog
+	for range backlog/uint64(s.rmBatchSize) + 1 {
+		removed, err := s.removeGarbageBatch()
+		res.RemovedGarbage += removed
+		if err != nil {
+			return res, err
+		}
+		if removed < uint64(s.rmBatchSize) {
+			break
+		}
+	}
+
+	if epoch := s.gc.lastEpoch.Load(); epoch > 0 {
+		res.ExpiredObjects = s.handleExpiredObjects(epoch)
+		res.DroppedTombstones = s.handleExpiredTombstones(epoch)
+		res.ExpiredLocks = s.handleExpiredLocks(epoch)
+	}
+
+	res.Duration = time.Since(start)
+	s.reportGCRun(res.RemovedGarbage, res.Duration)
+	s.reportGCBacklog()
+
+	return res, nil
+}
+
+// removeGarbage is a periodic GC routine removing single batch of garbage.
 func (s *Shard) removeGarbage() {
+	start := time.Now()
+
+	removed, err := s.removeGarbageBatch()
+	if err != nil {
+		return
+	}
+
+	s.reportGCRun(removed, time.Since(start))
+	if removed < uint64(s.rmBatchSize) && s.cfg.metricsWriter != nil {
+		// not full batch means all the garbage is removed
+		s.cfg.metricsWriter.SetGCBacklog(0)
+	}
+}
+
+// reportGCRun updates GC metrics after the garbage removal.
+func (s *Shard) reportGCRun(removed uint64, d time.Duration) {
+	if s.cfg.metricsWriter == nil {
+		return
+	}
+
+	s.cfg.metricsWriter.AddGCRemovedObjects(GCCategoryGarbage, removed)
+	s.cfg.metricsWriter.AddGCRunDuration(d)
+	s.cfg.metricsWriter.SetGCLastRun(time.Now())
+}
+
+// reportGCBacklog updates number of objects waiting to be removed by GC. It is
+// counted by the whole garbage traversal, so it is done on explicit GC runs
+// only.
+func (s *Shard) reportGCBacklog() {
+	if s.cfg.metricsWriter == nil {
+		return
+	}
+
+	backlog, err := s.metaBase.GarbageCount()
+	if err != nil {
+		s.log.Warn("could not count garbage objects", zap.Error(err))
+		return
+	}
+
+	s.cfg.metricsWriter.SetGCBacklog(backlog)
+}
+
+func (s *Shard) addGCRemovedObjects(category string, n uint64) {
+	if s.cfg.metricsWriter != nil && n > 0 {
+		s.cfg.metricsWriter.AddGCRemovedObjects(category, n)
+	}
+}
+
+// iterates over metabase and deletes a batch of objects
+// with GC-marked graves. Returns number of removed objects.
+// Does nothing if shard is not in "read-write" mode.
+func (s *Shard) removeGarbageBatch() (uint64, error) {
 	s.m.RLock()
 	defer s.m.RUnlock()
 
 	if s.info.Mode != mode.ReadWrite {
-		return
+		if s.info.Mode.NoMetabase() {
+			return 0, ErrDegradedMode
+		}
+		return 0, ErrReadOnlyMode
 	}
 
 	gObjs, gContainers, err := s.metaBase.GetGarbage(s.rmBatchSize)
@@ -182,17 +319,17 @@ func (s *Shard) removeGarbage() {
 			zap.Error(err),
 		)
 
-		return
+		return 0, fmt.Errorf("fetch garbage objects: %w", err)
 	}
 
 	// delete accumulated objects
-	err = s.deleteObjs(gObjs, true)
+	err = s.deleteObjs(iosched.WithClass(context.Background(), iosched.GC), gObjs, true, false)
 	if err != nil {
 		s.log.Warn("could not delete the objects",
 			zap.Error(err),
 		)
 
-		return
+		return 0, fmt.Errorf("delete garbage objects: %w", err)
 	}
 
 	// objects are removed, clean up empty container (all the object
@@ -206,24 +343,29 @@ func (s *Shard) removeGarbage() {
 			)
 		}
 	}
+
+	return uint64(len(gObjs)), nil
 }
 
 func (s *Shard) collectExpiredObjects(e Event) {
-	epoch := e.(newEpoch).epoch
+	s.addGCRemovedObjects(GCCategoryExpiredObjects, s.handleExpiredObjects(e.(newEpoch).epoch))
+}
+
+func (s *Shard) handleExpiredObjects(epoch uint64) uint64 {
 	log := s.log.With(zap.Uint64("epoch", epoch))
 
 	log.Debug("started expired objects handling")
 
-	expired, err := s.getExpiredObjects(e.(newEpoch).epoch, func(typ object.Type) bool {
+	expired, err := s.getExpiredObjects(epoch, func(typ object.Type) bool {
 		return typ != object.TypeLock
 	})
 	if err != nil {
 		log.Warn("iterator over expired objects failed", zap.Error(err))
-		return
+		return 0
 	}
 	if len(expired) == 0 {
 		log.Debug("no expired objects")
-		return
+		return 0
 	}
 
 	log.Debug("collected expired objects", zap.Int("num", len(expired)))
@@ -231,10 +373,15 @@ func (s *Shard) collectExpiredObjects(e Event) {
 	s.expiredObjectsCallback(expired)
 
 	log.Debug("finished expired objects handling")
+
+	return uint64(len(expired))
 }
 
 func (s *Shard) collectExpiredTombstones(e Event) {
-	epoch := e.(newEpoch).epoch
+	s.addGCRemovedObjects(GCCategoryExpiredTombstones, s.handleExpiredTombstones(e.(newEpoch).epoch))
+}
+
+func (s *Shard) handleExpiredTombstones(epoch uint64) uint64 {
 	log := s.log.With(zap.Uint64("epoch", epoch))
 
 	log.Debug("started expired tombstones handling")
@@ -242,24 +389,32 @@ func (s *Shard) collectExpiredTombstones(e Event) {
 	dropped, err := s.metaBase.DropExpiredTSMarks(epoch)
 	if err != nil {
 		log.Error("cleaning graveyard up failed", zap.Error(err))
-		return
+		return 0
 	}
 
 	log.Debug("finished expired tombstones handling", zap.Int("dropped marks", dropped))
+
+	return uint64(dropped)
 }
 
 func (s *Shard) collectExpiredLocks(e Event) {
-	expired, err := s.getExpiredObjects(e.(newEpoch).epoch, func(typ object.Type) bool {
+	s.addGCRemovedObjects(GCCategoryExpiredLocks, s.handleExpiredLocks(e.(newEpoch).epoch))
+}
+
+func (s *Shard) handleExpiredLocks(epoch uint64) uint64 {
+	expired, err := s.getExpiredObjects(epoch, func(typ object.Type) bool {
 		return typ == object.TypeLock
 	})
 	if err != nil || len(expired) == 0 {
 		if err != nil {
 			s.log.Warn("iterator over expired locks failed", zap.Error(err))
 		}
-		return
+		return 0
 	}
 
 	s.expiredLocksCallback(expired)
+
+	return uint64(len(expired))
 }
 
 func (s *Shard) getExpiredObjects(epoch uint64, typeCond func(object.Type) bool) ([]oid.Address, error) {
@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -12,6 +13,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
@@ -85,20 +87,20 @@ func TestGC_ExpiredObjectWithExpiredLock(t *testing.T) {
 	lock.SetAttributes(expAttr)
 	lockID := lock.GetID()
 
-	err := sh.Put(obj, nil)
+	err := sh.Put(context.Background(), obj, nil)
 	require.NoError(t, err)
 
 	err = sh.Lock(cnr, lockID, []oid.ID{objID})
 	require.NoError(t, err)
 
-	err = sh.Put(lock, nil)
+	err = sh.Put(context.Background(), lock, nil)
 	require.NoError(t, err)
 
 	epoch.Value = 5
 	sh.NotificationChannel() <- shard.EventNewEpoch(epoch.Value)
 
 	require.Eventually(t, func() bool {
-		_, err = sh.Get(objectCore.AddressOf(obj), false)
+		_, err = sh.Get(context.Background(), objectCore.AddressOf(obj), false)
 		return shard.IsErrNotFound(err)
 	}, 3*time.Second, 1*time.Second, "lock expiration should free object removal")
 }
@@ -123,7 +125,7 @@ func TestGC_ContainerCleanup(t *testing.T) {
 			addPayload(obj, 1<<20) // big
 		}
 
-		err := sh.Put(obj, nil)
+		err := sh.Put(context.Background(), obj, nil)
 		require.NoError(t, err)
 
 		oo = append(oo, objectCore.AddressOf(obj))
@@ -134,7 +136,7 @@ func TestGC_ContainerCleanup(t *testing.T) {
 	require.Len(t, containers, 1)
 
 	for _, o := range oo {
-		_, err = sh.Get(o, false)
+		_, err = sh.Get(context.Background(), o, false)
 		require.NoError(t, err)
 	}
 
@@ -145,7 +147,7 @@ func TestGC_ContainerCleanup(t *testing.T) {
 		require.NoError(t, err)
 
 		for _, o := range oo {
-			_, err = sh.Get(o, false)
+			_, err = sh.Get(context.Background(), o, false)
 			if !errors.Is(err, apistatus.ObjectNotFound{}) {
 				return false
 			}
@@ -205,18 +207,59 @@ func TestExpiration(t *testing.T) {
 			obj.SetType(typ)
 			require.NoError(t, obj.SetIDWithSignature(neofscryptotest.Signer()))
 
-			err := sh.Put(obj, nil)
+			err := sh.Put(context.Background(), obj, nil)
 			require.NoError(t, err)
 
-			_, err = sh.Get(objectCore.AddressOf(obj), false)
+			_, err = sh.Get(context.Background(), objectCore.AddressOf(obj), false)
 			require.NoError(t, err)
 
 			ch <- shard.EventNewEpoch(exp + 1)
 
 			require.Eventually(t, func() bool {
-				_, err = sh.Get(objectCore.AddressOf(obj), false)
+				_, err = sh.Get(context.Background(), objectCore.AddressOf(obj), false)
 				return shard.IsErrNotFound(err)
 			}, 3*time.Second, 100*time.Millisecond, "expiration should lead to object removal")
 		})
 	}
 }
+
+func TestShard_RunGC(t *testing.T) {
+	sh, mm := shardWithMetrics(t, t.TempDir(), shard.WithRemoverBatchSize(2))
+
+	const numOfObjs = 5
+	addrs := make([]oid.Address, 0, numOfObjs)
+
+	for range numOfObjs {
+		obj := generateObject()
+		require.NoError(t, sh.Put(context.Background(), obj, nil))
+		addrs = append(addrs, objectCore.AddressOf(obj))
+	}
+
+	require.NoError(t, sh.MarkGarbage(false, addrs[:numOfObjs-1]...))
+
+	res, err := sh.RunGC()
+	require.NoError(t, err)
+	require.EqualValues(t, numOfObjs-1, res.RemovedGarbage)
+	require.Zero(t, res.ExpiredObjects)
+	require.Zero(t, res.ExpiredLocks)
+	require.Zero(t, res.DroppedTombstones)
+	require.Positive(t, res.Duration)
+
+	for _, addr := range addrs[:numOfObjs-1] {
+		_, err = sh.Get(context.Background(), addr, false)
+		require.True(t, shard.IsErrNotFound(err))
+	}
+	_, err = sh.Get(context.Background(), addrs[numOfObjs-1], false)
+	require.NoError(t, err)
+
+	require.EqualValues(t, numOfObjs-1, mm.gcRemoved[shard.GCCategoryGarbage])
+	require.Equal(t, 1, mm.gcRuns)
+	require.Zero(t, mm.gcBacklog)
+	require.False(t, mm.gcLastRun.IsZero())
+
+	t.Run("read-only", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(mode.ReadOnly))
+		_, err := sh.RunGC()
+		require.ErrorIs(t, err, shard.ErrReadOnlyMode)
+	})
+}
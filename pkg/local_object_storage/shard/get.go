@@ -1,12 +1,14 @@
 package shard
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -25,12 +27,25 @@ var ErrMetaWithNoObject = errors.New("got meta, but no object")
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Get(addr oid.Address, skipMeta bool) (*objectSDK.Object, error) {
+func (s *Shard) Get(ctx context.Context, addr oid.Address, skipMeta bool) (res *objectSDK.Object, err error) {
+	ctx, span := s.startSpan(ctx, "Get", addr)
+	defer func() { tracing.End(span, err) }()
+
+	release, err := s.acquireIO(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if res != nil {
+			release(uint64(len(res.Payload())))
+		} else {
+			release(0)
+		}
+	}()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
-	var res *objectSDK.Object
-
 	cb := func(stor common.Storage) error {
 		obj, err := stor.Get(addr)
 		if err != nil {
@@ -50,7 +65,7 @@ func (s *Shard) Get(addr oid.Address, skipMeta bool) (*objectSDK.Object, error)
 	}
 
 	skipMeta = skipMeta || s.info.Mode.NoMetabase()
-	gotMeta, err := s.fetchObjectData(addr, skipMeta, cb, wc)
+	gotMeta, err := s.fetchObjectData(ctx, "Get", addr, skipMeta, cb, wc)
 	if err != nil && gotMeta {
 		err = fmt.Errorf("%w, %w", err, ErrMetaWithNoObject)
 	}
@@ -60,8 +75,8 @@ func (s *Shard) Get(addr oid.Address, skipMeta bool) (*objectSDK.Object, error)
 
 // fetchObjectData looks through writeCache and blobStor to find object. Returns
 // true iff skipMeta flag is unset && referenced object is found in the
-// underlying metaBase.
-func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool,
+// underlying metaBase. Storage calls are traced as op if ctx is traced.
+func (s *Shard) fetchObjectData(ctx context.Context, op string, addr oid.Address, skipMeta bool,
 	storageFunc func(st common.Storage) error,
 	wc func(w writecache.Cache) error,
 ) (bool, error) {
@@ -71,14 +86,18 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool,
 	)
 
 	if !skipMeta {
-		exists, mErr = s.metaBase.Exists(addr, false)
+		mErr = traceStorage(ctx, "metabase", "Exists", func() error {
+			var err error
+			exists, err = s.metaBase.Exists(addr, false)
+			return err
+		})
 		if mErr != nil && !s.info.Mode.NoMetabase() {
 			return false, mErr
 		}
 	}
 
 	if s.hasWriteCache() {
-		err := wc(s.writeCache)
+		err := traceStorage(ctx, "writecache", op, func() error { return wc(s.writeCache) })
 		if err == nil || IsErrOutOfRange(err) {
 			return exists, err
 		}
@@ -95,8 +114,10 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool,
 		}
 	}
 
+	blobFunc := func() error { return storageFunc(s.blobStor) }
+
 	if skipMeta || mErr != nil {
-		err := storageFunc(s.blobStor)
+		err := traceStorage(ctx, s.blobStor.Type(), op, blobFunc)
 		return false, err
 	}
 
@@ -104,29 +125,35 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool,
 		return false, logicerr.Wrap(apistatus.ObjectNotFound{})
 	}
 
-	return true, storageFunc(s.blobStor)
+	return true, traceStorage(ctx, s.blobStor.Type(), op, blobFunc)
 }
 
 // GetBytes reads object from the Shard by address into memory buffer in a
 // canonical NeoFS binary format. Returns [apistatus.ObjectNotFound] if object
 // is missing.
-func (s *Shard) GetBytes(addr oid.Address) ([]byte, error) {
-	return s.getBytesWithMetadataLookup(addr, true)
+func (s *Shard) GetBytes(ctx context.Context, addr oid.Address) ([]byte, error) {
+	return s.getBytesWithMetadataLookup(ctx, addr, true)
 }
 
 // GetBytesWithMetadataLookup works similar to [shard.GetBytes], but pre-checks
 // object presence in the underlying metabase: if object cannot be accessed from
 // the metabase, GetBytesWithMetadataLookup returns an error.
-func (s *Shard) GetBytesWithMetadataLookup(addr oid.Address) ([]byte, error) {
-	return s.getBytesWithMetadataLookup(addr, false)
+func (s *Shard) GetBytesWithMetadataLookup(ctx context.Context, addr oid.Address) ([]byte, error) {
+	return s.getBytesWithMetadataLookup(ctx, addr, false)
 }
 
-func (s *Shard) getBytesWithMetadataLookup(addr oid.Address, skipMeta bool) ([]byte, error) {
-	s.m.RLock()
-	defer s.m.RUnlock()
+func (s *Shard) getBytesWithMetadataLookup(ctx context.Context, addr oid.Address, skipMeta bool) ([]byte, error) {
+	release, err := s.acquireIO(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
 
 	var b []byte
-	hasMeta, err := s.fetchObjectData(addr, skipMeta, func(st common.Storage) error {
+	defer func() { release(uint64(len(b))) }()
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+	hasMeta, err := s.fetchObjectData(ctx, "GetBytes", addr, skipMeta, func(st common.Storage) error {
 		var err error
 		b, err = st.GetBytes(addr)
 		return err
@@ -2,6 +2,7 @@ package shard_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -35,7 +36,7 @@ func testShardGet(t *testing.T, hasWriteCache bool) {
 		addPayload(obj, 1<<5)
 		addr := object.AddressOf(obj)
 
-		err := sh.Put(obj, nil)
+		err := sh.Put(context.Background(), obj, nil)
 		require.NoError(t, err)
 
 		res, err := testGet(t, sh, addr, hasWriteCache)
@@ -52,7 +53,7 @@ func testShardGet(t *testing.T, hasWriteCache bool) {
 		addPayload(obj, 1<<20) // big obj
 		addr := object.AddressOf(obj)
 
-		err := sh.Put(obj, nil)
+		err := sh.Put(context.Background(), obj, nil)
 		require.NoError(t, err)
 
 		res, err := testGet(t, sh, addr, hasWriteCache)
@@ -78,7 +79,7 @@ func testShardGet(t *testing.T, hasWriteCache bool) {
 		child.SetSplitID(splitID)
 		addPayload(child, 1<<5)
 
-		err := sh.Put(child, nil)
+		err := sh.Put(context.Background(), child, nil)
 		require.NoError(t, err)
 
 		res, err := testGet(t, sh, object.AddressOf(child), hasWriteCache)
@@ -100,11 +101,11 @@ func testShardGet(t *testing.T, hasWriteCache bool) {
 }
 
 func testGet(t *testing.T, sh *shard.Shard, addr oid.Address, hasWriteCache bool) (*objectSDK.Object, error) {
-	res, err := sh.Get(addr, false)
+	res, err := sh.Get(context.Background(), addr, false)
 	if hasWriteCache {
 		require.Eventually(t, func() bool {
 			if shard.IsErrNotFound(err) {
-				res, err = sh.Get(addr, false)
+				res, err = sh.Get(context.Background(), addr, false)
 			}
 			return !shard.IsErrNotFound(err)
 		}, time.Second, time.Millisecond*100)
@@ -113,11 +114,11 @@ func testGet(t *testing.T, sh *shard.Shard, addr oid.Address, hasWriteCache bool
 }
 
 func testGetBytes(t testing.TB, sh *shard.Shard, addr oid.Address, objBin []byte) {
-	b, err := sh.GetBytes(addr)
+	b, err := sh.GetBytes(context.Background(), addr)
 	require.NoError(t, err)
 	require.Equal(t, objBin, b)
 
-	b, err = sh.GetBytesWithMetadataLookup(addr)
+	b, err = sh.GetBytesWithMetadataLookup(context.Background(), addr)
 	require.NoError(t, err)
 	require.Equal(t, objBin, b)
 }
@@ -1,9 +1,11 @@
 package shard
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -18,13 +20,27 @@ import (
 // Returns an error of type apistatus.ObjectNotFound if object is missing in Shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Head(addr oid.Address, raw bool) (*objectSDK.Object, error) {
+func (s *Shard) Head(ctx context.Context, addr oid.Address, raw bool) (res *objectSDK.Object, err error) {
+	ctx, span := s.startSpan(ctx, "Head", addr)
+	defer func() { tracing.End(span, err) }()
+
+	release, err := s.acquireIO(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer release(0)
+
 	var (
 		errSplitInfo *objectSDK.SplitInfoError
 		children     = make([]oid.Address, 0, 2)
 	)
 	if !s.GetMode().NoMetabase() {
-		available, err := s.metaBase.Exists(addr, false)
+		var available bool
+		err := traceStorage(ctx, "metabase", "Exists", func() error {
+			var err error
+			available, err = s.metaBase.Exists(addr, false)
+			return err
+		})
 		if err != nil && !errors.As(err, &errSplitInfo) {
 			return nil, err
 		}
@@ -46,15 +62,7 @@ func (s *Shard) Head(addr oid.Address, raw bool) (*objectSDK.Object, error) {
 			continue
 		}
 
-		if s.hasWriteCache() {
-			childHead, err := s.writeCache.Head(child)
-			if err == nil {
-				return childHead.Parent(), nil
-			}
-		}
-
-		childHead, err := s.blobStor.Head(child)
-		if err == nil {
+		if childHead, err := s.headFromStorages(ctx, child); err == nil {
 			return childHead.Parent(), nil
 		}
 	}
@@ -64,12 +72,28 @@ func (s *Shard) Head(addr oid.Address, raw bool) (*objectSDK.Object, error) {
 		return nil, errSplitInfo
 	}
 
+	return s.headFromStorages(ctx, addr)
+}
+
+// headFromStorages reads object header from the write-cache if any and falls
+// back to the blobstor.
+func (s *Shard) headFromStorages(ctx context.Context, addr oid.Address) (*objectSDK.Object, error) {
+	var hdr *objectSDK.Object
 	if s.hasWriteCache() {
-		obj, err := s.writeCache.Head(addr)
+		err := traceStorage(ctx, "writecache", "Head", func() error {
+			var err error
+			hdr, err = s.writeCache.Head(addr)
+			return err
+		})
 		if err == nil {
-			return obj, err
+			return hdr, nil
 		}
 	}
 
-	return s.blobStor.Head(addr)
+	err := traceStorage(ctx, s.blobStor.Type(), "Head", func() error {
+		var err error
+		hdr, err = s.blobStor.Head(addr)
+		return err
+	})
+	return hdr, err
 }
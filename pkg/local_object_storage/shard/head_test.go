@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -31,7 +32,7 @@ func testShardHead(t *testing.T, hasWriteCache bool) {
 		obj := generateObject()
 		addAttribute(obj, "foo", "bar")
 
-		err := sh.Put(obj, nil)
+		err := sh.Put(context.Background(), obj, nil)
 		require.NoError(t, err)
 
 		res, err := testHead(t, sh, object.AddressOf(obj), false, hasWriteCache)
@@ -52,7 +53,7 @@ func testShardHead(t *testing.T, hasWriteCache bool) {
 		child.SetParentID(idParent)
 		child.SetSplitID(splitID)
 
-		err := sh.Put(child, nil)
+		err := sh.Put(context.Background(), child, nil)
 		require.NoError(t, err)
 
 		var siErr *objectSDK.SplitInfoError
@@ -60,18 +61,18 @@ func testShardHead(t *testing.T, hasWriteCache bool) {
 		_, err = testHead(t, sh, object.AddressOf(parent), true, hasWriteCache)
 		require.True(t, errors.As(err, &siErr))
 
-		head, err := sh.Head(object.AddressOf(parent), false)
+		head, err := sh.Head(context.Background(), object.AddressOf(parent), false)
 		require.NoError(t, err)
 		require.Equal(t, parent.CutPayload(), head)
 	})
 }
 
 func testHead(t *testing.T, sh *shard.Shard, addr oid.Address, raw bool, hasWriteCache bool) (*objectSDK.Object, error) {
-	res, err := sh.Head(addr, raw)
+	res, err := sh.Head(context.Background(), addr, raw)
 	if hasWriteCache {
 		require.Eventually(t, func() bool {
 			if shard.IsErrNotFound(err) {
-				res, err = sh.Head(addr, raw)
+				res, err = sh.Head(context.Background(), addr, raw)
 			}
 			return !shard.IsErrNotFound(err)
 		}, time.Second, time.Millisecond*100)
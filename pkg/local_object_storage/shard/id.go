@@ -25,6 +25,11 @@ func (s *Shard) ID() *ID {
 	return s.info.ID
 }
 
+// Tier returns name of the storage tier the shard belongs to.
+func (s *Shard) Tier() string {
+	return s.info.Tier
+}
+
 // UpdateID reads shard ID saved in the metabase and updates it if it is missing.
 func (s *Shard) UpdateID() (err error) {
 	if err = s.metaBase.Open(false); err != nil {
@@ -14,6 +14,9 @@ type Info struct {
 	// Shard mode.
 	Mode mode.Mode
 
+	// Name of the storage tier the shard belongs to.
+	Tier string
+
 	// Information about the metabase.
 	MetaBaseInfo meta.Info
 
@@ -25,6 +28,9 @@ type Info struct {
 
 	// ErrorCount contains amount of errors occurred in shard operations.
 	ErrorCount uint32
+
+	// Progress of the metabase resynchronization.
+	Resync ResyncInfo
 }
 
 // StorageInfo contains information about storage component.
@@ -35,5 +41,12 @@ type StorageInfo struct {
 
 // DumpInfo returns information about the Shard.
 func (s *Shard) DumpInfo() Info {
-	return s.info
+	s.m.RLock()
+	info := s.info
+	s.m.RUnlock()
+
+	if r := s.resync.Load(); r != nil {
+		info.Resync = r.info()
+	}
+	return info
 }
@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -30,7 +31,7 @@ func testShardInhume(t *testing.T, hasWriteCache bool) {
 
 	ts := generateObjectWithCID(cnr)
 
-	err := sh.Put(obj, nil)
+	err := sh.Put(context.Background(), obj, nil)
 	require.NoError(t, err)
 
 	_, err = testGet(t, sh, object.AddressOf(obj), hasWriteCache)
@@ -39,6 +40,6 @@ func testShardInhume(t *testing.T, hasWriteCache bool) {
 	err = sh.Inhume(object.AddressOf(ts), 0, object.AddressOf(obj))
 	require.NoError(t, err)
 
-	_, err = sh.Get(object.AddressOf(obj), false)
+	_, err = sh.Get(context.Background(), object.AddressOf(obj), false)
 	require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
 }
@@ -0,0 +1,26 @@
+package shard
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
+)
+
+// WithIOScheduler returns option to schedule storage operations of the shard
+// according to the classes their contexts are tagged with, see
+// [iosched.WithClass]. Untagged operations are served as client ones.
+// Disabled by default.
+func WithIOScheduler(sc iosched.Config) Option {
+	return func(c *cfg) {
+		c.ioSchedCfg = sc
+	}
+}
+
+// acquireIO waits until the operation of the class ctx is tagged with reading
+// or writing size bytes is allowed to start. Returned function must be called
+// with the number of bytes read when the operation is finished. It must be
+// called before taking the shard lock, waiting for the slot under the lock
+// would block mode switches.
+func (s *Shard) acquireIO(ctx context.Context, size uint64) (func(read uint64), error) {
+	return s.ioSched.Acquire(ctx, iosched.ClassFrom(ctx), size)
+}
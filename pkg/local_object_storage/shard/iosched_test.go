@@ -0,0 +1,46 @@
+package shard_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_IOScheduler(t *testing.T) {
+	const rate = 20
+
+	sh := newCustomShard(t, t.TempDir(), false, nil, shard.WithIOScheduler(iosched.Config{
+		Classes: map[iosched.Class]iosched.Limits{iosched.Replication: {IOPS: rate}},
+	}))
+	defer releaseShard(sh, t)
+
+	obj := generateObject()
+	addr := objectCore.AddressOf(obj)
+	require.NoError(t, sh.Put(context.Background(), obj, nil))
+
+	// client operations are not limited
+	start := time.Now()
+	for range 2 * rate {
+		_, err := sh.Get(context.Background(), addr, false)
+		require.NoError(t, err)
+	}
+	require.Less(t, time.Since(start), time.Second)
+
+	ctx := iosched.WithClass(context.Background(), iosched.Replication)
+	start = time.Now()
+	for range rate + rate/2 {
+		_, err := sh.GetBytes(ctx, addr)
+		require.NoError(t, err)
+	}
+	require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err := sh.Head(cctx, addr, false)
+	require.ErrorIs(t, err, context.Canceled)
+}
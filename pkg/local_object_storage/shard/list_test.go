@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -48,7 +49,7 @@ func testShardList(t *testing.T, sh *shard.Shard) {
 
 			objs[object.AddressOf(obj).EncodeToString()] = 0
 
-			err := sh.Put(obj, nil)
+			err := sh.Put(context.Background(), obj, nil)
 			require.NoError(t, err)
 		}
 	}
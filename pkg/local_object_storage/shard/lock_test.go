@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 
@@ -53,7 +54,7 @@ func TestShard_Lock(t *testing.T) {
 
 	// put the object
 
-	err := sh.Put(obj, nil)
+	err := sh.Put(context.Background(), obj, nil)
 	require.NoError(t, err)
 
 	// lock the object
@@ -61,7 +62,7 @@ func TestShard_Lock(t *testing.T) {
 	err = sh.Lock(cnr, lockID, []oid.ID{objID})
 	require.NoError(t, err)
 
-	err = sh.Put(lock, nil)
+	err = sh.Put(context.Background(), lock, nil)
 	require.NoError(t, err)
 
 	t.Run("inhuming locked objects", func(t *testing.T) {
@@ -96,7 +97,7 @@ func TestShard_Lock(t *testing.T) {
 
 		// check that object has been removed
 
-		_, err = sh.Get(objectcore.AddressOf(obj), false)
+		_, err = sh.Get(context.Background(), objectcore.AddressOf(obj), false)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 }
@@ -113,7 +114,7 @@ func TestShard_IsLocked(t *testing.T) {
 
 	// put the object
 
-	err := sh.Put(obj, nil)
+	err := sh.Put(context.Background(), obj, nil)
 	require.NoError(t, err)
 
 	// not locked object is not locked
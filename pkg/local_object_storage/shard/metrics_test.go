@@ -1,8 +1,12 @@
 package shard_test
 
 import (
+	"context"
+	"crypto/rand"
 	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
@@ -15,19 +19,33 @@ import (
 )
 
 type metricsStore struct {
-	objectCounters map[string]uint64
-	containerSize  map[string]int64
-	payloadSize    int64
-	readOnly       bool
+	objectCounters     map[string]uint64
+	containerSize      map[string]int64
+	payloadSize        int64
+	readOnly           bool
+	compressionSaved   int64
+	compressionSkipped int64
+	gcRemoved          map[string]uint64
+	gcRuns             int
+	gcBacklog          uint64
+	gcLastRun          time.Time
+	scrubProblems      map[string]uint64
+	scrubChecked       uint64
+	scrubLastRun       time.Time
+	payloadScrubBytes  uint64
+	corruptedObjects   int
+	resyncDone         atomic.Uint64
+	resyncTotal        atomic.Uint64
+	resyncObjects      atomic.Uint64
 }
 
-func (m metricsStore) SetShardID(_ string) {}
+func (m *metricsStore) SetShardID(_ string) {}
 
-func (m metricsStore) SetObjectCounter(objectType string, v uint64) {
+func (m *metricsStore) SetObjectCounter(objectType string, v uint64) {
 	m.objectCounters[objectType] = v
 }
 
-func (m metricsStore) AddToObjectCounter(objectType string, delta int) {
+func (m *metricsStore) AddToObjectCounter(objectType string, delta int) {
 	switch {
 	case delta > 0:
 		m.objectCounters[objectType] += uint64(delta)
@@ -44,11 +62,11 @@ func (m metricsStore) AddToObjectCounter(objectType string, delta int) {
 	}
 }
 
-func (m metricsStore) IncObjectCounter(objectType string) {
+func (m *metricsStore) IncObjectCounter(objectType string) {
 	m.objectCounters[objectType] += 1
 }
 
-func (m metricsStore) DecObjectCounter(objectType string) {
+func (m *metricsStore) DecObjectCounter(objectType string) {
 	m.AddToObjectCounter(objectType, -1)
 }
 
@@ -56,7 +74,7 @@ func (m *metricsStore) SetReadonly(r bool) {
 	m.readOnly = r
 }
 
-func (m metricsStore) AddToContainerSize(cnr string, size int64) {
+func (m *metricsStore) AddToContainerSize(cnr string, size int64) {
 	m.containerSize[cnr] += size
 }
 
@@ -64,6 +82,59 @@ func (m *metricsStore) AddToPayloadSize(size int64) {
 	m.payloadSize += size
 }
 
+func (m *metricsStore) AddToCompressionSaved(size int64) {
+	m.compressionSaved += size
+}
+
+func (m *metricsStore) IncCompressionSkipped() {
+	m.compressionSkipped++
+}
+
+func (m *metricsStore) AddGCRemovedObjects(category string, count uint64) {
+	m.gcRemoved[category] += count
+}
+
+func (m *metricsStore) AddGCRunDuration(time.Duration) {
+	m.gcRuns++
+}
+
+func (m *metricsStore) SetGCBacklog(count uint64) {
+	m.gcBacklog = count
+}
+
+func (m *metricsStore) SetGCLastRun(t time.Time) {
+	m.gcLastRun = t
+}
+
+func (m *metricsStore) AddScrubProblems(kind string, count uint64) {
+	m.scrubProblems[kind] += count
+}
+
+func (m *metricsStore) AddScrubCheckedObjects(count uint64) {
+	m.scrubChecked += count
+}
+
+func (m *metricsStore) SetScrubLastRun(t time.Time) {
+	m.scrubLastRun = t
+}
+
+func (m *metricsStore) AddPayloadScrubBytes(size uint64) {
+	m.payloadScrubBytes += size
+}
+
+func (m *metricsStore) IncCorruptedObjects() {
+	m.corruptedObjects++
+}
+
+func (m *metricsStore) SetResyncProgress(done, total uint64) {
+	m.resyncDone.Store(done)
+	m.resyncTotal.Store(total)
+}
+
+func (m *metricsStore) AddResyncObjects(count uint64) {
+	m.resyncObjects.Add(count)
+}
+
 const physical = "phy"
 const logical = "logic"
 
@@ -103,7 +174,7 @@ func TestCounters(t *testing.T) {
 
 	t.Run("put", func(t *testing.T) {
 		for i := range objNumber {
-			err := sh.Put(oo[i], nil)
+			err := sh.Put(context.Background(), oo[i], nil)
 			require.NoError(t, err)
 		}
 
@@ -154,7 +225,7 @@ func TestCounters(t *testing.T) {
 
 		deletedNumber := int(phy / 4)
 
-		err := sh.Delete(addrFromObjs(oo[:deletedNumber]))
+		err := sh.Delete(context.Background(), addrFromObjs(oo[:deletedNumber]))
 		require.NoError(t, err)
 
 		require.Equal(t, phy-uint64(deletedNumber), mm.objectCounters[physical])
@@ -172,16 +243,46 @@ func TestCounters(t *testing.T) {
 	})
 }
 
-func shardWithMetrics(t *testing.T, path string) (*shard.Shard, *metricsStore) {
+func TestCompressionMetrics(t *testing.T) {
+	sh, mm := shardWithMetrics(t, t.TempDir(),
+		shard.WithCompressObjects(true),
+		shard.WithCompressionSampleSize(1024),
+	)
+
+	obj := generateObject()
+	obj.SetPayload(make([]byte, 16*1024))
+	obj.SetPayloadSize(16 * 1024)
+	require.NoError(t, sh.Put(context.Background(), obj, nil))
+	require.Positive(t, mm.compressionSaved)
+	require.Zero(t, mm.compressionSkipped)
+
+	saved := mm.compressionSaved
+	obj = generateObject()
+	payload := make([]byte, 16*1024)
+	_, _ = rand.Read(payload)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(16 * 1024)
+	require.NoError(t, sh.Put(context.Background(), obj, nil))
+	require.Equal(t, saved, mm.compressionSaved)
+	require.EqualValues(t, 1, mm.compressionSkipped)
+
+	res, err := sh.Get(context.Background(), objectcore.AddressOf(obj), false)
+	require.NoError(t, err)
+	require.Equal(t, payload, res.Payload())
+}
+
+func shardWithMetrics(t *testing.T, path string, opts ...shard.Option) (*shard.Shard, *metricsStore) {
 	mm := &metricsStore{
 		objectCounters: map[string]uint64{
 			"phy":   0,
 			"logic": 0,
 		},
 		containerSize: make(map[string]int64),
+		gcRemoved:     make(map[string]uint64),
+		scrubProblems: make(map[string]uint64),
 	}
 
-	sh := shard.New(
+	sh := shard.New(append([]shard.Option{
 		shard.WithBlobstor(fstree.New(
 			fstree.WithDirNameLen(2),
 			fstree.WithPath(filepath.Join(path, "fstree")),
@@ -191,7 +292,7 @@ func shardWithMetrics(t *testing.T, path string) (*shard.Shard, *metricsStore) {
 			meta.WithPath(filepath.Join(path, "meta")),
 			meta.WithEpochState(epochState{})),
 		shard.WithMetricsWriter(mm),
-	)
+	}, opts...)...)
 	require.NoError(t, sh.Open())
 	require.NoError(t, sh.Init())
 
@@ -15,7 +15,8 @@ var ErrReadOnlyMode = logicerr.New("shard is in read-only mode")
 // ErrDegradedMode is returned when operation requiring metabase is executed in degraded mode.
 var ErrDegradedMode = logicerr.New("shard is in degraded mode")
 
-// SetMode sets mode of the shard.
+// SetMode sets mode of the shard. If metabase resynchronization is in
+// progress, the mode is set after it.
 //
 // Returns any error encountered that did not allow
 // setting shard mode.
@@ -23,6 +24,12 @@ func (s *Shard) SetMode(m mode.Mode) error {
 	s.m.Lock()
 	defer s.m.Unlock()
 
+	if r := s.runningResync(); r != nil {
+		s.log.Info("metabase resync is in progress, mode will be set after it", zap.Stringer("mode", m))
+		r.target = m
+		return nil
+	}
+
 	return s.setMode(m)
 }
 
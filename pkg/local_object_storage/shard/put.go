@@ -1,9 +1,11 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
 )
@@ -15,7 +17,32 @@ import (
 // did not allow to completely save the object.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
-func (s *Shard) Put(obj *object.Object, objBin []byte) error {
+func (s *Shard) Put(ctx context.Context, obj *object.Object, objBin []byte) (err error) {
+	var addr = objectCore.AddressOf(obj)
+
+	ctx, span := s.startSpan(ctx, "Put", addr)
+	defer func() { tracing.End(span, err) }()
+
+	if objBin == nil {
+		objBin = obj.Marshal()
+	}
+
+	// write-cache delays PUT when filled, I/O slot must not be held meanwhile
+	tryCache := s.hasWriteCache()
+	if tryCache {
+		if err := s.writeCache.Throttle(uint64(len(objBin))); err != nil {
+			s.log.Debug("can't put object to the write-cache, trying blobstor",
+				zap.String("err", err.Error()))
+			tryCache = false
+		}
+	}
+
+	release, err := s.acquireIO(ctx, uint64(len(objBin)))
+	if err != nil {
+		return err
+	}
+	defer release(0)
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -24,18 +51,13 @@ func (s *Shard) Put(obj *object.Object, objBin []byte) error {
 		return ErrReadOnlyMode
 	}
 
-	var err error
-	if objBin == nil {
-		objBin = obj.Marshal()
-	}
-
-	var addr = objectCore.AddressOf(obj)
-
 	// exist check are not performed there, these checks should be executed
 	// ahead of `Put` by storage engine
-	tryCache := s.hasWriteCache() && !m.NoMetabase()
+	tryCache = tryCache && !m.NoMetabase()
 	if tryCache {
-		err = s.writeCache.Put(addr, obj, objBin)
+		err = traceStorage(ctx, "writecache", "Put", func() error {
+			return s.writeCache.Put(addr, obj, objBin)
+		})
 	}
 	if err != nil || !tryCache {
 		if err != nil {
@@ -43,7 +65,9 @@ func (s *Shard) Put(obj *object.Object, objBin []byte) error {
 				zap.String("err", err.Error()))
 		}
 
-		err = s.blobStor.Put(addr, objBin)
+		err = traceStorage(ctx, s.blobStor.Type(), "Put", func() error {
+			return s.blobStor.Put(addr, objBin)
+		})
 		if err != nil {
 			return fmt.Errorf("could not put object to BLOB storage: %w", err)
 		}
@@ -51,7 +75,7 @@ func (s *Shard) Put(obj *object.Object, objBin []byte) error {
 	}
 
 	if !m.NoMetabase() {
-		if err := s.metaBase.Put(obj); err != nil {
+		if err := traceStorage(ctx, "metabase", "Put", func() error { return s.metaBase.Put(obj) }); err != nil {
 			// may we need to handle this case in a special way
 			// since the object has been successfully written to BlobStor
 			return fmt.Errorf("could not put object to metabase: %w", err)
@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"testing"
 
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
@@ -23,10 +24,10 @@ func TestShard_PutBinary(t *testing.T) {
 
 	sh := newShard(t, false)
 
-	err := sh.Put(&obj, objBin)
+	err := sh.Put(context.Background(), &obj, objBin)
 	require.NoError(t, err)
 
-	res, err := sh.Get(addr, false)
+	res, err := sh.Get(context.Background(), addr, false)
 	require.NoError(t, err)
 	require.Equal(t, &obj, res)
 
@@ -37,12 +38,12 @@ func TestShard_PutBinary(t *testing.T) {
 	addr.SetObject(oidtest.ID())
 	obj.SetID(addr.Object()) // to avoid 'already exists' outcome
 	invalidObjBin := []byte("definitely not an object")
-	err = sh.Put(&obj, invalidObjBin)
+	err = sh.Put(context.Background(), &obj, invalidObjBin)
 	require.NoError(t, err)
 
 	testGetBytes(t, sh, addr, invalidObjBin)
 	require.NoError(t, err)
 
-	_, err = sh.Get(addr, false)
+	_, err = sh.Get(context.Background(), addr, false)
 	require.Error(t, err)
 }
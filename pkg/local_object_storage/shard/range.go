@@ -1,11 +1,13 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -24,12 +26,19 @@ import (
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) GetRange(addr oid.Address, offset uint64, length uint64, skipMeta bool) (*object.Object, error) {
+func (s *Shard) GetRange(ctx context.Context, addr oid.Address, offset uint64, length uint64, skipMeta bool) (obj *object.Object, err error) {
+	ctx, span := s.startSpan(ctx, "GetRange", addr)
+	defer func() { tracing.End(span, err) }()
+
+	release, err := s.acquireIO(ctx, length)
+	if err != nil {
+		return nil, err
+	}
+	defer release(0)
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
-	var obj *object.Object
-
 	cb := func(stor common.Storage) error {
 		r, err := stor.GetRange(addr, offset, length)
 		if err != nil {
@@ -67,7 +76,7 @@ func (s *Shard) GetRange(addr oid.Address, offset uint64, length uint64, skipMet
 	}
 
 	skipMeta = skipMeta || s.info.Mode.NoMetabase()
-	gotMeta, err := s.fetchObjectData(addr, skipMeta, cb, wc)
+	gotMeta, err := s.fetchObjectData(ctx, "GetRange", addr, skipMeta, cb, wc)
 	if err != nil && gotMeta {
 		err = fmt.Errorf("%w, %w", err, ErrMetaWithNoObject)
 	}
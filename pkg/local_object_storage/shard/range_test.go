@@ -2,6 +2,7 @@ package shard_test
 
 import (
 	"bytes"
+	"context"
 	"math"
 	"path/filepath"
 	"testing"
@@ -72,10 +73,10 @@ func testShardGetRange(t *testing.T, hasWriteCache bool) {
 			addr := object.AddressOf(obj)
 			payload := bytes.Clone(obj.Payload())
 
-			err := sh.Put(obj, nil)
+			err := sh.Put(context.Background(), obj, nil)
 			require.NoError(t, err)
 
-			res, err := sh.GetRange(addr, tc.rng.GetOffset(), tc.rng.GetLength(), false)
+			res, err := sh.GetRange(context.Background(), addr, tc.rng.GetOffset(), tc.rng.GetLength(), false)
 			if tc.hasErr {
 				require.ErrorAs(t, err, &apistatus.ObjectOutOfRange{})
 			} else {
@@ -0,0 +1,41 @@
+package shard
+
+import (
+	"fmt"
+)
+
+// RebalanceCursor returns listing cursor of the interrupted objects rebalance
+// saved in the metabase. Returns nil if there is no such cursor.
+func (s *Shard) RebalanceCursor() (*Cursor, error) {
+	if s.GetMode().NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	c, err := s.metaBase.ReadRebalanceCursor()
+	if err != nil {
+		return nil, fmt.Errorf("could not read rebalance cursor from metabase: %w", err)
+	}
+
+	return c, nil
+}
+
+// SetRebalanceCursor saves listing cursor of the objects rebalance in the
+// metabase, nil cursor removes it.
+func (s *Shard) SetRebalanceCursor(c *Cursor) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	m := s.info.Mode
+	if m.ReadOnly() {
+		return ErrReadOnlyMode
+	} else if m.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	err := s.metaBase.WriteRebalanceCursor(c)
+	if err != nil {
+		return fmt.Errorf("could not write rebalance cursor to metabase: %w", err)
+	}
+
+	return nil
+}
@@ -1,24 +1,26 @@
 package shard
 
 import (
-	"bytes"
-	"encoding/binary"
+	"context"
 	"errors"
+	"fmt"
 	"io"
 
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 )
 
 // ErrInvalidMagic is returned when dump format is invalid.
-var ErrInvalidMagic = logicerr.New("invalid magic")
+var ErrInvalidMagic = dump.ErrInvalidMagic
 
-// Restore restores objects from the dump prepared by Dump. If ignoreErrors
-// is set any restore errors are ignored (corrupted objects are just skipped).
+// Restore restores objects matching the filter from the dump prepared by
+// Dump, format version is detected automatically. If ignoreErrors is set any
+// restore errors are ignored (corrupted objects are just skipped).
 //
 // Returns two numbers: successful and failed restored objects, as well as any
-// error encountered.
-func (s *Shard) Restore(r io.Reader, ignoreErrors bool) (int, int, error) {
+// error encountered. Objects not matching the filter are not counted.
+func (s *Shard) Restore(r io.Reader, flt dump.Filter, ignoreErrors bool) (int, int, error) {
 	// Disallow changing mode during restore.
 	s.m.RLock()
 	defer s.m.RUnlock()
@@ -27,40 +29,37 @@ func (s *Shard) Restore(r io.Reader, ignoreErrors bool) (int, int, error) {
 		return 0, 0, ErrReadOnlyMode
 	}
 
-	var m [4]byte
-	_, _ = io.ReadFull(r, m[:])
-	if !bytes.Equal(m[:], dumpMagic) {
-		return 0, 0, ErrInvalidMagic
+	dr, err := dump.NewReader(r)
+	if err != nil {
+		return 0, 0, err
 	}
+	defer dr.Close()
 
 	var count, failCount int
-	var data []byte
-	var size [4]byte
 	for {
-		// If there are less than 4 bytes left, `Read` returns nil error instead of
-		// io.ErrUnexpectedEOF, thus `ReadFull` is used.
-		_, err := io.ReadFull(r, size[:])
+		rec, err := dr.Next()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
+			if ignoreErrors && errors.Is(err, dump.ErrChecksumMismatch) {
+				failCount++
+				continue
+			}
 			return count, failCount, err
 		}
 
-		sz := binary.LittleEndian.Uint32(size[:])
-		if uint32(cap(data)) < sz {
-			data = make([]byte, sz)
-		} else {
-			data = data[:sz]
-		}
-
-		_, err = r.Read(data)
-		if err != nil {
-			return count, failCount, err
+		if !rec.Address.Object().IsZero() && !flt.MatchContainer(rec.Address.Container()) {
+			continue
 		}
 
 		obj := object.New()
-		err = obj.Unmarshal(data)
+		err = obj.Unmarshal(rec.Data)
+		if err == nil && !rec.Address.Object().IsZero() {
+			if addr := objectCore.AddressOf(obj); addr != rec.Address {
+				err = fmt.Errorf("object address %s differs from the record one %s", addr, rec.Address)
+			}
+		}
 		if err != nil {
 			if ignoreErrors {
 				failCount++
@@ -69,7 +68,11 @@ func (s *Shard) Restore(r io.Reader, ignoreErrors bool) (int, int, error) {
 			return count, failCount, err
 		}
 
-		err = s.Put(obj, nil)
+		if !flt.Match(obj) {
+			continue
+		}
+
+		err = s.Put(context.Background(), obj, nil)
 		if err != nil && !IsErrObjectExpired(err) && !IsErrRemoved(err) {
 			return count, failCount, err
 		}
@@ -0,0 +1,314 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultResyncWorkers is the default number of storage partitions
+// resynchronized to the metabase in parallel.
+const defaultResyncWorkers = 4
+
+// ResyncInfo describes the progress of the metabase resynchronization.
+type ResyncInfo struct {
+	// InProgress is set if the shard is being resynchronized. Shard is in
+	// [mode.DegradedReadOnly] until it is finished.
+	InProgress bool
+	// DonePartitions is the number of storage partitions already
+	// resynchronized including the ones done before restart.
+	DonePartitions uint64
+	// TotalPartitions is the total number of storage partitions.
+	TotalPartitions uint64
+	// Objects is the number of objects resynchronized since the shard start.
+	Objects uint64
+}
+
+// resync is a metabase resynchronization going on in the background.
+type resync struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// target is the shard mode to set after resynchronization, protected
+	// by the shard mutex.
+	target mode.Mode
+
+	inProgress           atomic.Bool
+	done, total, objects atomic.Uint64
+}
+
+// runningResync returns the resynchronization in progress if any.
+func (s *Shard) runningResync() *resync {
+	if r := s.resync.Load(); r != nil && r.inProgress.Load() {
+		return r
+	}
+	return nil
+}
+
+func (r *resync) info() ResyncInfo {
+	return ResyncInfo{
+		InProgress:      r.inProgress.Load(),
+		DonePartitions:  r.done.Load(),
+		TotalPartitions: r.total.Load(),
+		Objects:         r.objects.Load(),
+	}
+}
+
+// needResync checks whether the metabase must be resynchronized and resets it
+// if the resynchronization is not resumed. outdated is set if the metabase
+// version is not supported. Interrupted resynchronization is resumed even if
+// it is requested by the configuration, so the progress is not lost on
+// restart.
+func (s *Shard) needResync(outdated bool) (bool, error) {
+	var reason string
+	if outdated {
+		reason = "outdated metabase version"
+	} else {
+		st, err := s.metaBase.ResyncState()
+		if err != nil {
+			return false, fmt.Errorf("read resync state: %w", err)
+		}
+		if st.InProgress {
+			s.log.Info("resuming interrupted metabase resync", zap.Int("done partitions", len(st.DonePartitions)))
+			return true, nil
+		}
+
+		if s.cfg.resyncMetabase {
+			reason = "requested by configuration"
+		} else {
+			lost, err := s.metabaseLost()
+			if err != nil {
+				return false, err
+			}
+			if !lost {
+				return false, nil
+			}
+			reason = "metabase is empty while blobstor is not"
+		}
+	}
+
+	s.log.Info("starting metabase resync", zap.String("reason", reason))
+
+	err := s.metaBase.StartResync()
+	if err != nil {
+		return false, fmt.Errorf("could not reset metabase: %w", err)
+	}
+
+	return true, nil
+}
+
+// metabaseLost checks whether metabase has no objects while blobstor has
+// some, which means the metabase was lost.
+func (s *Shard) metabaseLost() (bool, error) {
+	c, err := s.metaBase.ObjectCounters()
+	if err != nil {
+		return false, fmt.Errorf("read object counters: %w", err)
+	}
+	if c.Phy() != 0 {
+		return false, nil
+	}
+
+	var errFound = errors.New("found")
+	err = s.blobStor.IterateAddresses(func(oid.Address) error { return errFound }, true)
+	if errors.Is(err, errFound) {
+		return true, nil
+	}
+	return false, err
+}
+
+// startResync resynchronizes the metabase in the background. Shard is in
+// [mode.DegradedReadOnly] while it is running, so reads are served from the
+// blobstor and writes are denied. Current mode is set after resynchronization.
+func (s *Shard) startResync() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s.m.Lock()
+	r := &resync{cancel: cancel, target: s.info.Mode}
+	r.inProgress.Store(true)
+	r.wg.Add(1)
+	s.resync.Store(r)
+	// components stay in the current mode, metabase is written by the resync
+	s.info.Mode = mode.DegradedReadOnly
+	if s.metricsWriter != nil {
+		s.metricsWriter.SetReadonly(true)
+	}
+	s.m.Unlock()
+
+	go func() {
+		defer r.wg.Done()
+
+		err := s.runResync(ctx, r)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				s.log.Info("metabase resync interrupted, it will be resumed on the next start",
+					zap.Uint64("done partitions", r.done.Load()), zap.Uint64("total partitions", r.total.Load()))
+				return
+			}
+			s.log.Error("metabase resync failed, shard stays in degraded mode, it will be resumed on the next start",
+				zap.Error(err))
+		}
+
+		s.m.Lock()
+		r.inProgress.Store(false)
+		s.info.Mode = mode.ReadWrite
+		if err != nil {
+			err = s.setMode(mode.DegradedReadOnly)
+		} else {
+			err = s.setMode(r.target)
+		}
+		s.m.Unlock()
+		if err != nil {
+			s.log.Error("could not set shard mode after metabase resync", zap.Error(err))
+		}
+
+		s.reportResync(0, 0)
+		s.initMetrics()
+		s.startEncryptionRotation()
+	}()
+}
+
+func (s *Shard) stopResync() {
+	r := s.resync.Load()
+	if r == nil {
+		return
+	}
+
+	r.cancel()
+	r.wg.Wait()
+	s.resync.Store(nil)
+}
+
+// resyncMetabase resets the metabase and synchronously fills it with the
+// objects from the blobstor.
+func (s *Shard) resyncMetabase() error {
+	err := s.metaBase.StartResync()
+	if err != nil {
+		return fmt.Errorf("could not reset metabase: %w", err)
+	}
+
+	return s.runResync(context.Background(), new(resync))
+}
+
+// runResync puts objects from the storage partitions not resynchronized yet
+// to the metabase. Partitions are processed in parallel, each one is marked in
+// the metabase when done, so the interrupted resynchronization can be resumed.
+func (s *Shard) runResync(ctx context.Context, r *resync) error {
+	if s.hasWriteCache() {
+		// ensure there will not be any raсes in write-cache -> blobstor object
+		// background flushing while iterating blobstor
+		err := s.writeCache.Flush(true)
+		if err != nil {
+			s.log.Warn("could not flush write-cache while resyncing metabase", zap.Error(err))
+		}
+	}
+
+	iterate := func(_ string, objHandler func(oid.Address, []byte) error, errHandler func(oid.Address, error) error) error {
+		return s.blobStor.Iterate(objHandler, errHandler)
+	}
+	partitions := []string{""}
+	if p, ok := s.blobStor.(common.Partitioned); ok {
+		var err error
+		partitions, err = p.Partitions()
+		if err != nil {
+			return fmt.Errorf("could not list storage partitions: %w", err)
+		}
+		iterate = p.IteratePartition
+	}
+
+	st, err := s.metaBase.ResyncState()
+	if err != nil {
+		return fmt.Errorf("could not read resync state: %w", err)
+	}
+	done := make(map[string]struct{}, len(st.DonePartitions))
+	for _, p := range st.DonePartitions {
+		done[p] = struct{}{}
+	}
+
+	r.total.Store(uint64(len(partitions)))
+	for _, p := range partitions {
+		if _, ok := done[p]; ok {
+			r.done.Add(1)
+		}
+	}
+	s.reportResync(r.done.Load(), r.total.Load())
+
+	var errorHandler = func(addr oid.Address, err error) error {
+		s.log.Warn("error occurred during the iteration",
+			zap.Stringer("address", addr),
+			zap.String("err", err.Error()))
+		return nil
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(s.resyncWorkers)
+	for _, p := range partitions {
+		if _, ok := done[p]; ok {
+			continue
+		}
+
+		g.Go(func() error {
+			err := iterate(p, func(addr oid.Address, data []byte) error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				release, err := s.ioSched.Acquire(ctx, iosched.Scrub, 0)
+				if err != nil {
+					return err
+				}
+				err = s.resyncObjectHandler(addr, data)
+				release(uint64(len(data)))
+
+				r.objects.Add(1)
+				if s.metricsWriter != nil {
+					s.metricsWriter.AddResyncObjects(1)
+				}
+
+				return err
+			}, errorHandler)
+			if err != nil {
+				return fmt.Errorf("could not put objects to the meta from %q blobstor partition: %w", p, err)
+			}
+
+			err = s.metaBase.MarkResyncPartitionDone(p)
+			if err != nil {
+				return fmt.Errorf("could not save resync progress: %w", err)
+			}
+
+			s.reportResync(r.done.Add(1), r.total.Load())
+			return nil
+		})
+	}
+
+	err = g.Wait()
+	if err != nil {
+		return err
+	}
+
+	err = s.metaBase.SyncCounters()
+	if err != nil {
+		return fmt.Errorf("could not sync object counters: %w", err)
+	}
+
+	err = s.metaBase.FinishResync()
+	if err != nil {
+		return fmt.Errorf("could not finish resync: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Shard) reportResync(done, total uint64) {
+	if s.metricsWriter != nil {
+		s.metricsWriter.SetResyncProgress(done, total)
+	}
+}
@@ -0,0 +1,160 @@
+package shard
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingStorage is a [common.Storage] blocking iteration until released.
+type blockingStorage struct {
+	common.Storage
+	release chan struct{}
+}
+
+func (x *blockingStorage) Iterate(objHandler func(oid.Address, []byte) error, errHandler func(oid.Address, error) error) error {
+	<-x.release
+	return x.Storage.Iterate(objHandler, errHandler)
+}
+
+func putResyncObjects(t *testing.T, fsTree *fstree.FSTree, dir string, n int) []oid.Address {
+	sh := New(
+		WithBlobstor(fsTree),
+		WithMetaBaseOptions(meta.WithPath(filepath.Join(dir, "meta")), meta.WithEpochState(epochState{})))
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+
+	addrs := make([]oid.Address, n)
+	for i := range addrs {
+		obj := objecttest.Object()
+		obj.SetType(objectSDK.TypeRegular)
+		obj.SetPayload([]byte{byte(i)})
+		require.NoError(t, sh.Put(context.Background(), &obj, nil))
+		addrs[i] = object.AddressOf(&obj)
+	}
+	require.NoError(t, sh.Close())
+
+	return addrs
+}
+
+func TestShard_BackgroundResync(t *testing.T) {
+	dir := t.TempDir()
+	fsTree := fstree.New(
+		fstree.WithDirNameLen(1),
+		fstree.WithPath(filepath.Join(dir, "fstree")),
+		fstree.WithDepth(1))
+
+	addrs := putResyncObjects(t, fsTree, dir, 5)
+
+	bs := &blockingStorage{Storage: fsTree, release: make(chan struct{})}
+	// metabase is lost
+	sh := New(
+		WithBlobstor(bs),
+		WithMetaBaseOptions(meta.WithPath(filepath.Join(dir, "meta_new")), meta.WithEpochState(epochState{})))
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { _ = sh.Close() })
+
+	require.Equal(t, mode.DegradedReadOnly, sh.GetMode())
+	require.True(t, sh.DumpInfo().Resync.InProgress)
+
+	for _, addr := range addrs {
+		_, err := sh.Get(context.Background(), addr, false)
+		require.NoError(t, err)
+	}
+
+	obj := objecttest.Object()
+	require.Error(t, sh.Put(context.Background(), &obj, nil))
+
+	// mode is set after resync
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+	require.Equal(t, mode.DegradedReadOnly, sh.GetMode())
+
+	close(bs.release)
+	waitResync(t, sh)
+
+	require.Equal(t, mode.ReadOnly, sh.GetMode())
+	info := sh.DumpInfo().Resync
+	require.EqualValues(t, len(addrs), info.Objects)
+	require.EqualValues(t, 1, info.DonePartitions)
+	require.EqualValues(t, 1, info.TotalPartitions)
+
+	for _, addr := range addrs {
+		ok, err := sh.metaBase.HasPhysicalRecord(addr)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	st, err := sh.metaBase.ResyncState()
+	require.NoError(t, err)
+	require.False(t, st.InProgress)
+}
+
+func TestShard_ResumeResync(t *testing.T) {
+	t.Run("default", func(t *testing.T) { testResumeResync(t, false) })
+	// resync requested by the configuration does not reset the progress
+	t.Run("configured", func(t *testing.T) { testResumeResync(t, true) })
+}
+
+func testResumeResync(t *testing.T, configured bool) {
+	dir := t.TempDir()
+	fsTree := fstree.New(
+		fstree.WithDirNameLen(1),
+		fstree.WithPath(filepath.Join(dir, "fstree")),
+		fstree.WithDepth(1))
+
+	addrs := putResyncObjects(t, fsTree, dir, 20)
+
+	// interrupted resync with a single partition done
+	metaPath := filepath.Join(dir, "meta_new")
+	db := meta.New(meta.WithPath(metaPath), meta.WithEpochState(epochState{}))
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+	require.NoError(t, db.StartResync())
+
+	partitions, err := fsTree.Partitions()
+	require.NoError(t, err)
+	require.Greater(t, len(partitions), 1)
+	done := partitions[0]
+	require.NoError(t, db.MarkResyncPartitionDone(done))
+	require.NoError(t, db.Close())
+
+	sh := New(
+		WithBlobstor(fsTree),
+		WithMetaBaseOptions(meta.WithPath(metaPath), meta.WithEpochState(epochState{})),
+		WithResyncMetabaseWorkers(3),
+		WithResyncMetabase(configured))
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { _ = sh.Close() })
+
+	waitResync(t, sh)
+	require.Equal(t, mode.ReadWrite, sh.GetMode())
+
+	info := sh.DumpInfo().Resync
+	require.EqualValues(t, len(partitions), info.DonePartitions)
+	require.EqualValues(t, len(partitions), info.TotalPartitions)
+
+	var skipped uint64
+	for _, addr := range addrs {
+		ok, err := sh.metaBase.HasPhysicalRecord(addr)
+		require.NoError(t, err)
+		// objects of the done partition are not resynced again
+		inDone := addr.Object().EncodeToString()[:1] == done
+		require.Equal(t, !inDone, ok, addr)
+		if inDone {
+			skipped++
+		}
+	}
+	require.EqualValues(t, uint64(len(addrs))-skipped, info.Objects)
+}
@@ -0,0 +1,411 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// scrubBatchSize is a number of metabase records checked at once.
+const scrubBatchSize = 1000
+
+// Kinds of the problems found by the metabase scrub passed to [MetricsWriter].
+const (
+	ScrubProblemMissingBlob        = "missing_blob"
+	ScrubProblemMissingRecord      = "missing_record"
+	ScrubProblemWrongCounters      = "wrong_counters"
+	ScrubProblemWrongContainerSize = "wrong_container_size"
+)
+
+// ScrubStats describes results of a single metabase scrub.
+type ScrubStats struct {
+	// CheckedObjects is the number of available objects checked for presence
+	// in the blobstor or write-cache.
+	CheckedObjects uint64
+	// CheckedBlobs is the number of blobs checked for presence in the
+	// metabase.
+	CheckedBlobs uint64
+	// MissingBlobs is the number of metabase records of the objects missing
+	// in the blobstor and write-cache. Such records are dropped.
+	MissingBlobs uint64
+	// MissingRecords is the number of blobs without metabase records. Such
+	// objects are resynced to the metabase, blobs of the removed objects are
+	// deleted.
+	MissingRecords uint64
+	// WrongCounters is set if the metabase object counters were wrong. They
+	// are recalculated.
+	WrongCounters bool
+	// WrongContainerSizes is the number of wrong container size estimations.
+	// They are recalculated.
+	WrongContainerSizes uint64
+	// Duration is the time spent on the scrub.
+	Duration time.Duration
+}
+
+// scrubber is a background routine running metabase scrub periodically.
+type scrubber struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (s *Shard) startScrubber() {
+	if s.scrubInterval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sc := &scrubber{cancel: cancel}
+	sc.wg.Add(1)
+	s.scrubber = sc
+
+	go func() {
+		defer sc.wg.Done()
+
+		t := time.NewTicker(s.scrubInterval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+
+			if s.GetMode() != mode.ReadWrite {
+				continue
+			}
+
+			res, err := s.Scrub(ctx)
+			if err != nil {
+				if !errors.Is(err, context.Canceled) {
+					s.log.Warn("metabase scrub failed", zap.Error(err))
+				}
+				continue
+			}
+
+			s.log.Info("metabase scrub finished",
+				zap.Uint64("checked objects", res.CheckedObjects),
+				zap.Uint64("checked blobs", res.CheckedBlobs),
+				zap.Uint64("missing blobs", res.MissingBlobs),
+				zap.Uint64("missing records", res.MissingRecords),
+				zap.Bool("wrong counters", res.WrongCounters),
+				zap.Uint64("wrong container sizes", res.WrongContainerSizes),
+				zap.Duration("duration", res.Duration))
+		}
+	}()
+}
+
+func (s *Shard) stopScrubber() {
+	if s.scrubber == nil {
+		return
+	}
+
+	s.scrubber.cancel()
+	s.scrubber.wg.Wait()
+	s.scrubber = nil
+}
+
+// Scrub checks that the metabase matches the blobstor and write-cache
+// contents and repairs it: records of the objects missing in both storages are
+// dropped, objects stored without records are resynced to the metabase, and
+// object counters and container size estimations are recalculated if they are
+// wrong. Returns [ErrReadOnlyMode] or [ErrDegradedMode] if shard is not in
+// read-write mode.
+func (s *Shard) Scrub(ctx context.Context) (ScrubStats, error) {
+	var (
+		res   ScrubStats
+		start = time.Now()
+	)
+
+	switch m := s.GetMode(); {
+	case m.NoMetabase():
+		return res, ErrDegradedMode
+	case m != mode.ReadWrite:
+		return res, ErrReadOnlyMode
+	}
+
+	err := s.scrubRecords(ctx, &res)
+	if err == nil {
+		err = s.scrubBlobs(ctx, &res)
+	}
+	if err == nil {
+		err = s.scrubCounters(&res)
+	}
+
+	res.Duration = time.Since(start)
+	s.reportScrub(res)
+
+	return res, err
+}
+
+// scrubRecords drops metabase records of the available objects stored
+// neither in the blobstor nor in the write-cache.
+func (s *Shard) scrubRecords(ctx context.Context, res *ScrubStats) error {
+	var cursor *meta.Cursor
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		addrs, c, err := s.metaBase.ListWithCursor(scrubBatchSize, cursor)
+		if err != nil {
+			if errors.Is(err, meta.ErrEndOfListing) {
+				return nil
+			}
+			return fmt.Errorf("list objects: %w", err)
+		}
+		cursor = c
+
+		for i := range addrs {
+			res.CheckedObjects++
+
+			release, err := s.ioSched.Acquire(ctx, iosched.Scrub, 0)
+			if err != nil {
+				return err
+			}
+			ok := s.hasBlob(addrs[i].Address)
+			release(0)
+			if ok {
+				continue
+			}
+
+			dropped, err := s.dropMissingBlob(ctx, addrs[i].Address)
+			if err != nil {
+				return fmt.Errorf("drop %s object record: %w", addrs[i].Address, err)
+			}
+			if dropped {
+				res.MissingBlobs++
+			}
+		}
+	}
+}
+
+// hasBlob checks whether the object is stored in the blobstor or write-cache.
+// Storage errors are treated as the object presence.
+func (s *Shard) hasBlob(addr oid.Address) bool {
+	if ok, err := s.blobStor.Exists(addr); err != nil || ok {
+		return true
+	}
+
+	if s.hasWriteCache() {
+		if _, err := s.writeCache.Head(addr); err == nil {
+			return true
+		}
+	}
+
+	// object could be flushed after the first check
+	ok, err := s.blobStor.Exists(addr)
+	return err != nil || ok
+}
+
+// dropMissingBlob drops metabase record of the object if it is still missing
+// in the blobstor and write-cache. Shard is locked for the check, so the
+// object can't be put or removed concurrently. I/O slot is acquired before the
+// lock, so other operations don't wait for the scheduler.
+func (s *Shard) dropMissingBlob(ctx context.Context, addr oid.Address) (bool, error) {
+	release, err := s.ioSched.Acquire(ctx, iosched.Scrub, 0)
+	if err != nil {
+		return false, err
+	}
+	defer release(0)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if err := s.checkScrubMode(); err != nil {
+		return false, err
+	}
+
+	ok, err := s.metaBase.HasPhysicalRecord(addr)
+	if err != nil || !ok || s.hasBlob(addr) {
+		return false, err
+	}
+
+	s.log.Warn("object is missing in the blobstor, dropping its metabase record", zap.Stringer("address", addr))
+
+	return true, s.deleteObjs(ctx, []oid.Address{addr}, true, true)
+}
+
+// scrubBlobs resyncs objects stored in the blobstor without metabase records.
+func (s *Shard) scrubBlobs(ctx context.Context, res *ScrubStats) error {
+	return s.blobStor.IterateAddresses(func(addr oid.Address) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		res.CheckedBlobs++
+
+		release, err := s.ioSched.Acquire(ctx, iosched.Scrub, 0)
+		if err != nil {
+			return err
+		}
+		ok, err := s.metaBase.HasPhysicalRecord(addr)
+		release(0)
+		if err != nil {
+			return fmt.Errorf("check %s object record: %w", addr, err)
+		}
+		if ok {
+			return nil
+		}
+
+		resynced, err := s.resyncMissingRecord(ctx, addr)
+		if err != nil {
+			return fmt.Errorf("resync %s object: %w", addr, err)
+		}
+		if resynced {
+			res.MissingRecords++
+		}
+		return nil
+	}, true)
+}
+
+// resyncMissingRecord puts the object stored in the blobstor to the metabase
+// if it still has no record there. The object removed according to the
+// metabase is deleted from the blobstor instead. Shard is locked for the
+// check, so the object can't be put or removed concurrently. I/O slot is
+// acquired before the lock, so other operations don't wait for the scheduler.
+func (s *Shard) resyncMissingRecord(ctx context.Context, addr oid.Address) (bool, error) {
+	release, err := s.ioSched.Acquire(ctx, iosched.Scrub, 0)
+	if err != nil {
+		return false, err
+	}
+
+	var read uint64
+	defer func() { release(read) }()
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if err := s.checkScrubMode(); err != nil {
+		return false, err
+	}
+
+	ok, err := s.metaBase.HasPhysicalRecord(addr)
+	if err != nil || ok {
+		return false, err
+	}
+
+	data, err := s.blobStor.GetBytes(addr)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return false, nil
+		}
+		s.log.Warn("could not read object without metabase record", zap.Stringer("address", addr), zap.Error(err))
+		return false, nil
+	}
+	read = uint64(len(data))
+
+	s.log.Warn("object has no metabase record, resyncing it", zap.Stringer("address", addr))
+
+	err = s.resyncObjectHandler(addr, data)
+	if err != nil {
+		return true, err
+	}
+
+	ok, err = s.metaBase.HasPhysicalRecord(addr)
+	if err != nil {
+		return true, err
+	}
+	if ok {
+		hdr, err := s.metaBase.Get(addr, true)
+		if err == nil {
+			s.incObjectCounter()
+			s.addToContainerSize(addr.Container().EncodeToString(), int64(hdr.PayloadSize()))
+		}
+		return true, nil
+	}
+
+	if _, err = s.metaBase.Exists(addr, true); meta.IsErrRemoved(err) {
+		err = s.blobStor.Delete(addr)
+		if err != nil && !IsErrNotFound(err) {
+			return true, fmt.Errorf("delete removed object: %w", err)
+		}
+		logOp(s.log, deleteOp, addr)
+	}
+
+	return true, nil
+}
+
+// scrubCounters recalculates wrong metabase object counters and container
+// size estimations.
+func (s *Shard) scrubCounters(res *ScrubStats) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.checkScrubMode(); err != nil {
+		return err
+	}
+
+	c, err := s.metaBase.CheckCounters(true)
+	if err != nil {
+		return fmt.Errorf("check counters: %w", err)
+	}
+	if c.Consistent() {
+		return nil
+	}
+
+	if c.Stored != c.Actual {
+		res.WrongCounters = true
+		s.log.Warn("wrong metabase object counters, recalculating them",
+			zap.Uint64("stored phy", c.Stored.Phy()), zap.Uint64("actual phy", c.Actual.Phy()),
+			zap.Uint64("stored logic", c.Stored.Logic()), zap.Uint64("actual logic", c.Actual.Logic()))
+
+		if s.cfg.metricsWriter != nil {
+			s.cfg.metricsWriter.SetObjectCounter(physical, c.Actual.Phy())
+			s.cfg.metricsWriter.SetObjectCounter(logical, c.Actual.Logic())
+		}
+	}
+
+	res.WrongContainerSizes = uint64(len(c.ContainerSizes))
+	for _, m := range c.ContainerSizes {
+		s.log.Warn("wrong container size estimation, recalculating it",
+			zap.Stringer("container", m.Container), zap.Uint64("stored", m.Stored), zap.Uint64("actual", m.Actual))
+
+		delta := int64(m.Actual) - int64(m.Stored)
+		s.addToContainerSize(m.Container.EncodeToString(), delta)
+		s.addToPayloadCounter(delta)
+	}
+
+	return nil
+}
+
+func (s *Shard) checkScrubMode() error {
+	switch m := s.info.Mode; {
+	case m.NoMetabase():
+		return ErrDegradedMode
+	case m != mode.ReadWrite:
+		return ErrReadOnlyMode
+	}
+	return nil
+}
+
+// reportScrub updates scrub metrics.
+func (s *Shard) reportScrub(res ScrubStats) {
+	if s.cfg.metricsWriter == nil {
+		return
+	}
+
+	s.cfg.metricsWriter.AddScrubCheckedObjects(res.CheckedObjects + res.CheckedBlobs)
+	s.addScrubProblems(ScrubProblemMissingBlob, res.MissingBlobs)
+	s.addScrubProblems(ScrubProblemMissingRecord, res.MissingRecords)
+	if res.WrongCounters {
+		s.addScrubProblems(ScrubProblemWrongCounters, 1)
+	}
+	s.addScrubProblems(ScrubProblemWrongContainerSize, res.WrongContainerSizes)
+	s.cfg.metricsWriter.SetScrubLastRun(time.Now())
+}
+
+func (s *Shard) addScrubProblems(kind string, n uint64) {
+	if n > 0 {
+		s.cfg.metricsWriter.AddScrubProblems(kind, n)
+	}
+}
@@ -0,0 +1,79 @@
+package shard_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_Scrub(t *testing.T) {
+	dir := t.TempDir()
+	sh, mm := shardWithMetrics(t, dir)
+
+	kept := generateObject()
+	require.NoError(t, sh.Put(context.Background(), kept, nil))
+
+	lost := generateObject()
+	require.NoError(t, sh.Put(context.Background(), lost, nil))
+
+	// modify the blobstor bypassing the shard
+	fsTree := fstree.New(
+		fstree.WithDirNameLen(2),
+		fstree.WithPath(filepath.Join(dir, "fstree")),
+		fstree.WithDepth(1))
+	require.NoError(t, fsTree.Open(false))
+	require.NoError(t, fsTree.Init())
+	t.Cleanup(func() { _ = fsTree.Close() })
+
+	require.NoError(t, fsTree.Delete(objectCore.AddressOf(lost)))
+
+	unknown := generateObject()
+	require.NoError(t, fsTree.Put(objectCore.AddressOf(unknown), unknown.Marshal()))
+
+	_, err := sh.Get(context.Background(), objectCore.AddressOf(unknown), false)
+	require.True(t, shard.IsErrNotFound(err))
+
+	res, err := sh.Scrub(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 2, res.CheckedObjects)
+	require.EqualValues(t, 2, res.CheckedBlobs)
+	require.EqualValues(t, 1, res.MissingBlobs)
+	require.EqualValues(t, 1, res.MissingRecords)
+	require.False(t, res.WrongCounters)
+	require.Zero(t, res.WrongContainerSizes)
+
+	exists, err := sh.Exists(objectCore.AddressOf(lost), false)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	got, err := sh.Get(context.Background(), objectCore.AddressOf(unknown), false)
+	require.NoError(t, err)
+	require.Equal(t, unknown, got)
+
+	_, err = sh.Get(context.Background(), objectCore.AddressOf(kept), false)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 2, mm.objectCounters[physical])
+	require.EqualValues(t, 2, mm.objectCounters[logical])
+	require.EqualValues(t, 1, mm.scrubProblems[shard.ScrubProblemMissingBlob])
+	require.EqualValues(t, 1, mm.scrubProblems[shard.ScrubProblemMissingRecord])
+	require.EqualValues(t, 4, mm.scrubChecked)
+	require.False(t, mm.scrubLastRun.IsZero())
+
+	res, err = sh.Scrub(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, res.MissingBlobs)
+	require.Zero(t, res.MissingRecords)
+
+	t.Run("read-only", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(mode.ReadOnly))
+		_, err := sh.Scrub(context.Background())
+		require.ErrorIs(t, err, shard.ErrReadOnlyMode)
+	})
+}
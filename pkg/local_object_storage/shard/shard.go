@@ -2,14 +2,18 @@ package shard
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
@@ -23,6 +27,16 @@ type Shard struct {
 	writeCache writecache.Cache
 
 	metaBase *meta.DB
+
+	rotation *encryptionRotation
+
+	scrubber *scrubber
+
+	payloadScrubber *payloadScrubber
+
+	resync atomic.Pointer[resync]
+
+	ioSched *iosched.Scheduler
 }
 
 // Option represents Shard's constructor option.
@@ -62,12 +76,57 @@ type MetricsWriter interface {
 	SetShardID(id string)
 	// SetReadonly must set shard readonly state.
 	SetReadonly(readonly bool)
+	// AddToCompressionSaved must add the number of bytes saved by compression.
+	AddToCompressionSaved(size int64)
+	// IncCompressionSkipped must increment the number of objects stored
+	// uncompressed because compression is useless for them.
+	IncCompressionSkipped()
+	// AddGCRemovedObjects must add the number of objects removed by GC in
+	// the given category.
+	AddGCRemovedObjects(category string, count uint64)
+	// AddGCRunDuration must register duration of the GC run.
+	AddGCRunDuration(d time.Duration)
+	// SetGCBacklog must set the number of objects waiting to be removed by GC.
+	// It is counted on explicit GC runs and reset when periodic GC removes all
+	// the garbage.
+	SetGCBacklog(count uint64)
+	// SetGCLastRun must set time of the last GC run.
+	SetGCLastRun(t time.Time)
+	// AddScrubProblems must add the number of problems of the given kind
+	// found and repaired by the metabase scrub.
+	AddScrubProblems(kind string, count uint64)
+	// AddScrubCheckedObjects must add the number of objects checked by the
+	// metabase scrub.
+	AddScrubCheckedObjects(count uint64)
+	// SetScrubLastRun must set time of the last metabase scrub.
+	SetScrubLastRun(t time.Time)
+	// AddPayloadScrubBytes must add the number of bytes read by the payload
+	// scrub.
+	AddPayloadScrubBytes(size uint64)
+	// IncCorruptedObjects must increment the number of corrupted objects
+	// found by the payload scrub.
+	IncCorruptedObjects()
+	// SetResyncProgress must set the number of storage partitions already
+	// resynchronized to the metabase and their total number. Both are zero
+	// if there is no resynchronization in progress.
+	SetResyncProgress(done, total uint64)
+	// AddResyncObjects must add the number of objects resynchronized to the
+	// metabase.
+	AddResyncObjects(count uint64)
 }
 
+// compressionMetrics passes compression statistics to [MetricsWriter].
+type compressionMetrics struct{ mw MetricsWriter }
+
+func (m compressionMetrics) AddSavedBytes(n int) { m.mw.AddToCompressionSaved(int64(n)) }
+
+func (m compressionMetrics) IncSkipped() { m.mw.IncCompressionSkipped() }
+
 type cfg struct {
 	m sync.RWMutex
 
 	resyncMetabase bool
+	resyncWorkers  int
 
 	rmBatchSize int
 
@@ -91,8 +150,16 @@ type cfg struct {
 
 	metricsWriter MetricsWriter
 
+	scrubInterval time.Duration
+
+	payloadScrubRate        uint64
+	quarantineDir           string
+	corruptedObjectCallback CorruptedObjectCallback
+
 	reportErrorFunc func(selfID string, message string, err error)
 
+	ioSchedCfg iosched.Config
+
 	compression   compression.Config
 	blobStor      common.Storage
 	initedStorage bool
@@ -101,6 +168,7 @@ type cfg struct {
 func defaultCfg() *cfg {
 	return &cfg{
 		rmBatchSize:     100,
+		resyncWorkers:   defaultResyncWorkers,
 		log:             zap.L(),
 		gcCfg:           defaultGCCfg(),
 		reportErrorFunc: func(string, string, error) {},
@@ -115,6 +183,9 @@ func New(opts ...Option) *Shard {
 		opts[i](c)
 	}
 
+	if c.metricsWriter != nil {
+		c.compression.Metrics = compressionMetrics{c.metricsWriter}
+	}
 	c.blobStor.SetCompressor(&c.compression)
 	mb := meta.New(c.metaOpts...)
 
@@ -123,6 +194,10 @@ func New(opts ...Option) *Shard {
 		metaBase: mb,
 	}
 
+	if c.ioSchedCfg.Enabled() {
+		s.ioSched = iosched.New(c.ioSchedCfg)
+	}
+
 	reportFunc := func(msg string, err error) {
 		s.reportErrorFunc(s.ID().String(), msg, err)
 	}
@@ -131,7 +206,9 @@ func New(opts ...Option) *Shard {
 		s.writeCache = writecache.New(
 			append(c.writeCacheOpts,
 				writecache.WithReportErrorFunc(reportFunc),
-				writecache.WithStorage(s.blobStor))...)
+				writecache.WithStorage(s.blobStor),
+				writecache.WithIOScheduler(s.ioSched),
+				writecache.WithEncryption(c.compression.Encryption))...)
 	}
 
 	s.fillInfo()
@@ -156,7 +233,8 @@ func WithBlobstor(s common.Storage) Option {
 // WithCompressObjects returns option to toggle
 // compression of the stored objects.
 //
-// If true, Zstandard algorithm is used for data compression.
+// If true, the codec set by [WithCompressionCodec] (Zstandard by default) or
+// selected for the object container is used for data compression.
 //
 // If compressor (decompressor) creation failed,
 // the uncompressed option will be used, and the error
@@ -175,6 +253,61 @@ func WithUncompressableContentTypes(values []string) Option {
 	}
 }
 
+// WithCompressionCodec returns option to set name of the codec used to
+// compress objects by default. See [compression.Codecs] for supported ones.
+func WithCompressionCodec(codec string) Option {
+	return func(c *cfg) {
+		c.compression.Codec = codec
+	}
+}
+
+// WithContainerCompressionCodecs returns option to override compression
+// codec for objects of particular containers. Overrides have precedence over
+// [WithCompressionPolicy].
+func WithContainerCompressionCodecs(codecs map[cid.ID]string) Option {
+	return func(c *cfg) {
+		c.compression.ContainerCodecs = codecs
+	}
+}
+
+// WithCompressionSampleSize returns option to set size of the object data
+// tail compressed first to check whether the whole object is worth
+// compressing. Zero disables sampling.
+func WithCompressionSampleSize(size int) Option {
+	return func(c *cfg) {
+		c.compression.SampleSize = size
+	}
+}
+
+// WithCompressionMinSavingRatio returns option to set minimum share of
+// the object size compression must save, otherwise the object is stored
+// uncompressed.
+func WithCompressionMinSavingRatio(ratio float64) Option {
+	return func(c *cfg) {
+		c.compression.MinSavingRatio = ratio
+	}
+}
+
+// WithCompressionPolicy returns option to select compression codec for
+// objects of the container, e.g. from its attributes. The policy returns
+// empty string if there is no preference.
+func WithCompressionPolicy(policy func(cid.ID) string) Option {
+	return func(c *cfg) {
+		c.compression.ContainerPolicy = policy
+	}
+}
+
+// WithEncryption returns option to encrypt objects stored in the blobstor
+// and the write-cache. Objects encrypted with another key or stored
+// unencrypted are re-encrypted with the active key in background after
+// the shard is initialized, so old keys must stay in the cipher until it's
+// finished and all cached objects are flushed.
+func WithEncryption(c *encryption.Cipher) Option {
+	return func(cfg *cfg) {
+		cfg.compression.Encryption = c
+	}
+}
+
 // WithMetaBaseOptions returns option to set internal metabase options.
 func WithMetaBaseOptions(opts ...meta.Option) Option {
 	return func(c *cfg) {
@@ -209,11 +342,6 @@ func (s *Shard) hasWriteCache() bool {
 	return s.cfg.useWriteCache
 }
 
-// needResyncMetabase returns true if metabase is needed to be refilled.
-func (s *Shard) needResyncMetabase() bool {
-	return s.cfg.resyncMetabase
-}
-
 // WithRemoverBatchSize returns option to set batch size
 // of single removal operation.
 func WithRemoverBatchSize(sz int) Option {
@@ -261,6 +389,26 @@ func WithResyncMetabase(v bool) Option {
 	}
 }
 
+// WithResyncMetabaseWorkers returns option to set the number of storage
+// partitions resynchronized to the Metabase in parallel. Non-positive values
+// are ignored.
+func WithResyncMetabaseWorkers(n int) Option {
+	return func(c *cfg) {
+		if n > 0 {
+			c.resyncWorkers = n
+		}
+	}
+}
+
+// WithTier returns option to set name of the storage tier the shard belongs
+// to. Storage engine places objects and moves them between shards according
+// to their tiers. Empty by default.
+func WithTier(tier string) Option {
+	return func(c *cfg) {
+		c.info.Tier = tier
+	}
+}
+
 // WithMode returns option to set shard's mode. Mode must be one of the predefined:
 //   - mode.ReadWrite;
 //   - mode.ReadOnly.
@@ -286,6 +434,41 @@ func WithMetricsWriter(v MetricsWriter) Option {
 	}
 }
 
+// WithScrubInterval returns option to set interval of the background
+// metabase scrub checking and repairing metabase records, object counters and
+// container sizes. Non-positive value disables periodic scrub.
+func WithScrubInterval(d time.Duration) Option {
+	return func(c *cfg) {
+		c.scrubInterval = d
+	}
+}
+
+// WithPayloadScrubRate returns option to set maximum read rate in bytes per
+// second of the background payload scrub verifying ID, signature and payload
+// checksum of the stored objects. Zero disables payload scrub.
+func WithPayloadScrubRate(rate uint64) Option {
+	return func(c *cfg) {
+		c.payloadScrubRate = rate
+	}
+}
+
+// WithQuarantinePath returns option to set directory corrupted objects found
+// by the payload scrub are moved to. Defaults to the blobstor path with
+// ".quarantine" suffix.
+func WithQuarantinePath(p string) Option {
+	return func(c *cfg) {
+		c.quarantineDir = p
+	}
+}
+
+// WithCorruptedObjectCallback returns option to specify callback of the
+// corrupted objects removed from the shard by the payload scrub.
+func WithCorruptedObjectCallback(cb CorruptedObjectCallback) Option {
+	return func(c *cfg) {
+		c.corruptedObjectCallback = cb
+	}
+}
+
 // WithReportErrorFunc returns option to specify callback for handling storage-related errors
 // in the background workers.
 func WithReportErrorFunc(f func(selfID string, message string, err error)) Option {
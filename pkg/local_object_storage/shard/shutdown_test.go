@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"crypto/rand"
 	"testing"
 
@@ -31,7 +32,7 @@ func TestWriteCacheObjectLoss(t *testing.T) {
 	sh := newCustomShard(t, dir, true, wcOpts)
 
 	for i := range objects {
-		err := sh.Put(objects[i], nil)
+		err := sh.Put(context.Background(), objects[i], nil)
 		require.NoError(t, err)
 	}
 	require.NoError(t, sh.Close())
@@ -40,7 +41,7 @@ func TestWriteCacheObjectLoss(t *testing.T) {
 	defer releaseShard(sh, t)
 
 	for i := range objects {
-		_, err := sh.Get(object.AddressOf(objects[i]), false)
+		_, err := sh.Get(context.Background(), object.AddressOf(objects[i]), false)
 		require.NoError(t, err, i)
 	}
 }
@@ -0,0 +1,33 @@
+package shard
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts span of the shard operation with the object if ctx is
+// traced.
+func (s *Shard) startSpan(ctx context.Context, op string, addr oid.Address) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		// do not spend time on attributes of the span that will not be started
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	attrs := []attribute.KeyValue{attribute.Stringer("address", addr)}
+	if s.info.ID != nil {
+		attrs = append(attrs, attribute.String("shard_id", s.info.ID.String()))
+	}
+	return tracing.Start(ctx, "shard."+op, attrs...)
+}
+
+// traceStorage calls f within a span of the shard component operation, e.g.
+// "fstree.Get".
+func traceStorage(ctx context.Context, component, op string, f func() error) error {
+	_, span := tracing.StartChild(ctx, component+"."+op)
+	err := f()
+	tracing.End(span, err)
+	return err
+}
@@ -0,0 +1,18 @@
+// Package diskspace provides disk space statistics of the file system.
+package diskspace
+
+// Usage describes the disk space of the file system.
+type Usage struct {
+	// Total is the file system size in bytes.
+	Total uint64
+	// Available is the number of bytes available for writing.
+	Available uint64
+}
+
+// UsedRatio returns the share of the used disk space, from 0 to 1.
+func (u Usage) UsedRatio() float64 {
+	if u.Total == 0 {
+		return 0
+	}
+	return 1 - float64(min(u.Available, u.Total))/float64(u.Total)
+}
@@ -0,0 +1,24 @@
+package diskspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	u, err := Get(t.TempDir())
+	require.NoError(t, err)
+	require.NotZero(t, u.Total)
+	require.LessOrEqual(t, u.Available, u.Total)
+
+	_, err = Get("/non/existent/path")
+	require.Error(t, err)
+}
+
+func TestUsage_UsedRatio(t *testing.T) {
+	require.Zero(t, Usage{}.UsedRatio())
+	require.Zero(t, Usage{Total: 10, Available: 10}.UsedRatio())
+	require.InDelta(t, 0.75, Usage{Total: 100, Available: 25}.UsedRatio(), 1e-9)
+	require.EqualValues(t, 1, Usage{Total: 100}.UsedRatio())
+}
@@ -0,0 +1,23 @@
+//go:build !windows
+
+package diskspace
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// Get returns disk space statistics of the file system the path belongs to.
+func Get(path string) (Usage, error) {
+	var stat unix.Statfs_t
+	err := unix.Statfs(path, &stat)
+	if err != nil {
+		return Usage{}, fmt.Errorf("get FS stat by '%s' path: %w", path, err)
+	}
+
+	return Usage{
+		Total:     stat.Blocks * uint64(stat.Bsize),
+		Available: stat.Bavail * uint64(stat.Bsize),
+	}, nil
+}
@@ -0,0 +1,21 @@
+//go:build windows
+
+package diskspace
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/windows"
+)
+
+// Get returns disk space statistics of the file system the path belongs to.
+func Get(path string) (Usage, error) {
+	var availB, totalB, freeTotalB uint64
+
+	err := windows.GetDiskFreeSpaceEx(windows.StringToUTF16Ptr(path), &availB, &totalB, &freeTotalB)
+	if err != nil {
+		return Usage{}, fmt.Errorf("get disk stat by '%s' path: %w", path, err)
+	}
+
+	return Usage{Total: totalB, Available: availB}, nil
+}
@@ -0,0 +1,326 @@
+// Package iosched provides I/O scheduler of the storage shard prioritizing
+// client operations over the background ones.
+//
+// Operations are tagged with a [Class] using [WithClass] as they enter the
+// shard. Each class can be throttled by the number of operations and bytes per
+// second. If the number of concurrent operations is limited, waiting
+// operations are started in proportion to the weights of their classes, so
+// background work yields under client load.
+package iosched
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Class is a priority class of the storage operations.
+type Class uint8
+
+// Supported classes.
+const (
+	// Client is a class of the operations requested by the storage clients.
+	// Default class for untagged operations.
+	Client Class = iota
+	// Replication is a class of the object replication, evacuation and
+	// relocation between shards.
+	Replication
+	// Flush is a class of the write-cache flushes.
+	Flush
+	// GC is a class of the garbage collector.
+	GC
+	// Scrub is a class of the metabase and payload scrubs and metabase
+	// resync.
+	Scrub
+
+	numClasses
+)
+
+var classNames = [numClasses]string{"client", "replication", "flush", "gc", "scrub"}
+
+// defaultWeights are the class weights used if not set in [Config].
+var defaultWeights = [numClasses]uint32{100, 40, 40, 10, 10}
+
+// String returns class name.
+func (c Class) String() string {
+	if c < numClasses {
+		return classNames[c]
+	}
+	return fmt.Sprintf("unknown(%d)", uint8(c))
+}
+
+type classKey struct{}
+
+// WithClass returns a copy of ctx tagged with the operation class.
+func WithClass(ctx context.Context, c Class) context.Context {
+	return context.WithValue(ctx, classKey{}, c)
+}
+
+// ClassFrom returns the operation class ctx is tagged with, [Client] by
+// default.
+func ClassFrom(ctx context.Context) Class {
+	if c, ok := ctx.Value(classKey{}).(Class); ok {
+		return c
+	}
+	return Client
+}
+
+// Limits are the scheduling parameters of a class.
+type Limits struct {
+	// Weight is the share of the operations of the class started when
+	// operations of several classes wait for the free slot. Zero means
+	// default weight of the class.
+	Weight uint32
+	// IOPS is the maximum number of operations per second, zero means no
+	// limit.
+	IOPS uint64
+	// Bandwidth is the maximum number of bytes read or written per second,
+	// zero means no limit.
+	Bandwidth uint64
+}
+
+// Config is a configuration of the [Scheduler].
+type Config struct {
+	// MaxConcurrent is the maximum number of operations running at the same
+	// time, other operations wait for the free slot. Zero means no limit, so
+	// classes are only throttled by their limits.
+	MaxConcurrent int
+	// Classes are the scheduling parameters of the classes, missing ones use
+	// defaults.
+	Classes map[Class]Limits
+}
+
+// Enabled checks whether the configuration limits anything, so the scheduler
+// is needed.
+func (c Config) Enabled() bool {
+	if c.MaxConcurrent > 0 {
+		return true
+	}
+	for _, l := range c.Classes {
+		if l.IOPS > 0 || l.Bandwidth > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Scheduler schedules storage operations according to their classes. Nil
+// Scheduler does not limit anything.
+type Scheduler struct {
+	maxConcurrent int
+
+	mtx      sync.Mutex
+	inFlight int
+	waiting  int
+	// vnow is the virtual start time of the last started operation.
+	vnow    float64
+	classes [numClasses]classState
+}
+
+type classState struct {
+	weight    float64
+	iops, bw  *bucket
+	vtime     float64
+	waitQueue []*waiter
+}
+
+type waiter struct {
+	ch      chan struct{}
+	granted bool
+}
+
+// New creates new Scheduler with the given configuration.
+func New(cfg Config) *Scheduler {
+	s := &Scheduler{maxConcurrent: cfg.MaxConcurrent}
+	for c := range s.classes {
+		l := cfg.Classes[Class(c)]
+		if l.Weight == 0 {
+			l.Weight = defaultWeights[c]
+		}
+
+		st := &s.classes[c]
+		st.weight = float64(l.Weight)
+		if l.IOPS > 0 {
+			st.iops = newBucket(l.IOPS)
+		}
+		if l.Bandwidth > 0 {
+			st.bw = newBucket(l.Bandwidth)
+		}
+	}
+	return s
+}
+
+// Acquire waits until the operation of the class reading or writing size
+// bytes is allowed to start. Returned function must be called when the
+// operation is finished with the number of bytes read if it was unknown
+// before, they are taken into account when throttling the next operations.
+// Returns ctx error if it is done before the operation can start.
+func (s *Scheduler) Acquire(ctx context.Context, c Class, size uint64) (func(read uint64), error) {
+	if s == nil {
+		return func(uint64) {}, nil
+	}
+	if c >= numClasses {
+		c = Client
+	}
+
+	err := s.throttle(ctx, c, size)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.acquireSlot(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(read uint64) {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+
+		if s.maxConcurrent > 0 {
+			s.inFlight--
+			s.dispatch()
+		}
+		if bw := s.classes[c].bw; bw != nil && read > 0 {
+			bw.take(time.Now(), float64(read))
+		}
+	}, nil
+}
+
+func (s *Scheduler) throttle(ctx context.Context, c Class, size uint64) error {
+	var (
+		wait time.Duration
+		st   = &s.classes[c]
+	)
+
+	s.mtx.Lock()
+	now := time.Now()
+	if st.iops != nil {
+		wait = max(wait, st.iops.take(now, 1))
+	}
+	if st.bw != nil && size > 0 {
+		wait = max(wait, st.bw.take(now, float64(size)))
+	}
+	s.mtx.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(wait)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func (s *Scheduler) acquireSlot(ctx context.Context, c Class) error {
+	if s.maxConcurrent <= 0 {
+		return nil
+	}
+
+	st := &s.classes[c]
+
+	s.mtx.Lock()
+	if s.inFlight < s.maxConcurrent && s.waiting == 0 {
+		s.inFlight++
+		s.start(st)
+		s.mtx.Unlock()
+		return nil
+	}
+
+	w := &waiter{ch: make(chan struct{})}
+	if len(st.waitQueue) == 0 {
+		// idle class must not take advantage of the time it did not use
+		st.vtime = max(st.vtime, s.vnow)
+	}
+	st.waitQueue = append(st.waitQueue, w)
+	s.waiting++
+	s.mtx.Unlock()
+
+	select {
+	case <-w.ch:
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if w.granted {
+		s.inFlight--
+		s.dispatch()
+	} else {
+		for i := range st.waitQueue {
+			if st.waitQueue[i] == w {
+				st.waitQueue = append(st.waitQueue[:i], st.waitQueue[i+1:]...)
+				break
+			}
+		}
+		s.waiting--
+	}
+	return ctx.Err()
+}
+
+// dispatch starts waiting operations of the classes with the least virtual
+// time while there are free slots. Must be called under the lock.
+func (s *Scheduler) dispatch() {
+	for s.inFlight < s.maxConcurrent && s.waiting > 0 {
+		var next *classState
+		for i := range s.classes {
+			st := &s.classes[i]
+			if len(st.waitQueue) > 0 && (next == nil || st.vtime < next.vtime) {
+				next = st
+			}
+		}
+
+		w := next.waitQueue[0]
+		next.waitQueue = next.waitQueue[1:]
+		s.waiting--
+		s.inFlight++
+		s.start(next)
+
+		w.granted = true
+		close(w.ch)
+	}
+}
+
+// start accounts started operation of the class. Must be called under the
+// lock.
+func (s *Scheduler) start(st *classState) {
+	st.vtime = max(st.vtime, s.vnow)
+	s.vnow = st.vtime
+	st.vtime += 1 / st.weight
+}
+
+// bucket is a token bucket refilled with rate tokens per second up to rate
+// tokens.
+type bucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(rate uint64) *bucket {
+	return &bucket{rate: float64(rate), tokens: float64(rate)}
+}
+
+// take takes n tokens and returns the time to wait until the bucket is not
+// in debt.
+func (b *bucket) take(now time.Time, n float64) time.Duration {
+	if !b.last.IsZero() {
+		b.tokens = min(b.rate, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+
+	b.tokens -= n
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
@@ -0,0 +1,154 @@
+package iosched
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClass(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, Client, ClassFrom(ctx))
+	require.Equal(t, GC, ClassFrom(WithClass(ctx, GC)))
+	require.Equal(t, "scrub", Scrub.String())
+}
+
+func TestConfig_Enabled(t *testing.T) {
+	require.False(t, Config{}.Enabled())
+	require.False(t, Config{Classes: map[Class]Limits{GC: {Weight: 1}}}.Enabled())
+	require.True(t, Config{MaxConcurrent: 1}.Enabled())
+	require.True(t, Config{Classes: map[Class]Limits{GC: {IOPS: 1}}}.Enabled())
+}
+
+func TestScheduler_Nil(t *testing.T) {
+	var s *Scheduler
+	release, err := s.Acquire(context.Background(), Client, 1)
+	require.NoError(t, err)
+	release(1)
+}
+
+func TestScheduler_Throttle(t *testing.T) {
+	const rate = 50
+
+	t.Run("IOPS", func(t *testing.T) {
+		s := New(Config{Classes: map[Class]Limits{GC: {IOPS: rate}}})
+
+		start := time.Now()
+		for range rate + rate/2 {
+			release, err := s.Acquire(context.Background(), GC, 0)
+			require.NoError(t, err)
+			release(0)
+
+			// other classes are not limited
+			release, err = s.Acquire(context.Background(), Client, 0)
+			require.NoError(t, err)
+			release(0)
+		}
+		require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+	})
+
+	t.Run("bandwidth", func(t *testing.T) {
+		s := New(Config{Classes: map[Class]Limits{Scrub: {Bandwidth: rate}}})
+
+		start := time.Now()
+		release, err := s.Acquire(context.Background(), Scrub, 0)
+		require.NoError(t, err)
+		release(rate + rate/2) // read more than allowed
+
+		release, err = s.Acquire(context.Background(), Scrub, 1)
+		require.NoError(t, err)
+		release(0)
+		require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+	})
+
+	t.Run("context", func(t *testing.T) {
+		s := New(Config{Classes: map[Class]Limits{GC: {IOPS: 1}}})
+
+		release, err := s.Acquire(context.Background(), GC, 0)
+		require.NoError(t, err)
+		release(0)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = s.Acquire(ctx, GC, 0)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestScheduler_Priority(t *testing.T) {
+	const perClass = 10
+
+	s := New(Config{
+		MaxConcurrent: 1,
+		Classes:       map[Class]Limits{GC: {Weight: 1}},
+	})
+
+	// occupy the only slot
+	release, err := s.Acquire(context.Background(), GC, 0)
+	require.NoError(t, err)
+
+	var (
+		wg    sync.WaitGroup
+		mtx   sync.Mutex
+		order []Class
+	)
+	for _, c := range []Class{GC, Client} {
+		for range perClass {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				release, err := s.Acquire(context.Background(), c, 0)
+				require.NoError(t, err)
+
+				mtx.Lock()
+				order = append(order, c)
+				mtx.Unlock()
+				release(0)
+			}()
+		}
+	}
+
+	require.Eventually(t, func() bool {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+		return s.waiting == 2*perClass
+	}, time.Second, time.Millisecond)
+
+	release(0)
+	wg.Wait()
+
+	require.Len(t, order, 2*perClass)
+	var clients int
+	for _, c := range order[:perClass] {
+		if c == Client {
+			clients++
+		}
+	}
+	// GC weight is 100 times less
+	require.GreaterOrEqual(t, clients, perClass-1, order)
+}
+
+func TestScheduler_CancelWaiting(t *testing.T) {
+	s := New(Config{MaxConcurrent: 1})
+
+	release, err := s.Acquire(context.Background(), Client, 0)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.Acquire(ctx, GC, 0)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	release(0)
+
+	// slot is free
+	release, err = s.Acquire(context.Background(), GC, 0)
+	require.NoError(t, err)
+	release(0)
+	require.Zero(t, s.inFlight)
+	require.Zero(t, s.waiting)
+}
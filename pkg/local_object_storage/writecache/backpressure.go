@@ -0,0 +1,131 @@
+package writecache
+
+import (
+	"time"
+)
+
+const (
+	// throttleFillRatio is a write-cache fill ratio starting from which PUT
+	// operations are delayed and the largest objects are flushed first.
+	throttleFillRatio = 0.75
+	// spaceWaitInterval is an interval of checking for the free space while
+	// the PUT operation waits for it.
+	spaceWaitInterval = 10 * time.Millisecond
+	// maxFlushPause is a maximum pause before flushing the next object caused
+	// by the slow main storage.
+	maxFlushPause = time.Second
+	// flushLatencyWeight is a weight of the last main storage write latency in
+	// its moving average.
+	flushLatencyWeight = 0.2
+)
+
+// fillRatio returns ratio of the cache size increased by the given value to
+// the cache capacity.
+func (c *cache) fillRatio(add uint64) float64 {
+	if c.maxCacheSize == 0 {
+		return 1
+	}
+	return float64(c.objCounters.Size()+add) / float64(c.maxCacheSize)
+}
+
+func (c *cache) updateFillRatio() {
+	c.metrics.SetWCFillRatio(c.fillRatio(0))
+}
+
+// Throttle delays PUT of the object of the given size according to the cache
+// fill. Below the throttling threshold there is no delay, above it the delay
+// grows linearly up to the configured maximum for the completely filled cache.
+// If the object does not fit into the cache, throttle waits for the flush to
+// free enough space, but no longer than the maximum delay, and returns
+// [ErrOutOfSpace] if it does not happen. Returns [ErrReadOnly] if the cache is
+// read-only or closed, including the case when it is closed while waiting.
+func (c *cache) Throttle(size uint64) error {
+	if c.maxPutDelay <= 0 {
+		return nil
+	}
+
+	c.modeMtx.RLock()
+	closeCh, readOnly := c.closeCh, c.readOnly()
+	c.modeMtx.RUnlock()
+	if readOnly {
+		return ErrReadOnly
+	}
+
+	fill := c.fillRatio(size)
+	if fill <= throttleFillRatio {
+		return nil
+	}
+
+	if fill <= 1 {
+		c.metrics.IncWCThrottledPuts()
+		select {
+		case <-time.After(time.Duration(float64(c.maxPutDelay) * (fill - throttleFillRatio) / (1 - throttleFillRatio))):
+			return nil
+		case <-closeCh:
+			return ErrReadOnly
+		}
+	}
+
+	if size > c.maxCacheSize {
+		return ErrOutOfSpace
+	}
+
+	c.metrics.IncWCThrottledPuts()
+
+	deadline := time.Now().Add(c.maxPutDelay)
+	ticker := time.NewTicker(spaceWaitInterval)
+	defer ticker.Stop()
+
+	for c.fillRatio(size) > 1 {
+		if !time.Now().Before(deadline) {
+			return ErrOutOfSpace
+		}
+		select {
+		case <-ticker.C:
+		case <-closeCh:
+			return ErrReadOnly
+		}
+	}
+
+	return nil
+}
+
+// observeFlushLatency accounts the given main storage write latency in its
+// moving average.
+func (c *cache) observeFlushLatency(d time.Duration) {
+	if c.flushLatencyTarget <= 0 {
+		return
+	}
+
+	for {
+		prev := c.flushLatency.Load()
+		next := int64(d)
+		if prev != 0 {
+			next = prev + int64(flushLatencyWeight*float64(next-prev))
+		}
+		if c.flushLatency.CompareAndSwap(prev, next) {
+			return
+		}
+	}
+}
+
+// waitFlushRate pauses the flush when the average main storage write latency
+// exceeds the configured target by the excess, but not longer than
+// maxFlushPause. There is no pause when the cache is filled above the
+// throttling threshold: freeing the space for the incoming objects is more
+// important then.
+func (c *cache) waitFlushRate() {
+	if c.flushLatencyTarget <= 0 || c.fillRatio(0) > throttleFillRatio {
+		return
+	}
+
+	excess := time.Duration(c.flushLatency.Load()) - c.flushLatencyTarget
+	if excess <= 0 {
+		return
+	}
+
+	select {
+	case <-time.After(min(excess, maxFlushPause)):
+	case <-c.closeCh:
+	}
+}
@@ -0,0 +1,134 @@
+package writecache
+
+import (
+	"testing"
+	"time"
+
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_Throttle(t *testing.T) {
+	const (
+		capacity = 100
+		maxDelay = 200 * time.Millisecond
+	)
+
+	newTestCache := func(opts ...Option) *cache {
+		return New(append([]Option{WithMaxCacheSize(capacity), WithMaxPutDelay(maxDelay)}, opts...)...).(*cache)
+	}
+
+	t.Run("below threshold", func(t *testing.T) {
+		c := newTestCache()
+		c.objCounters.Add(oidtest.Address(), 50, 0)
+
+		start := time.Now()
+		require.NoError(t, c.Throttle(25))
+		require.Less(t, time.Since(start), maxDelay/4)
+	})
+
+	t.Run("above threshold", func(t *testing.T) {
+		c := newTestCache()
+		c.objCounters.Add(oidtest.Address(), 80, 0)
+
+		start := time.Now()
+		require.NoError(t, c.Throttle(20))
+		require.GreaterOrEqual(t, time.Since(start), maxDelay)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		c := newTestCache(WithMaxPutDelay(-1))
+		c.objCounters.Add(oidtest.Address(), 100, 0)
+
+		start := time.Now()
+		require.NoError(t, c.Throttle(10))
+		require.Less(t, time.Since(start), maxDelay/4)
+	})
+
+	t.Run("too big object", func(t *testing.T) {
+		c := newTestCache()
+
+		start := time.Now()
+		require.ErrorIs(t, c.Throttle(capacity+1), ErrOutOfSpace)
+		require.Less(t, time.Since(start), maxDelay/4)
+	})
+
+	t.Run("no space", func(t *testing.T) {
+		c := newTestCache()
+		c.objCounters.Add(oidtest.Address(), 95, 0)
+
+		start := time.Now()
+		require.ErrorIs(t, c.Throttle(10), ErrOutOfSpace)
+		require.GreaterOrEqual(t, time.Since(start), maxDelay)
+	})
+
+	t.Run("space freed", func(t *testing.T) {
+		c := newTestCache()
+		addr := oidtest.Address()
+		c.objCounters.Add(addr, 95, 0)
+
+		time.AfterFunc(maxDelay/4, func() { c.objCounters.Delete(addr) })
+
+		require.NoError(t, c.Throttle(10))
+	})
+
+	t.Run("close", func(t *testing.T) {
+		for _, used := range []uint64{80, 95} {
+			c := newTestCache()
+			c.closeCh = make(chan struct{})
+			c.objCounters.Add(oidtest.Address(), used, 0)
+
+			time.AfterFunc(maxDelay/4, func() { close(c.closeCh) })
+
+			start := time.Now()
+			require.ErrorIs(t, c.Throttle(10), ErrReadOnly)
+			require.Less(t, time.Since(start), maxDelay/2)
+		}
+	})
+}
+
+func TestCache_FlushRate(t *testing.T) {
+	const target = 10 * time.Millisecond
+
+	t.Run("disabled", func(t *testing.T) {
+		c := New().(*cache)
+		c.observeFlushLatency(time.Second)
+		require.Zero(t, c.flushLatency.Load())
+	})
+
+	t.Run("moving average", func(t *testing.T) {
+		c := New(WithFlushLatencyTarget(target)).(*cache)
+
+		c.observeFlushLatency(100 * time.Millisecond)
+		require.EqualValues(t, 100*time.Millisecond, c.flushLatency.Load())
+
+		c.observeFlushLatency(0)
+		require.EqualValues(t, 80*time.Millisecond, c.flushLatency.Load())
+	})
+
+	t.Run("pause", func(t *testing.T) {
+		c := New(WithFlushLatencyTarget(target), WithMaxCacheSize(100)).(*cache)
+		c.closeCh = make(chan struct{})
+		c.observeFlushLatency(target + 100*time.Millisecond)
+
+		start := time.Now()
+		c.waitFlushRate()
+		require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+		close(c.closeCh)
+
+		start = time.Now()
+		c.waitFlushRate()
+		require.Less(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("filling cache", func(t *testing.T) {
+		c := New(WithFlushLatencyTarget(target), WithMaxCacheSize(100)).(*cache)
+		c.observeFlushLatency(target + 100*time.Millisecond)
+		c.objCounters.Add(oidtest.Address(), 80, 0)
+
+		start := time.Now()
+		c.waitFlushRate()
+		require.Less(t, time.Since(start), 50*time.Millisecond)
+	})
+}
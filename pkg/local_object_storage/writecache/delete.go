@@ -19,17 +19,32 @@ func (c *cache) Delete(addr oid.Address) error {
 }
 
 func (c *cache) delete(addr oid.Address) error {
-	err := c.fsTree.Delete(addr)
+	s, err := c.stripeOf(addr)
+	if err != nil {
+		return err
+	}
+	return c.deleteFrom(s, addr)
+}
+
+// deleteFrom removes object from the given write-cache device.
+func (c *cache) deleteFrom(s *stripe, addr oid.Address) error {
+	err := s.fsTree.Delete(addr)
 	if err == nil {
 		storagelog.Write(c.log,
 			storagelog.AddressField(addr),
 			storagelog.StorageTypeField(wcStorageType),
 			storagelog.OpField("DELETE"),
 		)
-		c.objCounters.Delete(addr)
-		c.metrics.DecWCObjectCount()
-		c.metrics.SetWCSize(c.objCounters.Size())
+		c.forget(addr)
 	}
 
 	return err
 }
+
+// forget removes object from the cache counters.
+func (c *cache) forget(addr oid.Address) {
+	c.objCounters.Delete(addr)
+	c.metrics.DecWCObjectCount()
+	c.metrics.SetWCSize(c.objCounters.Size())
+	c.updateFillRatio()
+}
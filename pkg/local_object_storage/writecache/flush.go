@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
@@ -55,6 +56,7 @@ func (c *cache) flushScheduler() {
 			batchTimer.Stop()
 			batchTimer = nil
 		}
+		c.waitFlushRate()
 		err := c.flushBatch(batch, true)
 		if err != nil {
 			c.log.Error("can't flush batch of objects", zap.Error(err))
@@ -115,7 +117,7 @@ func (c *cache) flushScheduler() {
 
 		type addrSize struct {
 			addr oid.Address
-			size uint64
+			cachedObject
 		}
 		var sortedAddrs []addrSize
 
@@ -125,7 +127,7 @@ func (c *cache) flushScheduler() {
 		}
 
 		addrs := c.objCounters.Map()
-		for addr, size := range addrs {
+		for addr, obj := range addrs {
 			sAddr := addr.String()
 			if _, exists := batchSet[sAddr]; exists {
 				continue
@@ -133,11 +135,19 @@ func (c *cache) flushScheduler() {
 			if _, loaded := c.processingBigObjs.Load(sAddr); loaded {
 				continue
 			}
-			sortedAddrs = append(sortedAddrs, addrSize{addr, size})
+			sortedAddrs = append(sortedAddrs, addrSize{addr, obj})
+		}
+		// Flush the oldest objects first normally, but when the cache is
+		// getting full, free the space as fast as possible.
+		if c.fillRatio(0) > throttleFillRatio {
+			sort.Slice(sortedAddrs, func(i, j int) bool {
+				return sortedAddrs[i].size > sortedAddrs[j].size
+			})
+		} else {
+			sort.Slice(sortedAddrs, func(i, j int) bool {
+				return sortedAddrs[i].added.Before(sortedAddrs[j].added)
+			})
 		}
-		sort.Slice(sortedAddrs, func(i, j int) bool {
-			return sortedAddrs[i].size > sortedAddrs[j].size
-		})
 
 	addrLoop:
 		for _, as := range sortedAddrs {
@@ -170,7 +180,7 @@ func (c *cache) flushScheduler() {
 			}
 
 			batch = append(batch, as.addr)
-			batchSize += uint64(as.size)
+			batchSize += as.size
 
 			if batchTimer == nil {
 				batchTimer = time.NewTimer(defaultMaxBatchDelay)
@@ -192,12 +202,16 @@ func (c *cache) flushWorker(id int) {
 			if !ok {
 				return
 			}
+			c.waitFlushRate()
 			c.modeMtx.RLock()
 			if c.readOnly() {
 				c.modeMtx.RUnlock()
 				continue
 			}
-			err := c.flushSingle(addr, true)
+			var err error
+			if s, sErr := c.stripeOf(addr); sErr == nil {
+				err = c.flushSingle(s, addr, true)
+			}
 			c.modeMtx.RUnlock()
 
 			c.processingBigObjs.Delete(addr.String())
@@ -227,11 +241,11 @@ func (c *cache) reportFlushError(msg string, addr string, err error) {
 	}
 }
 
-func (c *cache) flushSingle(addr oid.Address, ignoreErrors bool) error {
+func (c *cache) flushSingle(s *stripe, addr oid.Address, ignoreErrors bool) error {
 	if c.metrics.mr != nil {
 		defer elapsed(c.metrics.AddWCFlushSingleDuration)()
 	}
-	data, err := c.getObject(addr)
+	data, err := c.getObject(s, addr)
 	if err != nil {
 		if ignoreErrors {
 			return nil
@@ -244,7 +258,7 @@ func (c *cache) flushSingle(addr oid.Address, ignoreErrors bool) error {
 		return err
 	}
 
-	err = c.delete(addr)
+	err = c.deleteFrom(s, addr)
 	if err != nil && !errors.As(err, new(apistatus.ObjectNotFound)) {
 		c.log.Error("can't remove object from write-cache", zap.Error(err))
 	}
@@ -254,7 +268,15 @@ func (c *cache) flushSingle(addr oid.Address, ignoreErrors bool) error {
 
 // flushObject is used to write object directly to the main storage.
 func (c *cache) flushObject(addr oid.Address, data []byte) error {
-	err := c.storage.Put(addr, data)
+	release, err := c.ioSched.Acquire(c.closeCtx, iosched.Flush, uint64(len(data)))
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err = c.storage.Put(addr, data)
+	c.observeFlushLatency(time.Since(start))
+	release(0)
 	if err != nil {
 		if !errors.Is(err, common.ErrNoSpace) && !errors.Is(err, common.ErrReadOnly) {
 			c.reportFlushError("can't flush an object to blobstor",
@@ -280,7 +302,12 @@ func (c *cache) flushBatch(addrs []oid.Address, ignoreErrors bool) error {
 
 	objs := make(map[oid.Address][]byte, len(addrs))
 	for _, addr := range addrs {
-		data, err := c.getObject(addr)
+		s, err := c.stripeOf(addr)
+		if err != nil {
+			// removed after being scheduled
+			continue
+		}
+		data, err := c.getObject(s, addr)
 		if err != nil {
 			if ignoreErrors {
 				continue
@@ -291,7 +318,20 @@ func (c *cache) flushBatch(addrs []oid.Address, ignoreErrors bool) error {
 		objs[addr] = data
 	}
 
-	err := c.storage.PutBatch(objs)
+	var size uint64
+	for _, data := range objs {
+		size += uint64(len(data))
+	}
+
+	release, err := c.ioSched.Acquire(c.closeCtx, iosched.Flush, size)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err = c.storage.PutBatch(objs)
+	c.observeFlushLatency(time.Since(start))
+	release(0)
 	if err != nil {
 		if !errors.Is(err, common.ErrNoSpace) && !errors.Is(err, common.ErrReadOnly) {
 			for addr := range objs {
@@ -311,10 +351,10 @@ func (c *cache) flushBatch(addrs []oid.Address, ignoreErrors bool) error {
 	return err
 }
 
-func (c *cache) getObject(addr oid.Address) ([]byte, error) {
+func (c *cache) getObject(s *stripe, addr oid.Address) ([]byte, error) {
 	sAddr := addr.EncodeToString()
 
-	data, err := c.fsTree.GetBytes(addr)
+	data, err := s.fsTree.GetBytes(addr)
 	if err != nil {
 		if errors.As(err, new(apistatus.ObjectNotFound)) {
 			// an object can be removed b/w iterating over it
@@ -323,6 +363,11 @@ func (c *cache) getObject(addr oid.Address) ([]byte, error) {
 		}
 
 		c.reportFlushError("can't read a file", sAddr, err)
+		if s.failed.Load() {
+			// the object is lost with the device, there is no sense in
+			// keeping its space reserved and retrying the flush
+			c.forget(addr)
+		}
 		return nil, err
 	}
 
@@ -340,7 +385,13 @@ func (c *cache) Flush(ignoreErrors bool) error {
 }
 
 func (c *cache) flush(ignoreErrors bool) error {
-	return c.fsTree.IterateAddresses(func(addr oid.Address) error {
-		return c.flushSingle(addr, ignoreErrors)
-	}, ignoreErrors)
+	for _, s := range c.stripes {
+		err := s.fsTree.IterateAddresses(func(addr oid.Address) error {
+			return c.flushSingle(s, addr, ignoreErrors)
+		}, ignoreErrors)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
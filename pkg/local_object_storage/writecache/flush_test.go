@@ -128,11 +128,11 @@ func TestFlush(t *testing.T) {
 				obj, data := newObject(t, 1)
 				addr := objectCore.AddressOf(obj)
 
-				err := c.fsTree.Put(addr, data)
+				err := c.stripes[0].fsTree.Put(addr, data)
 				require.NoError(t, err)
 
 				p := addr.Object().EncodeToString() + "." + addr.Container().EncodeToString()
-				p = filepath.Join(c.fsTree.RootPath, p[:1], p[1:])
+				p = filepath.Join(c.stripes[0].fsTree.RootPath, p[:1], p[1:])
 
 				_, err = os.Stat(p) // sanity check
 				require.NoError(t, err)
@@ -13,10 +13,11 @@ import (
 //
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
 func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
-	if !c.objCounters.HasAddress(addr) {
-		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+	s, err := c.stripeOf(addr)
+	if err != nil {
+		return nil, err
 	}
-	obj, err := c.fsTree.Get(addr)
+	obj, err := s.fsTree.Get(addr)
 	if err != nil {
 		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
 	}
@@ -28,10 +29,11 @@ func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
 //
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
 func (c *cache) Head(addr oid.Address) (*objectSDK.Object, error) {
-	if !c.objCounters.HasAddress(addr) {
-		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+	s, err := c.stripeOf(addr)
+	if err != nil {
+		return nil, err
 	}
-	obj, err := c.fsTree.Head(addr)
+	obj, err := s.fsTree.Head(addr)
 	if err != nil {
 		return nil, logicerr.Wrap(fmt.Errorf("%w: %w", apistatus.ErrObjectNotFound, err))
 	}
@@ -40,10 +42,11 @@ func (c *cache) Head(addr oid.Address) (*objectSDK.Object, error) {
 }
 
 func (c *cache) GetBytes(addr oid.Address) ([]byte, error) {
-	if !c.objCounters.HasAddress(addr) {
-		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+	s, err := c.stripeOf(addr)
+	if err != nil {
+		return nil, err
 	}
-	b, err := c.fsTree.GetBytes(addr)
+	b, err := s.fsTree.GetBytes(addr)
 	if err != nil {
 		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
 	}
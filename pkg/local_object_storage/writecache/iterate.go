@@ -17,18 +17,25 @@ func (c *cache) Iterate(handler func(oid.Address, []byte) error, ignoreErrors bo
 		return nil
 	}
 
-	var addrHandler = func(addr oid.Address) error {
-		data, err := c.fsTree.GetBytes(addr)
-		if err != nil {
-			if ignoreErrors || errors.As(err, new(apistatus.ObjectNotFound)) {
-				// an object can be removed b/w iterating over it
-				// and reading its payload; not an error
-				return nil
+	for _, s := range c.stripes {
+		var addrHandler = func(addr oid.Address) error {
+			data, err := s.fsTree.GetBytes(addr)
+			if err != nil {
+				if ignoreErrors || errors.As(err, new(apistatus.ObjectNotFound)) {
+					// an object can be removed b/w iterating over it
+					// and reading its payload; not an error
+					return nil
+				}
+				return err
 			}
+			return handler(addr, data)
+		}
+
+		err := s.fsTree.IterateAddresses(addrHandler, ignoreErrors)
+		if err != nil {
 			return err
 		}
-		return handler(addr, data)
 	}
 
-	return c.fsTree.IterateAddresses(addrHandler, ignoreErrors)
+	return nil
 }
@@ -10,6 +10,8 @@ type metricRegister interface {
 	DecWCObjectCount(shardID string)
 	AddWCSize(shardID string, size uint64)
 	SetWCSize(shardID string, size uint64)
+	SetWCFillRatio(shardID string, ratio float64)
+	IncWCThrottledPuts(shardID string)
 }
 
 type metricsWithID struct {
@@ -53,6 +55,18 @@ func (m *metricsWithID) SetWCSize(size uint64) {
 	}
 }
 
+func (m *metricsWithID) SetWCFillRatio(ratio float64) {
+	if m.mr != nil {
+		m.mr.SetWCFillRatio(m.id, ratio)
+	}
+}
+
+func (m *metricsWithID) IncWCThrottledPuts() {
+	if m.mr != nil {
+		m.mr.IncWCThrottledPuts(m.id)
+	}
+}
+
 func elapsed(addFunc func(d time.Duration)) func() {
 	t := time.Now()
 
@@ -17,7 +17,7 @@ func TestMigrateFromBolt(t *testing.T) {
 	c, s := newCache(t)
 
 	wc := c.(*cache)
-	path := filepath.Join(wc.path, dbName)
+	path := filepath.Join(wc.paths[0], dbName)
 
 	require.NoError(t, wc.Close())
 
@@ -1,7 +1,11 @@
 package writecache
 
 import (
+	"time"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
@@ -18,8 +22,8 @@ type stor interface {
 
 type options struct {
 	log *zap.Logger
-	// path is a path to a directory for write-cache.
-	path string
+	// paths are paths to directories for write-cache, one per device.
+	paths []string
 	// storage is the main persistent storage.
 	storage stor
 	// maxCacheSize is the maximum total size of all objects saved in cache.
@@ -41,6 +45,16 @@ type options struct {
 	maxFlushBatchThreshold uint64
 	// metrics is the metrics register instance for write-cache.
 	metrics *metricsWithID
+	// maxPutDelay is the maximum delay of the PUT operation caused by the
+	// write-cache fill.
+	maxPutDelay time.Duration
+	// flushLatencyTarget is the main storage write latency above which flush
+	// is slowed down, zero disables flush rate limiting.
+	flushLatencyTarget time.Duration
+	// ioSched schedules writes of the flushed objects to the main storage.
+	ioSched *iosched.Scheduler
+
+	encryption *encryption.Cipher
 }
 
 // WithLogger sets logger.
@@ -52,8 +66,17 @@ func WithLogger(log *zap.Logger) Option {
 
 // WithPath sets path to writecache db.
 func WithPath(path string) Option {
+	return WithPaths(path)
+}
+
+// WithPaths sets paths to the write-cache directories located on different
+// devices. Objects are striped across all of them. If writing to some device
+// fails, objects are written to the remaining ones. The first path also keeps
+// the database of the old write-cache format to migrate unless the device
+// fails to open.
+func WithPaths(paths ...string) Option {
 	return func(o *options) {
-		o.path = path
+		o.paths = paths
 	}
 }
 
@@ -127,3 +150,39 @@ func WithMetrics(m metricRegister) Option {
 		o.metrics.mr = m
 	}
 }
+
+// WithEncryption sets cipher to encrypt cached objects with.
+func WithEncryption(c *encryption.Cipher) Option {
+	return func(o *options) {
+		o.encryption = c
+	}
+}
+
+// WithMaxPutDelay sets maximum delay of the PUT operation when the write-cache
+// is filled above the throttling threshold. When the object does not fit into
+// the cache, PUT waits for the flush to free enough space for at most this
+// time before returning [ErrOutOfSpace]. Non-positive value (default) disables
+// throttling.
+func WithMaxPutDelay(d time.Duration) Option {
+	return func(o *options) {
+		o.maxPutDelay = d
+	}
+}
+
+// WithFlushLatencyTarget sets main storage write latency above which the
+// background flush is slowed down to reduce the main storage load. Zero value
+// (default) disables flush rate limiting.
+func WithFlushLatencyTarget(d time.Duration) Option {
+	return func(o *options) {
+		o.flushLatencyTarget = d
+	}
+}
+
+// WithIOScheduler sets I/O scheduler of the shard the flushed objects are
+// written to the main storage with, they are scheduled as [iosched.Flush]
+// operations.
+func WithIOScheduler(s *iosched.Scheduler) Option {
+	return func(o *options) {
+		o.ioSched = s
+	}
+}
@@ -13,18 +13,19 @@ var (
 	ErrOutOfSpace = errors.New("no space left in the write cache")
 )
 
-// Put puts object to write-cache.
+// Put puts object to write-cache. Put is not delayed, callers throttle
+// themselves with [Cache.Throttle].
 func (c *cache) Put(addr oid.Address, obj *objectSDK.Object, data []byte) error {
+	if c.metrics.mr != nil {
+		defer elapsed(c.metrics.AddWCPutDuration)()
+	}
+
 	c.modeMtx.RLock()
 	defer c.modeMtx.RUnlock()
 	if c.readOnly() {
 		return ErrReadOnly
 	}
 
-	if c.metrics.mr != nil {
-		defer elapsed(c.metrics.AddWCPutDuration)()
-	}
-
 	oi := objectInfo{
 		addr: addr.EncodeToString(),
 		obj:  obj,
@@ -42,14 +43,20 @@ func (c *cache) put(addr oid.Address, obj objectInfo) error {
 		return ErrOutOfSpace
 	}
 
-	err := c.fsTree.Put(addr, obj.data)
+	mtx := c.putLock(addr)
+	mtx.Lock()
+	stripe, err := c.putToStripe(addr, obj.data)
+	if err == nil {
+		c.objCounters.Add(addr, objSz, stripe)
+	}
+	mtx.Unlock()
 	if err != nil {
 		return err
 	}
 
-	c.objCounters.Add(addr, objSz)
 	c.metrics.IncWCObjectCount()
 	c.metrics.AddWCSize(objSz)
+	c.updateFillRatio()
 	storagelog.Write(c.log,
 		storagelog.AddressField(obj.addr),
 		storagelog.StorageTypeField(wcStorageType),
@@ -4,29 +4,46 @@ import (
 	"fmt"
 	"maps"
 	"sync"
+	"time"
 
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
 )
 
+// cachedObject describes object stored in the write-cache.
+type cachedObject struct {
+	size uint64
+	// added is the time the object was put to the cache, zero for the objects
+	// found in the cache on its opening.
+	added time.Time
+	// stripe is an index of the write-cache device storing the object.
+	stripe int
+}
+
 type counters struct {
 	mu     sync.RWMutex
-	objMap map[oid.Address]uint64
+	objMap map[oid.Address]cachedObject
 	size   uint64
 }
 
-func (x *counters) Add(addr oid.Address, size uint64) {
+func (x *counters) Add(addr oid.Address, size uint64, stripe int) {
+	x.add(addr, cachedObject{size: size, added: time.Now(), stripe: stripe})
+}
+
+func (x *counters) add(addr oid.Address, obj cachedObject) {
 	x.mu.Lock()
 	defer x.mu.Unlock()
 
-	x.size += size
-	x.objMap[addr] = size
+	x.size -= x.objMap[addr].size
+	x.size += obj.size
+	x.objMap[addr] = obj
 }
 
 func (x *counters) Delete(addr oid.Address) {
 	x.mu.Lock()
 	defer x.mu.Unlock()
 
-	x.size -= x.objMap[addr]
+	x.size -= x.objMap[addr].size
 	delete(x.objMap, addr)
 }
 
@@ -37,32 +54,65 @@ func (x *counters) Size() uint64 {
 }
 
 func (x *counters) HasAddress(addr oid.Address) bool {
+	_, ok := x.Get(addr)
+	return ok
+}
+
+func (x *counters) Get(addr oid.Address) (cachedObject, bool) {
 	x.mu.RLock()
 	defer x.mu.RUnlock()
-	_, ok := x.objMap[addr]
-	return ok
+	obj, ok := x.objMap[addr]
+	return obj, ok
 }
 
-func (x *counters) Map() map[oid.Address]uint64 {
+func (x *counters) Map() map[oid.Address]cachedObject {
 	x.mu.RLock()
 	defer x.mu.RUnlock()
 	return maps.Clone(x.objMap)
 }
 
 func (c *cache) initCounters() error {
-	var sizeHandler = func(addr oid.Address, size uint64) error {
-		if _, ok := c.objCounters.objMap[addr]; ok {
+	var (
+		seen       = make(map[oid.Address]struct{})
+		duplicates = make([][]oid.Address, len(c.stripes))
+	)
+	for i, s := range c.stripes {
+		var sizeHandler = func(addr oid.Address, size uint64) error {
+			if _, ok := seen[addr]; ok {
+				// object was put to several devices, the first copy is kept
+				duplicates[i] = append(duplicates[i], addr)
+				return nil
+			}
+			seen[addr] = struct{}{}
+			if obj, ok := c.objCounters.objMap[addr]; ok {
+				// device set may change between reopenings
+				obj.stripe = i
+				c.objCounters.objMap[addr] = obj
+				return nil
+			}
+			c.objCounters.add(addr, cachedObject{size: size, stripe: i})
+			c.metrics.IncWCObjectCount()
+			c.metrics.AddWCSize(size)
 			return nil
 		}
-		c.objCounters.Add(addr, size)
-		c.metrics.IncWCObjectCount()
-		c.metrics.AddWCSize(size)
-		return nil
+
+		err := s.fsTree.IterateSizes(sizeHandler, false)
+		if err != nil {
+			return fmt.Errorf("could not read write-cache FS counter (%s): %w", s.path, err)
+		}
 	}
+	c.updateFillRatio()
 
-	err := c.fsTree.IterateSizes(sizeHandler, false)
-	if err != nil {
-		return fmt.Errorf("could not read write-cache FS counter: %w", err)
+	if c.readOnly() {
+		return nil
+	}
+	for i := range duplicates {
+		for _, addr := range duplicates[i] {
+			if err := c.stripes[i].fsTree.Delete(addr); err != nil {
+				c.log.Warn("could not remove duplicated object from write-cache device",
+					zap.String("path", c.stripes[i].path), zap.Stringer("address", addr), zap.Error(err))
+			}
+		}
 	}
 
 	return nil
@@ -13,9 +13,13 @@ type ObjectStatus struct {
 func (c *cache) ObjectStatus(address oid.Address) (ObjectStatus, error) {
 	var res ObjectStatus
 
-	_, err := c.fsTree.Get(address)
+	s, err := c.stripeOf(address)
+	if err != nil {
+		return res, err
+	}
+	_, err = s.fsTree.Get(address)
 	if err == nil {
-		res.PathFSTree = c.fsTree.Path()
+		res.PathFSTree = s.fsTree.Path()
 	}
 	return res, err
 }
@@ -1,32 +1,134 @@
 package writecache
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"os"
+	"sync"
+	"sync/atomic"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
 )
 
 const dbName = "small.bolt"
 
+// putLockNum is a number of locks serializing puts of the same object.
+const putLockNum = 64
+
+// errNoStripes is returned when there are no write-cache devices left to
+// write objects to.
+var errNoStripes = errors.New("all write-cache devices failed")
+
+// stripe is a single write-cache device. Objects are striped across all
+// devices of the write-cache.
+type stripe struct {
+	path   string
+	fsTree *fstree.FSTree
+	// failed is set when writing to the device fails. Objects are no longer
+	// put to the failed device, but the ones already stored there are still
+	// read and flushed.
+	failed atomic.Bool
+}
+
 func (c *cache) openStore(readOnly bool) error {
-	err := util.MkdirAllX(c.path, os.ModePerm)
+	c.stripes = make([]*stripe, 0, len(c.paths))
+
+	for _, p := range c.paths {
+		s, err := c.openStripe(p, readOnly)
+		if err != nil {
+			if len(c.paths) == 1 {
+				return err
+			}
+			c.log.Error("could not open write-cache device, skipping it",
+				zap.String("path", p), zap.Error(err))
+			continue
+		}
+		c.stripes = append(c.stripes, s)
+	}
+
+	if len(c.stripes) == 0 {
+		return errNoStripes
+	}
+
+	return nil
+}
+
+func (c *cache) openStripe(path string, readOnly bool) (*stripe, error) {
+	err := util.MkdirAllX(path, os.ModePerm)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	c.fsTree = fstree.New(
-		fstree.WithPath(c.path),
+	fsTree := fstree.New(
+		fstree.WithPath(path),
 		fstree.WithPerm(os.ModePerm),
 		fstree.WithDepth(1),
 		fstree.WithDirNameLen(1),
 		fstree.WithNoSync(c.noSync),
 		fstree.WithCombinedCountLimit(1))
-	c.fsTree.SetLogger(c.log)
-	if err := c.fsTree.Open(readOnly); err != nil {
-		return fmt.Errorf("could not open FSTree: %w", err)
+	fsTree.SetLogger(c.log)
+	if c.encryption != nil {
+		fsTree.SetCompressor(&compression.Config{Encryption: c.encryption})
+	}
+	if err := fsTree.Open(readOnly); err != nil {
+		return nil, fmt.Errorf("could not open FSTree: %w", err)
 	}
 
-	return nil
+	return &stripe{path: path, fsTree: fsTree}, nil
+}
+
+// stripeOf returns device storing the referenced object. Returns
+// [apistatus.ObjectNotFound] if the object is not cached.
+func (c *cache) stripeOf(addr oid.Address) (*stripe, error) {
+	obj, ok := c.objCounters.Get(addr)
+	if !ok || obj.stripe >= len(c.stripes) {
+		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+	return c.stripes[obj.stripe], nil
+}
+
+// putLock returns lock serializing puts of the referenced object.
+func (c *cache) putLock(addr oid.Address) *sync.Mutex {
+	id := addr.Object()
+	return &c.putLocks[binary.BigEndian.Uint16(id[:])%putLockNum]
+}
+
+// putToStripe writes object to the device it is already stored on or to the
+// next healthy device in turn. If writing fails, the device is marked as
+// failed and the next healthy one is tried. Returns index of the device the
+// object was written to.
+func (c *cache) putToStripe(addr oid.Address, data []byte) (int, error) {
+	if obj, ok := c.objCounters.Get(addr); ok && obj.stripe < len(c.stripes) && !c.stripes[obj.stripe].failed.Load() {
+		return obj.stripe, c.stripes[obj.stripe].fsTree.Put(addr, data)
+	}
+
+	for range c.stripes {
+		i := int(c.nextStripe.Add(1) % uint32(len(c.stripes)))
+		s := c.stripes[i]
+		if s.failed.Load() {
+			continue
+		}
+
+		err := s.fsTree.Put(addr, data)
+		if err == nil {
+			return i, nil
+		}
+
+		if len(c.stripes) == 1 {
+			return 0, err
+		}
+		if s.failed.CompareAndSwap(false, true) {
+			c.log.Error("write-cache device failed, objects are no longer written to it",
+				zap.String("path", s.path), zap.Error(err))
+		}
+	}
+
+	return 0, errNoStripes
 }
@@ -0,0 +1,197 @@
+package writecache
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func newStripedCache(t *testing.T, n int) (*cache, []string) {
+	dir := t.TempDir()
+	paths := make([]string, n)
+	for i := range paths {
+		paths[i] = filepath.Join(dir, "dev"+string(rune('0'+i)))
+	}
+
+	wc, _ := newCache(t, WithPaths(paths...))
+	t.Cleanup(func() { _ = wc.Close() })
+
+	return wc.(*cache), paths
+}
+
+// breakDevice makes the directory unusable regardless of the process
+// privileges by replacing it with a regular file.
+func breakDevice(t *testing.T, path string) {
+	require.NoError(t, os.RemoveAll(path))
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+}
+
+func TestCache_Stripes(t *testing.T) {
+	c, paths := newStripedCache(t, 3)
+
+	objects := make([]objectPair, 6)
+	for i := range objects {
+		objects[i] = putObject(t, c, 1)
+	}
+
+	for i := range c.stripes {
+		var n int
+		require.NoError(t, c.stripes[i].fsTree.IterateAddresses(func(oid.Address) error {
+			n++
+			return nil
+		}, false))
+		require.Equal(t, 2, n, paths[i])
+	}
+
+	for _, o := range objects {
+		res, err := c.Get(o.addr)
+		require.NoError(t, err)
+		require.Equal(t, o.obj, res)
+
+		st, err := c.ObjectStatus(o.addr)
+		require.NoError(t, err)
+		require.Contains(t, paths, st.PathFSTree)
+	}
+
+	require.Equal(t, paths, c.DumpInfo().Paths)
+	require.Equal(t, paths[0], c.DumpInfo().Path)
+
+	require.NoError(t, c.Close())
+	require.NoError(t, c.Open(false))
+	require.NoError(t, c.Init())
+
+	for _, o := range objects {
+		_, err := c.Get(o.addr)
+		require.NoError(t, err)
+	}
+}
+
+func TestCache_ConcurrentPut(t *testing.T) {
+	c, _ := newStripedCache(t, 3)
+
+	obj, data := newObject(t, 1)
+	addr := objectCore.AddressOf(obj)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, c.Put(addr, obj, data))
+		}()
+	}
+	wg.Wait()
+
+	var n int
+	for i := range c.stripes {
+		_, err := c.stripes[i].fsTree.Get(addr)
+		if err == nil {
+			n++
+		}
+	}
+	require.LessOrEqual(t, n, 1)
+	require.LessOrEqual(t, c.objCounters.Size(), uint64(len(data)))
+}
+
+func TestCache_DuplicatesOnOpen(t *testing.T) {
+	c, _ := newStripedCache(t, 2)
+
+	obj, data := newObject(t, 1)
+	addr := objectCore.AddressOf(obj)
+	for i := range c.stripes {
+		require.NoError(t, c.stripes[i].fsTree.Put(addr, data))
+	}
+
+	require.NoError(t, c.Close())
+	require.NoError(t, c.Open(true))
+
+	var n int
+	for i := range c.stripes {
+		if _, err := c.stripes[i].fsTree.Get(addr); err == nil {
+			n++
+		}
+	}
+	require.Equal(t, 2, n) // nothing is removed in read-only mode
+	require.EqualValues(t, len(data), c.objCounters.Size())
+
+	require.NoError(t, c.Close())
+	require.NoError(t, c.Open(false))
+
+	n = 0
+	for i := range c.stripes {
+		if _, err := c.stripes[i].fsTree.Get(addr); err == nil {
+			n++
+		}
+	}
+	require.Equal(t, 1, n)
+	require.EqualValues(t, len(data), c.objCounters.Size())
+
+	res, err := c.Get(addr)
+	require.NoError(t, err)
+	require.Equal(t, obj, res)
+}
+
+func TestCache_StripeFailure(t *testing.T) {
+	t.Run("on put", func(t *testing.T) {
+		c, paths := newStripedCache(t, 2)
+
+		lost := putObject(t, c, 1)
+		lostStripe, err := c.stripeOf(lost.addr)
+		require.NoError(t, err)
+
+		breakDevice(t, lostStripe.path)
+
+		objects := make([]objectPair, 4)
+		for i := range objects {
+			objects[i] = putObject(t, c, 1)
+		}
+		require.True(t, lostStripe.failed.Load())
+
+		for _, o := range objects {
+			st, err := c.ObjectStatus(o.addr)
+			require.NoError(t, err)
+			require.NotEqual(t, lostStripe.path, st.PathFSTree)
+			require.Contains(t, paths, st.PathFSTree)
+		}
+
+		// object on the failed device can't be flushed, it is dropped
+		require.NoError(t, c.SetMode(mode.ReadOnly))
+		_, err = c.getObject(lostStripe, lost.addr)
+		require.Error(t, err)
+		require.False(t, c.objCounters.HasAddress(lost.addr))
+	})
+
+	t.Run("all devices", func(t *testing.T) {
+		c, paths := newStripedCache(t, 2)
+
+		for _, p := range paths {
+			breakDevice(t, p)
+		}
+
+		obj, data := newObject(t, 1)
+		require.ErrorIs(t, c.Put(objectCore.AddressOf(obj), obj, data), errNoStripes)
+	})
+
+	t.Run("on open", func(t *testing.T) {
+		c, paths := newStripedCache(t, 2)
+		require.NoError(t, c.Close())
+
+		breakDevice(t, paths[0])
+
+		require.NoError(t, c.Open(false))
+		require.NoError(t, c.Init())
+		require.Len(t, c.stripes, 1)
+		require.Equal(t, paths[1], c.stripes[0].path)
+
+		o := putObject(t, c, 1)
+		st, err := c.ObjectStatus(o.addr)
+		require.NoError(t, err)
+		require.Equal(t, paths[1], st.PathFSTree)
+	})
+}
@@ -1,14 +1,15 @@
 package writecache
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -18,8 +19,11 @@ import (
 
 // Info groups the information about write-cache.
 type Info struct {
-	// Full path to the write-cache.
+	// Full path to the write-cache. The first one for the write-cache
+	// spanning several devices.
 	Path string
+	// Full paths to all write-cache devices.
+	Paths []string
 }
 
 // Cache represents write-cache for objects.
@@ -39,6 +43,12 @@ type Cache interface {
 	Delete(oid.Address) error
 	Iterate(func(oid.Address, []byte) error, bool) error
 	Put(oid.Address, *object.Object, []byte) error
+	// Throttle delays PUT of the object of the given size according to the
+	// Cache fill, see [WithMaxPutDelay]. It must be called before Put without
+	// holding any resources needed by other operations.
+	//
+	// Returns ErrOutOfSpace if the object does not fit into the Cache.
+	Throttle(size uint64) error
 	SetMode(mode.Mode) error
 	SetLogger(*zap.Logger)
 	SetShardIDMetrics(string)
@@ -66,10 +76,22 @@ type cache struct {
 	processingBigObjs sync.Map
 	// closeCh is close channel.
 	closeCh chan struct{}
+	// closeCtx is canceled together with closeCh, it interrupts flush
+	// operations waiting for the main storage I/O.
+	closeCtx    context.Context
+	cancelClose context.CancelFunc
 	// wg is a wait group for flush workers.
 	wg sync.WaitGroup
-	// fsTree contains big files stored directly on file-system.
-	fsTree *fstree.FSTree
+	// stripes are the write-cache devices objects are striped across.
+	stripes []*stripe
+	// nextStripe is a counter selecting device for the next object.
+	nextStripe atomic.Uint32
+	// putLocks serialize puts of the same object, so its copies are not
+	// written to different devices.
+	putLocks [putLockNum]sync.Mutex
+	// flushLatency is a moving average of the main storage write latency
+	// in nanoseconds.
+	flushLatency atomic.Int64
 }
 
 // wcStorageType is used for write-cache operations logging.
@@ -95,13 +117,14 @@ func New(opts ...Option) Cache {
 		flushCh:    make(chan oid.Address),
 		flushErrCh: make(chan struct{}, 1),
 		mode:       mode.ReadWrite,
+		closeCtx:   context.Background(),
 
 		options: options{
 			log:          zap.NewNop(),
 			metrics:      new(metricsWithID),
 			maxCacheSize: defaultMaxCacheSize,
 			objCounters: counters{
-				objMap: make(map[oid.Address]uint64),
+				objMap: make(map[oid.Address]cachedObject),
 			},
 			workersCount:           defaultWorkerCount,
 			maxFlushBatchSize:      defaultMaxBatchSize,
@@ -129,7 +152,8 @@ func (c *cache) SetShardIDMetrics(id string) {
 
 func (c *cache) DumpInfo() Info {
 	return Info{
-		Path: c.path,
+		Path:  c.paths[0],
+		Paths: c.paths,
 	}
 }
 
@@ -142,9 +166,9 @@ func (c *cache) Open(readOnly bool) error {
 
 	// Opening after Close is done during maintenance mode,
 	// thus we need to create a channel here.
-	c.closeCh = make(chan struct{})
-
 	c.modeMtx.Lock()
+	c.closeCh = make(chan struct{})
+	c.closeCtx, c.cancelClose = context.WithCancel(context.Background())
 	if readOnly {
 		c.mode = mode.ReadOnly
 	} else {
@@ -157,9 +181,16 @@ func (c *cache) Open(readOnly bool) error {
 
 // Init runs necessary services. No-op in read-only mode.
 func (c *cache) Init() error {
-	err := c.fsTree.Init()
-	if err != nil {
-		return fmt.Errorf("init FSTree: %w", err)
+	for _, s := range c.stripes {
+		err := s.fsTree.Init()
+		if err != nil {
+			if len(c.stripes) == 1 {
+				return fmt.Errorf("init FSTree: %w", err)
+			}
+			s.failed.Store(true)
+			c.log.Error("could not init write-cache device, objects are not written to it",
+				zap.String("path", s.path), zap.Error(err))
+		}
 	}
 
 	c.modeMtx.Lock()
@@ -192,16 +223,19 @@ func (c *cache) Close() error {
 	if c.closeCh != nil {
 		close(c.closeCh)
 	}
-	c.wg.Wait()
-	if c.closeCh != nil {
-		c.closeCh = nil
+	if c.cancelClose != nil {
+		c.cancelClose()
 	}
+	c.wg.Wait()
+	c.modeMtx.Lock()
+	c.closeCh = nil
+	c.modeMtx.Unlock()
 
 	return nil
 }
 
 func (c *cache) migrate() error {
-	path := filepath.Join(c.path, dbName)
+	path := filepath.Join(c.stripes[0].path, dbName)
 	_, err := os.Stat(path)
 	if errors.Is(err, os.ErrNotExist) {
 		c.log.Debug("no migration needed, there is no database file")
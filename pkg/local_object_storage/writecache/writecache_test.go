@@ -2,6 +2,7 @@ package writecache
 
 import (
 	"testing"
+	"time"
 
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
@@ -28,3 +29,18 @@ func TestCache_InitReadOnly(t *testing.T) {
 	err = wc.Init()
 	require.NoError(t, err)
 }
+
+func TestCache_PutClosed(t *testing.T) {
+	const maxDelay = time.Second
+	wc, _ := newCache(t, WithMaxPutDelay(maxDelay))
+
+	obj, data := newObject(t, 0)
+	addr := objectcore.AddressOf(obj)
+
+	require.NoError(t, wc.Close())
+
+	start := time.Now()
+	require.ErrorIs(t, wc.Throttle(uint64(len(data))), ErrReadOnly)
+	require.Less(t, time.Since(start), maxDelay/4)
+	require.ErrorIs(t, wc.Put(addr, obj, data), ErrReadOnly)
+}
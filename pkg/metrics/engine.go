@@ -23,6 +23,29 @@ type (
 		containerSize prometheus.GaugeVec
 		payloadSize   prometheus.GaugeVec
 		capacitySize  prometheus.GaugeVec
+		freeSpace     prometheus.GaugeVec
+
+		compressionSaved   prometheus.CounterVec
+		compressionSkipped prometheus.CounterVec
+
+		gcRemovedObjects prometheus.CounterVec
+		gcRunDuration    prometheus.HistogramVec
+		gcBacklog        prometheus.GaugeVec
+		gcLastRun        prometheus.GaugeVec
+
+		scrubProblems prometheus.CounterVec
+		scrubChecked  prometheus.CounterVec
+		scrubLastRun  prometheus.GaugeVec
+
+		payloadScrubBytes prometheus.CounterVec
+		corruptedObjects  prometheus.CounterVec
+
+		resyncDonePartitions  prometheus.GaugeVec
+		resyncTotalPartitions prometheus.GaugeVec
+		resyncObjects         prometheus.CounterVec
+		tierMovedObjects      prometheus.CounterVec
+		objectCacheHits       prometheus.CounterVec
+		objectCacheMisses     prometheus.CounterVec
 	}
 )
 
@@ -129,6 +152,132 @@ func newEngineMetrics() engineMetrics {
 			Name:      "capacity",
 			Help:      "Contains the shard's capacity",
 		}, []string{shardIDLabelKey})
+
+		freeSpace = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "free_space",
+			Help:      "Number of bytes available for writing on the shard's disk",
+		}, []string{shardIDLabelKey})
+
+		compressionSaved = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "compression_saved_bytes",
+			Help:      "Accumulated number of bytes saved by objects compression in a shard",
+		}, []string{shardIDLabelKey})
+
+		compressionSkipped = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "compression_skipped_objects",
+			Help:      "Number of objects stored uncompressed in a shard because compression is useless for them",
+		}, []string{shardIDLabelKey})
+
+		gcRemovedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "gc_removed_objects",
+			Help:      "Number of objects processed by shard GC by category",
+		}, []string{shardIDLabelKey, gcCategoryLabelKey})
+
+		gcRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "gc_run_time",
+			Help:      "Shard GC garbage removal run time",
+		}, []string{shardIDLabelKey})
+
+		gcBacklog = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "gc_backlog",
+			Help:      "Number of objects marked as garbage and waiting to be removed from a shard, counted on explicit GC runs",
+		}, []string{shardIDLabelKey})
+
+		gcLastRun = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "gc_last_run_timestamp",
+			Help:      "Unix time of the last shard GC garbage removal run",
+		}, []string{shardIDLabelKey})
+
+		scrubProblems = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "scrub_problems",
+			Help:      "Number of problems found and repaired by shard metabase scrub by type",
+		}, []string{shardIDLabelKey, scrubProblemLabelKey})
+
+		scrubChecked = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "scrub_checked_objects",
+			Help:      "Number of objects checked by shard metabase scrub",
+		}, []string{shardIDLabelKey})
+
+		scrubLastRun = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "scrub_last_run_timestamp",
+			Help:      "Unix time of the last shard metabase scrub",
+		}, []string{shardIDLabelKey})
+
+		payloadScrubBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "payload_scrub_bytes",
+			Help:      "Number of bytes read by shard payload scrub",
+		}, []string{shardIDLabelKey})
+
+		corruptedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "corrupted_objects",
+			Help:      "Number of corrupted objects found by shard payload scrub and quarantined",
+		}, []string{shardIDLabelKey})
+
+		resyncDonePartitions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "resync_done_partitions",
+			Help:      "Number of storage partitions resynchronized to the shard metabase, zero if there is no resync in progress",
+		}, []string{shardIDLabelKey})
+
+		resyncTotalPartitions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "resync_total_partitions",
+			Help:      "Number of storage partitions to resynchronize to the shard metabase, zero if there is no resync in progress",
+		}, []string{shardIDLabelKey})
+
+		resyncObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "resync_objects",
+			Help:      "Number of objects resynchronized to the shard metabase",
+		}, []string{shardIDLabelKey})
+
+		tierMovedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "tier_moved_objects",
+			Help:      "Number of objects moved between storage tiers",
+		}, []string{tierDirectionLabelKey})
+
+		objectCacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "object_cache_hits",
+			Help:      "Number of object requests served from the in-memory object cache",
+		}, []string{cacheOpLabelKey})
+
+		objectCacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: engineSubsystem,
+			Name:      "object_cache_misses",
+			Help:      "Number of object requests not found in the in-memory object cache",
+		}, []string{cacheOpLabelKey})
 	)
 
 	return engineMetrics{
@@ -146,6 +295,24 @@ func newEngineMetrics() engineMetrics {
 		containerSize:                 *containerSize,
 		payloadSize:                   *payloadSize,
 		capacitySize:                  *capacitySize,
+		freeSpace:                     *freeSpace,
+		compressionSaved:              *compressionSaved,
+		compressionSkipped:            *compressionSkipped,
+		gcRemovedObjects:              *gcRemovedObjects,
+		gcRunDuration:                 *gcRunDuration,
+		gcBacklog:                     *gcBacklog,
+		gcLastRun:                     *gcLastRun,
+		scrubProblems:                 *scrubProblems,
+		scrubChecked:                  *scrubChecked,
+		scrubLastRun:                  *scrubLastRun,
+		payloadScrubBytes:             *payloadScrubBytes,
+		corruptedObjects:              *corruptedObjects,
+		resyncDonePartitions:          *resyncDonePartitions,
+		resyncTotalPartitions:         *resyncTotalPartitions,
+		resyncObjects:                 *resyncObjects,
+		tierMovedObjects:              *tierMovedObjects,
+		objectCacheHits:               *objectCacheHits,
+		objectCacheMisses:             *objectCacheMisses,
 	}
 }
 
@@ -164,6 +331,24 @@ func (m engineMetrics) register() {
 	prometheus.MustRegister(m.containerSize)
 	prometheus.MustRegister(m.payloadSize)
 	prometheus.MustRegister(m.capacitySize)
+	prometheus.MustRegister(m.freeSpace)
+	prometheus.MustRegister(m.compressionSaved)
+	prometheus.MustRegister(m.compressionSkipped)
+	prometheus.MustRegister(m.gcRemovedObjects)
+	prometheus.MustRegister(m.gcRunDuration)
+	prometheus.MustRegister(m.gcBacklog)
+	prometheus.MustRegister(m.gcLastRun)
+	prometheus.MustRegister(m.scrubProblems)
+	prometheus.MustRegister(m.scrubChecked)
+	prometheus.MustRegister(m.scrubLastRun)
+	prometheus.MustRegister(m.payloadScrubBytes)
+	prometheus.MustRegister(m.corruptedObjects)
+	prometheus.MustRegister(m.resyncDonePartitions)
+	prometheus.MustRegister(m.resyncTotalPartitions)
+	prometheus.MustRegister(m.resyncObjects)
+	prometheus.MustRegister(m.tierMovedObjects)
+	prometheus.MustRegister(m.objectCacheHits)
+	prometheus.MustRegister(m.objectCacheMisses)
 }
 
 func (m engineMetrics) AddListContainersDuration(d time.Duration) {
@@ -225,3 +410,78 @@ func (m engineMetrics) AddToPayloadCounter(shardID string, size int64) {
 func (m engineMetrics) SetCapacitySize(shardID string, capacity uint64) {
 	m.capacitySize.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(capacity))
 }
+
+func (m engineMetrics) AddToCompressionSaved(shardID string, size int64) {
+	m.compressionSaved.With(prometheus.Labels{shardIDLabelKey: shardID}).Add(float64(size))
+}
+
+func (m engineMetrics) IncCompressionSkipped(shardID string) {
+	m.compressionSkipped.With(prometheus.Labels{shardIDLabelKey: shardID}).Inc()
+}
+
+func (m engineMetrics) AddGCRemovedObjects(shardID, category string, count uint64) {
+	m.gcRemovedObjects.With(prometheus.Labels{
+		shardIDLabelKey:    shardID,
+		gcCategoryLabelKey: category,
+	}).Add(float64(count))
+}
+
+func (m engineMetrics) AddGCRunDuration(shardID string, d time.Duration) {
+	m.gcRunDuration.With(prometheus.Labels{shardIDLabelKey: shardID}).Observe(d.Seconds())
+}
+
+func (m engineMetrics) SetGCBacklog(shardID string, count uint64) {
+	m.gcBacklog.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(count))
+}
+
+func (m engineMetrics) SetGCLastRun(shardID string, t time.Time) {
+	m.gcLastRun.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(t.Unix()))
+}
+
+func (m engineMetrics) AddScrubProblems(shardID, kind string, count uint64) {
+	m.scrubProblems.With(prometheus.Labels{
+		shardIDLabelKey:      shardID,
+		scrubProblemLabelKey: kind,
+	}).Add(float64(count))
+}
+
+func (m engineMetrics) AddScrubCheckedObjects(shardID string, count uint64) {
+	m.scrubChecked.With(prometheus.Labels{shardIDLabelKey: shardID}).Add(float64(count))
+}
+
+func (m engineMetrics) SetScrubLastRun(shardID string, t time.Time) {
+	m.scrubLastRun.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(t.Unix()))
+}
+
+func (m engineMetrics) AddPayloadScrubBytes(shardID string, size uint64) {
+	m.payloadScrubBytes.With(prometheus.Labels{shardIDLabelKey: shardID}).Add(float64(size))
+}
+
+func (m engineMetrics) IncCorruptedObjects(shardID string) {
+	m.corruptedObjects.With(prometheus.Labels{shardIDLabelKey: shardID}).Inc()
+}
+
+func (m engineMetrics) SetResyncProgress(shardID string, done, total uint64) {
+	m.resyncDonePartitions.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(done))
+	m.resyncTotalPartitions.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(total))
+}
+
+func (m engineMetrics) AddResyncObjects(shardID string, count uint64) {
+	m.resyncObjects.With(prometheus.Labels{shardIDLabelKey: shardID}).Add(float64(count))
+}
+
+func (m engineMetrics) AddTierMovedObjects(direction string, count uint64) {
+	m.tierMovedObjects.With(prometheus.Labels{tierDirectionLabelKey: direction}).Add(float64(count))
+}
+
+func (m engineMetrics) IncObjectCacheHit(op string) {
+	m.objectCacheHits.With(prometheus.Labels{cacheOpLabelKey: op}).Inc()
+}
+
+func (m engineMetrics) IncObjectCacheMiss(op string) {
+	m.objectCacheMisses.With(prometheus.Labels{cacheOpLabelKey: op}).Inc()
+}
+
+func (m engineMetrics) SetFreeSpace(shardID string, size uint64) {
+	m.freeSpace.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(size))
+}
@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	fsChainSubsystem = "fschain"
+
+	fsChainMethodLabelKey   = "method"
+	fsChainSuccessLabelKey  = "success"
+	fsChainEndpointLabelKey = "endpoint"
+
+	// fixed8Factor is a number of fractional GAS units in one GAS.
+	fixed8Factor = 1e8
+)
+
+type fsChainMetrics struct {
+	rpcDuration          *prometheus.HistogramVec
+	invocationGAS        *prometheus.CounterVec
+	notaryDeposit        prometheus.Gauge
+	endpoint             *prometheus.GaugeVec
+	endpointSwitches     prometheus.Counter
+	subscriptionRestores prometheus.Counter
+}
+
+func newFSChainMetrics(namespace string) fsChainMetrics {
+	return fsChainMetrics{
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: fsChainSubsystem,
+			Name:      "rpc_duration",
+			Help:      "FS chain RPC call latency by method",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 9),
+		}, []string{fsChainMethodLabelKey, fsChainSuccessLabelKey}),
+		invocationGAS: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: fsChainSubsystem,
+			Name:      "invocation_gas",
+			Help:      "GAS paid for the FS chain transactions by contract method",
+		}, []string{fsChainMethodLabelKey}),
+		notaryDeposit: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: fsChainSubsystem,
+			Name:      "notary_deposit",
+			Help:      "Notary deposit of the node account in GAS",
+		}),
+		endpoint: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: fsChainSubsystem,
+			Name:      "active_endpoint",
+			Help:      "FS chain RPC endpoint the node is connected to, no series if the connection is lost",
+		}, []string{fsChainEndpointLabelKey}),
+		endpointSwitches: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: fsChainSubsystem,
+			Name:      "endpoint_switches",
+			Help:      "Number of switches to another FS chain RPC endpoint after connection loss",
+		}),
+		subscriptionRestores: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: fsChainSubsystem,
+			Name:      "subscription_reconnects",
+			Help:      "Number of FS chain event subscriptions restored after endpoint switch",
+		}),
+	}
+}
+
+func (m fsChainMetrics) register() {
+	prometheus.MustRegister(m.rpcDuration)
+	prometheus.MustRegister(m.invocationGAS)
+	prometheus.MustRegister(m.notaryDeposit)
+	prometheus.MustRegister(m.endpoint)
+	prometheus.MustRegister(m.endpointSwitches)
+	prometheus.MustRegister(m.subscriptionRestores)
+}
+
+func (m fsChainMetrics) AddFSChainRPCDuration(method string, success bool, d time.Duration) {
+	m.rpcDuration.With(prometheus.Labels{
+		fsChainMethodLabelKey:  method,
+		fsChainSuccessLabelKey: strconv.FormatBool(success),
+	}).Observe(d.Seconds())
+}
+
+func (m fsChainMetrics) AddFSChainInvocationGAS(method string, gas int64) {
+	m.invocationGAS.With(prometheus.Labels{fsChainMethodLabelKey: method}).Add(float64(gas) / fixed8Factor)
+}
+
+func (m fsChainMetrics) SetFSChainNotaryDeposit(amount int64) {
+	m.notaryDeposit.Set(float64(amount) / fixed8Factor)
+}
+
+func (m fsChainMetrics) SetFSChainEndpoint(endpoint string) {
+	m.endpoint.Reset()
+	if endpoint != "" {
+		m.endpoint.With(prometheus.Labels{fsChainEndpointLabelKey: endpoint}).Set(1)
+	}
+}
+
+func (m fsChainMetrics) IncFSChainEndpointSwitches() {
+	m.endpointSwitches.Inc()
+}
+
+func (m fsChainMetrics) IncFSChainSubscriptionReconnects() {
+	m.subscriptionRestores.Inc()
+}
@@ -1,15 +1,34 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
-const innerRingNameSpace = "neofs_ir"
+const (
+	innerRingNameSpace = "neofs_ir"
+
+	processorSubsystem = "processor"
+
+	processorLabelKey = "processor"
+)
 
 // InnerRingServiceMetrics contains metrics collected by inner ring.
 type InnerRingServiceMetrics struct {
+	fsChainMetrics
+
 	epoch       prometheus.Gauge
 	healthCheck prometheus.Gauge
+
+	receivedEvents       *prometheus.CounterVec
+	handledEvents        *prometheus.CounterVec
+	droppedEvents        *prometheus.CounterVec
+	eventHandlingTime    *prometheus.HistogramVec
+	notaryRequestsSent   *prometheus.CounterVec
+	notaryRequestsFailed *prometheus.CounterVec
+	busyWorkers          *prometheus.GaugeVec
+	poolCapacity         *prometheus.GaugeVec
 }
 
 // NewInnerRingMetrics returns new instance of metrics collectors for inner ring.
@@ -32,10 +51,72 @@ func NewInnerRingMetrics(version string) InnerRingServiceMetrics {
 	})
 	prometheus.MustRegister(healthCheck)
 
-	return InnerRingServiceMetrics{
-		epoch:       epoch,
-		healthCheck: healthCheck,
+	fsChain := newFSChainMetrics(innerRingNameSpace)
+	fsChain.register()
+
+	m := InnerRingServiceMetrics{
+		fsChainMetrics: fsChain,
+		epoch:          epoch,
+		healthCheck:    healthCheck,
+		receivedEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: innerRingNameSpace,
+			Subsystem: processorSubsystem,
+			Name:      "received_events",
+			Help:      "Number of events submitted to the processor",
+		}, []string{processorLabelKey}),
+		handledEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: innerRingNameSpace,
+			Subsystem: processorSubsystem,
+			Name:      "handled_events",
+			Help:      "Number of events handled by the processor",
+		}, []string{processorLabelKey}),
+		droppedEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: innerRingNameSpace,
+			Subsystem: processorSubsystem,
+			Name:      "dropped_events",
+			Help:      "Number of events dropped because the processor worker pool was full",
+		}, []string{processorLabelKey}),
+		eventHandlingTime: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: innerRingNameSpace,
+			Subsystem: processorSubsystem,
+			Name:      "event_handling_time",
+			Help:      "Processor event handling time",
+		}, []string{processorLabelKey}),
+		notaryRequestsSent: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: innerRingNameSpace,
+			Subsystem: processorSubsystem,
+			Name:      "notary_requests_sent",
+			Help:      "Number of notary requests sent by the processor",
+		}, []string{processorLabelKey}),
+		notaryRequestsFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: innerRingNameSpace,
+			Subsystem: processorSubsystem,
+			Name:      "notary_requests_failed",
+			Help:      "Number of notary requests the processor failed to send",
+		}, []string{processorLabelKey}),
+		busyWorkers: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: innerRingNameSpace,
+			Subsystem: processorSubsystem,
+			Name:      "busy_workers",
+			Help:      "Number of the processor workers handling events",
+		}, []string{processorLabelKey}),
+		poolCapacity: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: innerRingNameSpace,
+			Subsystem: processorSubsystem,
+			Name:      "pool_capacity",
+			Help:      "Capacity of the processor worker pool",
+		}, []string{processorLabelKey}),
 	}
+	prometheus.MustRegister(m.receivedEvents)
+	prometheus.MustRegister(m.handledEvents)
+	prometheus.MustRegister(m.droppedEvents)
+	prometheus.MustRegister(m.eventHandlingTime)
+	prometheus.MustRegister(m.notaryRequestsSent)
+	prometheus.MustRegister(m.notaryRequestsFailed)
+	prometheus.MustRegister(m.busyWorkers)
+	prometheus.MustRegister(m.poolCapacity)
+
+	return m
 }
 
 // SetEpoch updates epoch metrics.
@@ -46,3 +127,49 @@ func (m InnerRingServiceMetrics) SetEpoch(epoch uint64) {
 func (m InnerRingServiceMetrics) SetHealthCheck(healthCheck int32) {
 	m.healthCheck.Set(float64(healthCheck))
 }
+
+// IncProcessorReceivedEvents increments number of events submitted to the
+// processor.
+func (m InnerRingServiceMetrics) IncProcessorReceivedEvents(processor string) {
+	m.receivedEvents.With(prometheus.Labels{processorLabelKey: processor}).Inc()
+}
+
+// IncProcessorHandledEvents increments number of events handled by the
+// processor.
+func (m InnerRingServiceMetrics) IncProcessorHandledEvents(processor string) {
+	m.handledEvents.With(prometheus.Labels{processorLabelKey: processor}).Inc()
+}
+
+// IncProcessorDroppedEvents increments number of events dropped by the
+// processor.
+func (m InnerRingServiceMetrics) IncProcessorDroppedEvents(processor string) {
+	m.droppedEvents.With(prometheus.Labels{processorLabelKey: processor}).Inc()
+}
+
+// AddProcessorEventHandlingDuration registers duration of the processor event
+// handling.
+func (m InnerRingServiceMetrics) AddProcessorEventHandlingDuration(processor string, d time.Duration) {
+	m.eventHandlingTime.With(prometheus.Labels{processorLabelKey: processor}).Observe(d.Seconds())
+}
+
+// IncProcessorNotaryRequestsSent increments number of notary requests sent by
+// the processor.
+func (m InnerRingServiceMetrics) IncProcessorNotaryRequestsSent(processor string) {
+	m.notaryRequestsSent.With(prometheus.Labels{processorLabelKey: processor}).Inc()
+}
+
+// IncProcessorNotaryRequestsFailed increments number of notary requests the
+// processor failed to send.
+func (m InnerRingServiceMetrics) IncProcessorNotaryRequestsFailed(processor string) {
+	m.notaryRequestsFailed.With(prometheus.Labels{processorLabelKey: processor}).Inc()
+}
+
+// SetProcessorBusyWorkers sets number of the processor workers handling events.
+func (m InnerRingServiceMetrics) SetProcessorBusyWorkers(processor string, n int) {
+	m.busyWorkers.With(prometheus.Labels{processorLabelKey: processor}).Set(float64(n))
+}
+
+// SetProcessorPoolCapacity sets capacity of the processor worker pool.
+func (m InnerRingServiceMetrics) SetProcessorPoolCapacity(processor string, n int) {
+	m.poolCapacity.With(prometheus.Labels{processorLabelKey: processor}).Set(float64(n))
+}
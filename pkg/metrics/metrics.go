@@ -11,6 +11,9 @@ type NodeMetrics struct {
 	engineMetrics
 	stateMetrics
 	writecacheMetrics
+	policerMetrics
+	replicatorMetrics
+	fsChainMetrics
 	epoch prometheus.Gauge
 }
 
@@ -29,6 +32,15 @@ func NewNodeMetrics(version string) *NodeMetrics {
 	writecache := newWritecacheMetrics()
 	writecache.register()
 
+	policer := newPolicerMetrics()
+	policer.register()
+
+	replicator := newReplicatorMetrics()
+	replicator.register()
+
+	fsChain := newFSChainMetrics(storageNodeNameSpace)
+	fsChain.register()
+
 	epoch := prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: storageNodeNameSpace,
 		Subsystem: stateSubsystem,
@@ -42,6 +54,9 @@ func NewNodeMetrics(version string) *NodeMetrics {
 		engineMetrics:        engine,
 		stateMetrics:         state,
 		writecacheMetrics:    writecache,
+		policerMetrics:       policer,
+		replicatorMetrics:    replicator,
+		fsChainMetrics:       fsChain,
 		epoch:                epoch,
 	}
 }
@@ -38,13 +38,20 @@ type (
 
 		shardMetrics   *prometheus.GaugeVec
 		shardsReadonly *prometheus.GaugeVec
+
+		softQuotaExceeded *prometheus.CounterVec
 	}
 )
 
 const (
-	shardIDLabelKey     = "shard"
-	counterTypeLabelKey = "type"
-	containerIDLabelKey = "cid"
+	shardIDLabelKey       = "shard"
+	counterTypeLabelKey   = "type"
+	containerIDLabelKey   = "cid"
+	quotaTypeLabelKey     = "type"
+	gcCategoryLabelKey    = "type"
+	scrubProblemLabelKey  = "type"
+	tierDirectionLabelKey = "direction"
+	cacheOpLabelKey       = "op"
 )
 
 func newMethodCallCounter(name string) methodCount {
@@ -170,6 +177,15 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		},
 			[]string{shardIDLabelKey},
 		)
+
+		softQuotaExceeded = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: objectSubsystem,
+			Name:      "soft_quota_exceeded",
+			Help:      "Number of objects put over soft storage quota",
+		},
+			[]string{quotaTypeLabelKey},
+		)
 	)
 
 	return objectServiceMetrics{
@@ -191,6 +207,7 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		getPayload:        getPayload,
 		shardMetrics:      shardsMetrics,
 		shardsReadonly:    shardsReadonly,
+		softQuotaExceeded: softQuotaExceeded,
 	}
 }
 
@@ -216,6 +233,7 @@ func (m objectServiceMetrics) register() {
 
 	prometheus.MustRegister(m.shardMetrics)
 	prometheus.MustRegister(m.shardsReadonly)
+	prometheus.MustRegister(m.softQuotaExceeded)
 }
 
 func (m objectServiceMetrics) HandleOpExecResult(op stat.Method, success bool, d time.Duration) {
@@ -283,3 +301,13 @@ func (m objectServiceMetrics) SetReadonly(shardID string, readonly bool) {
 		},
 	).Set(flag)
 }
+
+// IncSoftQuotaExceeded increments number of objects put over soft storage
+// quota of the given type.
+func (m objectServiceMetrics) IncSoftQuotaExceeded(quotaType string) {
+	m.softQuotaExceeded.With(
+		prometheus.Labels{
+			quotaTypeLabelKey: quotaType,
+		},
+	).Inc()
+}
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const policerSubsystem = "policer"
+
+type policerMetrics struct {
+	checkedObjects  prometheus.Counter
+	shortages       prometheus.Counter
+	redundantCopies prometheus.Counter
+	queueLength     prometheus.Gauge
+	objectsInWork   prometheus.Gauge
+	passObjects     prometheus.Gauge
+	passDuration    prometheus.Histogram
+}
+
+func newPolicerMetrics() policerMetrics {
+	return policerMetrics{
+		checkedObjects: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: policerSubsystem,
+			Name:      "checked_objects",
+			Help:      "Number of objects checked for the storage policy compliance",
+		}),
+		shortages: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: policerSubsystem,
+			Name:      "shortages",
+			Help:      "Number of detected shortages of object copies or erasure-coded parts",
+		}),
+		redundantCopies: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: policerSubsystem,
+			Name:      "redundant_copies",
+			Help:      "Number of redundant local object copies removed",
+		}),
+		queueLength: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: policerSubsystem,
+			Name:      "queue_length",
+			Help:      "Number of listed objects waiting to be submitted for the check",
+		}),
+		objectsInWork: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: policerSubsystem,
+			Name:      "objects_in_work",
+			Help:      "Number of objects being checked",
+		}),
+		passObjects: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: policerSubsystem,
+			Name:      "pass_objects",
+			Help:      "Number of objects submitted for the check during the current pass over the local storage",
+		}),
+		passDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: policerSubsystem,
+			Name:      "pass_time",
+			Help:      "Duration of the complete pass over the local storage",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+		}),
+	}
+}
+
+func (m policerMetrics) register() {
+	prometheus.MustRegister(m.checkedObjects)
+	prometheus.MustRegister(m.shortages)
+	prometheus.MustRegister(m.redundantCopies)
+	prometheus.MustRegister(m.queueLength)
+	prometheus.MustRegister(m.objectsInWork)
+	prometheus.MustRegister(m.passObjects)
+	prometheus.MustRegister(m.passDuration)
+}
+
+func (m policerMetrics) IncPolicerCheckedObjects() {
+	m.checkedObjects.Inc()
+}
+
+func (m policerMetrics) IncPolicerShortage() {
+	m.shortages.Inc()
+}
+
+func (m policerMetrics) IncPolicerRedundantCopies() {
+	m.redundantCopies.Inc()
+}
+
+func (m policerMetrics) SetPolicerQueueLength(n int) {
+	m.queueLength.Set(float64(n))
+}
+
+func (m policerMetrics) SetPolicerObjectsInWork(n int) {
+	m.objectsInWork.Set(float64(n))
+}
+
+func (m policerMetrics) SetPolicerPassObjects(n uint64) {
+	m.passObjects.Set(float64(n))
+}
+
+func (m policerMetrics) ObservePolicerPassDuration(d time.Duration) {
+	m.passDuration.Observe(d.Seconds())
+}
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	replicatorSubsystem = "replicator"
+
+	nodeLabelKey = "node"
+)
+
+type replicatorMetrics struct {
+	attempts  prometheus.CounterVec
+	successes prometheus.CounterVec
+	failures  prometheus.CounterVec
+}
+
+func newReplicatorMetrics() replicatorMetrics {
+	var (
+		attempts = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: replicatorSubsystem,
+			Name:      "attempts",
+			Help:      "Number of attempts to replicate an object to the node",
+		}, []string{nodeLabelKey})
+
+		successes = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: replicatorSubsystem,
+			Name:      "successes",
+			Help:      "Number of objects successfully replicated to the node",
+		}, []string{nodeLabelKey})
+
+		failures = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: replicatorSubsystem,
+			Name:      "failures",
+			Help:      "Number of failed attempts to replicate an object to the node",
+		}, []string{nodeLabelKey})
+	)
+
+	return replicatorMetrics{
+		attempts:  *attempts,
+		successes: *successes,
+		failures:  *failures,
+	}
+}
+
+func (m replicatorMetrics) register() {
+	prometheus.MustRegister(m.attempts)
+	prometheus.MustRegister(m.successes)
+	prometheus.MustRegister(m.failures)
+}
+
+func (m replicatorMetrics) IncReplicationAttempts(node string) {
+	m.attempts.With(prometheus.Labels{nodeLabelKey: node}).Inc()
+}
+
+func (m replicatorMetrics) IncReplicationSuccesses(node string) {
+	m.successes.With(prometheus.Labels{nodeLabelKey: node}).Inc()
+}
+
+func (m replicatorMetrics) IncReplicationFailures(node string) {
+	m.failures.With(prometheus.Labels{nodeLabelKey: node}).Inc()
+}
@@ -15,6 +15,9 @@ type writecacheMetrics struct {
 
 	objectCount prometheus.GaugeVec
 	size        prometheus.GaugeVec
+
+	fillRatio     prometheus.GaugeVec
+	throttledPuts prometheus.CounterVec
 }
 
 func newWritecacheMetrics() writecacheMetrics {
@@ -53,6 +56,20 @@ func newWritecacheMetrics() writecacheMetrics {
 			Name:      "size",
 			Help:      "Size of the writecache",
 		}, []string{shardIDLabelKey})
+
+		fillRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: writecacheSubsystem,
+			Name:      "fill_ratio",
+			Help:      "Ratio of the writecache size to its capacity",
+		}, []string{shardIDLabelKey})
+
+		throttledPuts = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: writecacheSubsystem,
+			Name:      "throttled_puts",
+			Help:      "Number of writecache 'put' operations delayed because of the writecache fill",
+		}, []string{shardIDLabelKey})
 	)
 	return writecacheMetrics{
 		putDuration:         *putDuration,
@@ -60,6 +77,8 @@ func newWritecacheMetrics() writecacheMetrics {
 		flushBatchDuration:  *flushBatchDuration,
 		objectCount:         *objectCount,
 		size:                *size,
+		fillRatio:           *fillRatio,
+		throttledPuts:       *throttledPuts,
 	}
 }
 
@@ -69,6 +88,8 @@ func (m writecacheMetrics) register() {
 	prometheus.MustRegister(m.flushBatchDuration)
 	prometheus.MustRegister(m.objectCount)
 	prometheus.MustRegister(m.size)
+	prometheus.MustRegister(m.fillRatio)
+	prometheus.MustRegister(m.throttledPuts)
 }
 
 func (m writecacheMetrics) AddWCPutDuration(shardID string, d time.Duration) {
@@ -98,3 +119,11 @@ func (m writecacheMetrics) AddWCSize(shardID string, size uint64) {
 func (m writecacheMetrics) SetWCSize(shardID string, size uint64) {
 	m.size.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(size))
 }
+
+func (m writecacheMetrics) SetWCFillRatio(shardID string, ratio float64) {
+	m.fillRatio.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(ratio)
+}
+
+func (m writecacheMetrics) IncWCThrottledPuts(shardID string) {
+	m.throttledPuts.With(prometheus.Labels{shardIDLabelKey: shardID}).Inc()
+}
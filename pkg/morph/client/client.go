@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -64,7 +65,9 @@ type Client struct {
 }
 
 // Call calls specified method of the Neo smart contract with provided arguments.
-func (c *Client) Call(contract util.Uint160, method string, args ...any) (*result.Invoke, error) {
+func (c *Client) Call(contract util.Uint160, method string, args ...any) (res *result.Invoke, err error) {
+	defer c.observeRPC(method, time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -77,7 +80,9 @@ func (c *Client) Call(contract util.Uint160, method string, args ...any) (*resul
 // CallAndExpandIterator calls specified iterating method of the Neo smart
 // contract with provided arguments, and fetches iterator from the response
 // carrying up to limited number of items.
-func (c *Client) CallAndExpandIterator(contract util.Uint160, method string, maxItems int, args ...any) (*result.Invoke, error) {
+func (c *Client) CallAndExpandIterator(contract util.Uint160, method string, maxItems int, args ...any) (res *result.Invoke, err error) {
+	defer c.observeRPC(method, time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -184,7 +189,9 @@ func (c *Client) GetVersion() (*result.Version, error) {
 
 // SendRawTransaction sends specified transaction to the Neo blockchain the
 // Client connected to and returns the transaction hash.
-func (c *Client) SendRawTransaction(tx *transaction.Transaction) (util.Uint256, error) {
+func (c *Client) SendRawTransaction(tx *transaction.Transaction) (res util.Uint256, err error) {
+	defer c.observeRPC("sendrawtransaction", time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -197,7 +204,9 @@ func (c *Client) SendRawTransaction(tx *transaction.Transaction) (util.Uint256,
 // SubmitP2PNotaryRequest submits given Notary service request to the Neo
 // blockchain the Client connected to and returns the fallback transaction's
 // hash.
-func (c *Client) SubmitP2PNotaryRequest(req *payload.P2PNotaryRequest) (util.Uint256, error) {
+func (c *Client) SubmitP2PNotaryRequest(req *payload.P2PNotaryRequest) (res util.Uint256, err error) {
+	defer c.observeRPC("submitnotaryrequest", time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -298,7 +307,9 @@ func (e *notHaltStateError) Error() string {
 //
 // Note: true await flag always means additional subscription for [Client] which
 // is always limited on server side, use it carefully.
-func (c *Client) Invoke(contract util.Uint160, await, payByProxy bool, fee fixedn.Fixed8, method string, args ...any) error {
+func (c *Client) Invoke(contract util.Uint160, await, payByProxy bool, fee fixedn.Fixed8, method string, args ...any) (err error) {
+	defer c.observeRPC(method, time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -310,7 +321,15 @@ func (c *Client) Invoke(contract util.Uint160, await, payByProxy bool, fee fixed
 		act = conn.rpcProxyActor
 	}
 
-	txHash, vub, err := act.SendTunedCall(contract, method, nil, addFeeCheckerModifier(int64(fee)), args...)
+	var gas int64
+	feeChecker := addFeeCheckerModifier(int64(fee))
+	txHash, vub, err := act.SendTunedCall(contract, method, nil, func(r *result.Invoke, t *transaction.Transaction) error {
+		if err := feeChecker(r, t); err != nil {
+			return err
+		}
+		gas = t.SystemFee + t.NetworkFee
+		return nil
+	}, args...)
 	if await {
 		_, err = conn.rpcActor.Wait(txHash, vub, err)
 	}
@@ -318,6 +337,8 @@ func (c *Client) Invoke(contract util.Uint160, await, payByProxy bool, fee fixed
 		return fmt.Errorf("could not invoke %s: %w", method, err)
 	}
 
+	c.cfg.metrics.AddFSChainInvocationGAS(method, gas)
+
 	c.logger.Debug("neo client invoke",
 		zap.String("method", method),
 		zap.Uint32("vub", vub),
@@ -347,6 +368,8 @@ func (c *Client) TestInvoke(contract util.Uint160, method string, args ...any) (
 // If prefetchElements > 0, that many elements are tried to be placed on stack without
 // additional network communication (without the iterator expansion).
 func (c *Client) TestInvokeIterator(contract util.Uint160, method string, prefetchElements int, args ...any) (res []stackitem.Item, err error) {
+	defer c.observeRPC(method, time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -446,6 +469,8 @@ func (c *Client) Committee() (res keys.PublicKeys, err error) {
 
 // TxHalt returns true if transaction has been successfully executed and persisted.
 func (c *Client) TxHalt(h util.Uint256) (res bool, err error) {
+	defer c.observeRPC("getapplicationlog", time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -462,6 +487,8 @@ func (c *Client) TxHalt(h util.Uint256) (res bool, err error) {
 
 // TxHeight returns true if transaction has been successfully executed and persisted.
 func (c *Client) TxHeight(h util.Uint256) (res uint32, err error) {
+	defer c.observeRPC("gettransactionheight", time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -509,6 +536,8 @@ func (c *Client) MagicNumber() (uint32, error) {
 // BlockCount returns block count of the network
 // to which the underlying RPC node client is connected.
 func (c *Client) BlockCount() (res uint32, err error) {
+	defer c.observeRPC("getblockcount", time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -519,7 +548,9 @@ func (c *Client) BlockCount() (res uint32, err error) {
 }
 
 // GetBlockHeader returns block header by index.
-func (c *Client) GetBlockHeader(ind uint32) (*block.Header, error) {
+func (c *Client) GetBlockHeader(ind uint32) (res *block.Header, err error) {
+	defer c.observeRPC("getblock", time.Now(), &err)
+
 	conn := c.conn.Load()
 	if conn == nil {
 		return nil, ErrConnectionLost
@@ -590,7 +621,9 @@ func (c *Client) AccountVote(addr util.Uint160) (*keys.PublicKey, error) {
 // GetRawNotaryPool returns hashes of main P2PNotaryRequest transactions
 // that are currently in the RPC node's notary request pool with the
 // corresponding hashes of fallback transactions.
-func (c *Client) GetRawNotaryPool() (*result.RawNotaryPool, error) {
+func (c *Client) GetRawNotaryPool() (res *result.RawNotaryPool, err error) {
+	defer c.observeRPC("getrawnotarypool", time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -50,6 +50,8 @@ type cfg struct {
 	reconnectionRetries int
 	reconnectionDelay   time.Duration
 	rpcSwitchCb         Callback
+
+	metrics Metrics
 }
 
 const (
@@ -79,6 +81,7 @@ func defaultConfig() *cfg {
 		endpointsLock:       &sync.RWMutex{},
 		reconnectionDelay:   5 * time.Second,
 		reconnectionRetries: 5,
+		metrics:             noopMetrics{},
 	}
 }
 
@@ -148,6 +151,9 @@ func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
 		// if extra endpoints were provided via options,
 		// they will be used in switch process
 		conn, err = cli.newConnectionWS(cfg.singleCli)
+		if err == nil {
+			cfg.metrics.SetFSChainEndpoint(cfg.singleCli.Endpoint())
+		}
 	} else {
 		if len(cfg.endpoints) == 0 {
 			return nil, errors.New("no endpoints were provided")
@@ -170,6 +176,7 @@ func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
 			}
 			cli.logger.Info("outdated Neo RPC node", zap.String("endpoint", conn.client.Endpoint()), zap.Error(err))
 			conn.Close()
+			cfg.metrics.SetFSChainEndpoint("")
 		}
 		conn = cli.connEndpoints()
 		if conn == nil {
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"time"
+)
+
+// Metrics is an interface of the FS chain client metrics collector.
+type Metrics interface {
+	// AddFSChainRPCDuration registers duration of the RPC call. Method is the
+	// contract method for contract calls and invocations and the name of the
+	// Neo RPC request otherwise.
+	AddFSChainRPCDuration(method string, success bool, d time.Duration)
+	// AddFSChainInvocationGAS registers GAS (in fractional units) paid for the
+	// transaction invoking given contract method.
+	AddFSChainInvocationGAS(method string, gas int64)
+	// SetFSChainNotaryDeposit sets notary deposit (in fractional GAS units)
+	// of the client's account.
+	SetFSChainNotaryDeposit(amount int64)
+	// SetFSChainEndpoint sets RPC endpoint the client is connected to. Empty
+	// endpoint means connection is lost.
+	SetFSChainEndpoint(endpoint string)
+	// IncFSChainEndpointSwitches increments number of switches to another
+	// RPC endpoint after connection loss.
+	IncFSChainEndpointSwitches()
+	// IncFSChainSubscriptionReconnects increments number of the successful
+	// subscriptions restorations after RPC endpoint switch.
+	IncFSChainSubscriptionReconnects()
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) AddFSChainRPCDuration(string, bool, time.Duration) {}
+func (noopMetrics) AddFSChainInvocationGAS(string, int64)             {}
+func (noopMetrics) SetFSChainNotaryDeposit(int64)                     {}
+func (noopMetrics) SetFSChainEndpoint(string)                         {}
+func (noopMetrics) IncFSChainEndpointSwitches()                       {}
+func (noopMetrics) IncFSChainSubscriptionReconnects()                 {}
+
+// WithMetrics returns a client constructor option that specifies the
+// component for collecting metrics of the RPC calls and connection state.
+//
+// Ignores nil value.
+//
+// If option not provided, metrics are not collected.
+func WithMetrics(m Metrics) Option {
+	return func(c *cfg) {
+		if m != nil {
+			c.metrics = m
+		}
+	}
+}
+
+// observeRPC registers duration of the RPC call started at the given time and
+// finished with the referenced error. Intended to be deferred.
+func (c *Client) observeRPC(method string, start time.Time, errp *error) {
+	c.cfg.metrics.AddFSChainRPCDuration(method, *errp == nil, time.Since(start))
+}
+
+// Endpoint returns RPC endpoint the client is currently connected to. Returns
+// empty string if the connection is lost.
+func (c *Client) Endpoint() string {
+	var conn = c.conn.Load()
+
+	if conn == nil {
+		return ""
+	}
+
+	return conn.client.Endpoint()
+}
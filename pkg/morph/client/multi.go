@@ -13,10 +13,12 @@ func (c *Client) switchRPC() *connection {
 	if conn != nil {
 		conn.Close() // Ensure it's completed and drained.
 	}
+	c.cfg.metrics.SetFSChainEndpoint("")
 	for {
 		conn = c.connEndpoints()
 		if conn != nil {
 			c.conn.Store(conn)
+			c.cfg.metrics.IncFSChainEndpointSwitches()
 			if c.cfg.rpcSwitchCb != nil {
 				c.cfg.rpcSwitchCb()
 			}
@@ -52,6 +54,7 @@ func (c *Client) connEndpoints() *connection {
 
 		c.logger.Info("connection to RPC node has been established",
 			zap.String("endpoint", e))
+		c.cfg.metrics.SetFSChainEndpoint(e)
 
 		return conn
 	}
@@ -234,6 +234,12 @@ func (c *Client) depositNotary(conn *connection, amount fixedn.Fixed8, till int6
 //
 // This function must be invoked with notary enabled otherwise it throws panic.
 func (c *Client) GetNotaryDeposit() (res int64, err error) {
+	defer func() {
+		if err == nil {
+			c.cfg.metrics.SetFSChainNotaryDeposit(res)
+		}
+	}()
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -348,7 +354,9 @@ func (c *Client) NotaryInvokeNotAlpha(contract util.Uint160, await bool, fee fix
 //     TXs retrieved from the received notary requests.
 //   - true await flag always means additional subscription for [Client] which
 //     is always limited on server side, use it carefully.
-func (c *Client) NotarySignAndInvokeTX(mainTx *transaction.Transaction, await bool) error {
+func (c *Client) NotarySignAndInvokeTX(mainTx *transaction.Transaction, await bool) (err error) {
+	defer c.observeRPC("submitnotaryrequest", time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -476,7 +484,9 @@ func (c *Client) notaryInvokeAsCommittee(method string, nonce, vub uint32, args
 	return err
 }
 
-func (c *Client) notaryInvoke(committee, invokedByAlpha bool, contract util.Uint160, await bool, nonce uint32, vub *uint32, method string, args ...any) (util.Uint256, error) {
+func (c *Client) notaryInvoke(committee, invokedByAlpha bool, contract util.Uint160, await bool, nonce uint32, vub *uint32, method string, args ...any) (_ util.Uint256, err error) {
+	defer c.observeRPC(method, time.Now(), &err)
+
 	var conn = c.conn.Load()
 
 	if conn == nil {
@@ -509,6 +519,7 @@ func (c *Client) notaryInvoke(committee, invokedByAlpha bool, contract util.Uint
 		return util.Uint256{}, err
 	}
 
+	var gas int64
 	mainH, fbH, untilActual, err := nAct.Notarize(nAct.MakeTunedCall(contract, method, nil, func(r *result.Invoke, t *transaction.Transaction) error {
 		if r.State != vmstate.Halt.String() {
 			return &notHaltStateError{state: r.State, exception: r.FaultException}
@@ -520,6 +531,7 @@ func (c *Client) notaryInvoke(committee, invokedByAlpha bool, contract util.Uint
 		// Add 10% GAS to prevent this errors:
 		// "at instruction 1689 (SYSCALL): System.Runtime.Log failed: insufficient amount of gas"
 		t.SystemFee += t.SystemFee / 10
+		gas = t.SystemFee + t.NetworkFee
 
 		return nil
 	}, args...))
@@ -531,6 +543,10 @@ func (c *Client) notaryInvoke(committee, invokedByAlpha bool, contract util.Uint
 		return util.Uint256{}, err
 	}
 
+	if err == nil {
+		c.cfg.metrics.AddFSChainInvocationGAS(method, gas)
+	}
+
 	c.logger.Debug("notary request invoked",
 		zap.String("method", method),
 		zap.Uint32("valid_until_block", untilActual),
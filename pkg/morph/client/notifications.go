@@ -261,6 +261,11 @@ routeloop:
 // cached information about them.
 func (c *Client) restoreSubscriptions(conn *connection, resCh chan struct{}) {
 	var err error
+	defer func() {
+		if err == nil {
+			c.cfg.metrics.IncFSChainSubscriptionReconnects()
+		}
+	}()
 
 	c.subs.RLock()
 	defer c.subs.RUnlock()
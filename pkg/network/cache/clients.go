@@ -14,6 +14,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/internal/uriutil"
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -23,6 +24,7 @@ import (
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	apireputation "github.com/nspcc-dev/neofs-sdk-go/reputation"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
@@ -268,10 +270,14 @@ func (x *connections) all(f func(ma string, c *client.Client) bool) {
 	x.mtx.RUnlock()
 }
 
-func (x *connections) forEach(ctx context.Context, f func(context.Context, *client.Client) error) error {
+// forEach calls f for each connection until the first success or non-temporary
+// error. Each call is traced as op within ctx.
+func (x *connections) forEach(ctx context.Context, op string, f func(context.Context, *client.Client) error) error {
 	var firstErr error
 	for ma, c := range x.all {
-		err := f(ctx, c)
+		spanCtx, span := tracing.StartChild(ctx, "client."+op, attribute.String("address", ma))
+		err := f(spanCtx, c)
+		tracing.End(span, err)
 		if err == nil {
 			return nil
 		}
@@ -286,20 +292,20 @@ func (x *connections) forEach(ctx context.Context, f func(context.Context, *clie
 }
 
 func (x *connections) ForEachGRPCConn(ctx context.Context, f func(context.Context, *grpc.ClientConn) error) error {
-	return x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return x.forEach(ctx, "GRPCConn", func(ctx context.Context, c *client.Client) error {
 		return f(ctx, c.Conn())
 	})
 }
 
 func (x *connections) ContainerAnnounceUsedSpace(ctx context.Context, es []container.SizeEstimation, opts client.PrmAnnounceSpace) error {
-	return x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return x.forEach(ctx, "ContainerAnnounceUsedSpace", func(ctx context.Context, c *client.Client) error {
 		return c.ContainerAnnounceUsedSpace(ctx, es, opts)
 	})
 }
 
 func (x *connections) ObjectPutInit(ctx context.Context, hdr object.Object, signer user.Signer, opts client.PrmObjectPutInit) (client.ObjectWriter, error) {
 	var res client.ObjectWriter
-	return res, x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return res, x.forEach(ctx, "ObjectPutInit", func(ctx context.Context, c *client.Client) error {
 		var err error
 		res, err = c.ObjectPutInit(ctx, hdr, signer, opts)
 		return err
@@ -310,7 +316,9 @@ func (x *connections) ReplicateObject(ctx context.Context, id oid.ID, src io.Rea
 	// same as forEach but with specific error handling
 	var firstErr error
 	for ma, c := range x.all {
-		sig, err := c.ReplicateObject(ctx, id, src, signer, signedReplication)
+		spanCtx, span := tracing.StartChild(ctx, "client.ReplicateObject", attribute.String("address", ma))
+		sig, err := c.ReplicateObject(spanCtx, id, src, signer, signedReplication)
+		tracing.End(span, err)
 		if err == nil {
 			return sig, nil
 		}
@@ -329,7 +337,7 @@ func (x *connections) ReplicateObject(ctx context.Context, id oid.ID, src io.Rea
 
 func (x *connections) ObjectDelete(ctx context.Context, cnr cid.ID, obj oid.ID, signer user.Signer, opts client.PrmObjectDelete) (oid.ID, error) {
 	var res oid.ID
-	return res, x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return res, x.forEach(ctx, "ObjectDelete", func(ctx context.Context, c *client.Client) error {
 		var err error
 		res, err = c.ObjectDelete(ctx, cnr, obj, signer, opts)
 		return err
@@ -339,7 +347,7 @@ func (x *connections) ObjectDelete(ctx context.Context, cnr cid.ID, obj oid.ID,
 func (x *connections) ObjectGetInit(ctx context.Context, cnr cid.ID, id oid.ID, signer user.Signer, opts client.PrmObjectGet) (object.Object, *client.PayloadReader, error) {
 	var res1 object.Object
 	var res2 *client.PayloadReader
-	return res1, res2, x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return res1, res2, x.forEach(ctx, "ObjectGetInit", func(ctx context.Context, c *client.Client) error {
 		var err error
 		res1, res2, err = c.ObjectGetInit(ctx, cnr, id, signer, opts)
 		return err
@@ -348,7 +356,7 @@ func (x *connections) ObjectGetInit(ctx context.Context, cnr cid.ID, id oid.ID,
 
 func (x *connections) ObjectHead(ctx context.Context, cnr cid.ID, id oid.ID, signer user.Signer, opts client.PrmObjectHead) (*object.Object, error) {
 	var res *object.Object
-	return res, x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return res, x.forEach(ctx, "ObjectHead", func(ctx context.Context, c *client.Client) error {
 		var err error
 		res, err = c.ObjectHead(ctx, cnr, id, signer, opts)
 		return err
@@ -357,7 +365,7 @@ func (x *connections) ObjectHead(ctx context.Context, cnr cid.ID, id oid.ID, sig
 
 func (x *connections) ObjectSearchInit(ctx context.Context, cnr cid.ID, signer user.Signer, opts client.PrmObjectSearch) (*client.ObjectListReader, error) {
 	var res *client.ObjectListReader
-	return res, x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return res, x.forEach(ctx, "ObjectSearchInit", func(ctx context.Context, c *client.Client) error {
 		var err error
 		res, err = c.ObjectSearchInit(ctx, cnr, signer, opts)
 		return err
@@ -366,7 +374,7 @@ func (x *connections) ObjectSearchInit(ctx context.Context, cnr cid.ID, signer u
 
 func (x *connections) ObjectRangeInit(ctx context.Context, cnr cid.ID, id oid.ID, off, ln uint64, signer user.Signer, opts client.PrmObjectRange) (*client.ObjectRangeReader, error) {
 	var res *client.ObjectRangeReader
-	return res, x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return res, x.forEach(ctx, "ObjectRangeInit", func(ctx context.Context, c *client.Client) error {
 		var err error
 		res, err = c.ObjectRangeInit(ctx, cnr, id, off, ln, signer, opts)
 		return err
@@ -375,7 +383,7 @@ func (x *connections) ObjectRangeInit(ctx context.Context, cnr cid.ID, id oid.ID
 
 func (x *connections) ObjectHash(ctx context.Context, cnr cid.ID, id oid.ID, signer user.Signer, opts client.PrmObjectHash) ([][]byte, error) {
 	var res [][]byte
-	return res, x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return res, x.forEach(ctx, "ObjectHash", func(ctx context.Context, c *client.Client) error {
 		var err error
 		res, err = c.ObjectHash(ctx, cnr, id, signer, opts)
 		return err
@@ -383,13 +391,13 @@ func (x *connections) ObjectHash(ctx context.Context, cnr cid.ID, id oid.ID, sig
 }
 
 func (x *connections) AnnounceLocalTrust(ctx context.Context, epoch uint64, ts []apireputation.Trust, opts client.PrmAnnounceLocalTrust) error {
-	return x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return x.forEach(ctx, "AnnounceLocalTrust", func(ctx context.Context, c *client.Client) error {
 		return c.AnnounceLocalTrust(ctx, epoch, ts, opts)
 	})
 }
 
 func (x *connections) AnnounceIntermediateTrust(ctx context.Context, epoch uint64, t apireputation.PeerToPeerTrust, opts client.PrmAnnounceIntermediateTrust) error {
-	return x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return x.forEach(ctx, "AnnounceIntermediateTrust", func(ctx context.Context, c *client.Client) error {
 		return c.AnnounceIntermediateTrust(ctx, epoch, t, opts)
 	})
 }
@@ -398,7 +406,7 @@ func (x *connections) SearchObjects(ctx context.Context, cnr cid.ID, fs object.S
 	signer neofscrypto.Signer, opts client.SearchObjectsOptions) ([]client.SearchResultItem, string, error) {
 	var resItems []client.SearchResultItem
 	var resCursor string
-	return resItems, resCursor, x.forEach(ctx, func(ctx context.Context, c *client.Client) error {
+	return resItems, resCursor, x.forEach(ctx, "SearchObjects", func(ctx context.Context, c *client.Client) error {
 		var err error
 		resItems, resCursor, err = c.SearchObjects(ctx, cnr, fs, attrs, cursor, signer, opts)
 		return err
@@ -11,6 +11,7 @@ import (
 
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
@@ -20,6 +21,7 @@ import (
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	reputationSDK "github.com/nspcc-dev/neofs-sdk-go/reputation"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -137,7 +139,7 @@ loop:
 
 var errRecentlyFailed = errors.New("client has recently failed, skipping")
 
-func (x *multiClient) iterateClients(ctx context.Context, f func(clientcore.Client) error) error {
+func (x *multiClient) iterateClients(ctx context.Context, op string, f func(context.Context, clientcore.Client) error) error {
 	var firstErr error
 
 	x.addrMtx.RLock()
@@ -155,10 +157,12 @@ loop:
 
 		var err error
 
+		spanCtx, span := tracing.StartChild(ctx, "client."+op, attribute.Stringer("address", addr))
 		c, err := x.client(addr)
 		if err == nil {
-			err = f(c)
+			err = f(spanCtx, c)
 		}
+		tracing.End(span, err)
 
 		// non-status logic error that could be returned
 		// from the SDK client; should not be considered
@@ -234,7 +238,7 @@ func (s *singleClient) invalidate() {
 }
 
 func (x *multiClient) ObjectPutInit(ctx context.Context, header objectSDK.Object, signer user.Signer, p client.PrmObjectPutInit) (res client.ObjectWriter, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, "ObjectPutInit", func(ctx context.Context, c clientcore.Client) error {
 		res, err = c.ObjectPutInit(ctx, header, signer, p)
 		return err
 	})
@@ -245,7 +249,7 @@ func (x *multiClient) ObjectPutInit(ctx context.Context, header objectSDK.Object
 func (x *multiClient) ReplicateObject(ctx context.Context, id oid.ID, src io.ReadSeeker, signer neofscrypto.Signer, signedReplication bool) (*neofscrypto.Signature, error) {
 	var errSeek error
 	var signature *neofscrypto.Signature
-	err := x.iterateClients(ctx, func(c clientcore.Client) error {
+	err := x.iterateClients(ctx, "ReplicateObject", func(ctx context.Context, c clientcore.Client) error {
 		var err error
 		signature, err = c.ReplicateObject(ctx, id, src, signer, signedReplication)
 		if err != nil {
@@ -263,13 +267,13 @@ func (x *multiClient) ReplicateObject(ctx context.Context, id oid.ID, src io.Rea
 }
 
 func (x *multiClient) ContainerAnnounceUsedSpace(ctx context.Context, announcements []container.SizeEstimation, prm client.PrmAnnounceSpace) error {
-	return x.iterateClients(ctx, func(c clientcore.Client) error {
+	return x.iterateClients(ctx, "ContainerAnnounceUsedSpace", func(ctx context.Context, c clientcore.Client) error {
 		return c.ContainerAnnounceUsedSpace(ctx, announcements, prm)
 	})
 }
 
 func (x *multiClient) ObjectDelete(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectDelete) (tombID oid.ID, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, "ObjectDelete", func(ctx context.Context, c clientcore.Client) error {
 		tombID, err = c.ObjectDelete(ctx, containerID, objectID, signer, prm)
 		return err
 	})
@@ -278,7 +282,7 @@ func (x *multiClient) ObjectDelete(ctx context.Context, containerID cid.ID, obje
 }
 
 func (x *multiClient) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectGet) (hdr objectSDK.Object, rdr *client.PayloadReader, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, "ObjectGetInit", func(ctx context.Context, c clientcore.Client) error {
 		hdr, rdr, err = c.ObjectGetInit(ctx, containerID, objectID, signer, prm)
 		return err
 	})
@@ -287,7 +291,7 @@ func (x *multiClient) ObjectGetInit(ctx context.Context, containerID cid.ID, obj
 }
 
 func (x *multiClient) ObjectRangeInit(ctx context.Context, containerID cid.ID, objectID oid.ID, offset, length uint64, signer user.Signer, prm client.PrmObjectRange) (res *client.ObjectRangeReader, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, "ObjectRangeInit", func(ctx context.Context, c clientcore.Client) error {
 		res, err = c.ObjectRangeInit(ctx, containerID, objectID, offset, length, signer, prm)
 		return err
 	})
@@ -296,7 +300,7 @@ func (x *multiClient) ObjectRangeInit(ctx context.Context, containerID cid.ID, o
 }
 
 func (x *multiClient) ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHead) (res *objectSDK.Object, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, "ObjectHead", func(ctx context.Context, c clientcore.Client) error {
 		res, err = c.ObjectHead(ctx, containerID, objectID, signer, prm)
 		return err
 	})
@@ -305,7 +309,7 @@ func (x *multiClient) ObjectHead(ctx context.Context, containerID cid.ID, object
 }
 
 func (x *multiClient) ObjectHash(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHash) (res [][]byte, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, "ObjectHash", func(ctx context.Context, c clientcore.Client) error {
 		res, err = c.ObjectHash(ctx, containerID, objectID, signer, prm)
 		return err
 	})
@@ -314,7 +318,7 @@ func (x *multiClient) ObjectHash(ctx context.Context, containerID cid.ID, object
 }
 
 func (x *multiClient) ObjectSearchInit(ctx context.Context, containerID cid.ID, signer user.Signer, prm client.PrmObjectSearch) (res *client.ObjectListReader, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, "ObjectSearchInit", func(ctx context.Context, c clientcore.Client) error {
 		res, err = c.ObjectSearchInit(ctx, containerID, signer, prm)
 		return err
 	})
@@ -325,7 +329,7 @@ func (x *multiClient) ObjectSearchInit(ctx context.Context, containerID cid.ID,
 func (x *multiClient) SearchObjects(ctx context.Context, cnr cid.ID, fs objectSDK.SearchFilters, attrs []string, cursor string,
 	signer neofscrypto.Signer, opts client.SearchObjectsOptions) ([]client.SearchResultItem, string, error) {
 	var res []client.SearchResultItem
-	return res, cursor, x.iterateClients(ctx, func(c clientcore.Client) error {
+	return res, cursor, x.iterateClients(ctx, "SearchObjects", func(ctx context.Context, c clientcore.Client) error {
 		var err error
 		res, cursor, err = c.SearchObjects(ctx, cnr, fs, attrs, cursor, signer, opts)
 		return err
@@ -333,13 +337,13 @@ func (x *multiClient) SearchObjects(ctx context.Context, cnr cid.ID, fs objectSD
 }
 
 func (x *multiClient) AnnounceLocalTrust(ctx context.Context, epoch uint64, trusts []reputationSDK.Trust, prm client.PrmAnnounceLocalTrust) error {
-	return x.iterateClients(ctx, func(c clientcore.Client) error {
+	return x.iterateClients(ctx, "AnnounceLocalTrust", func(ctx context.Context, c clientcore.Client) error {
 		return c.AnnounceLocalTrust(ctx, epoch, trusts, prm)
 	})
 }
 
 func (x *multiClient) AnnounceIntermediateTrust(ctx context.Context, epoch uint64, trust reputationSDK.PeerToPeerTrust, prm client.PrmAnnounceIntermediateTrust) error {
-	return x.iterateClients(ctx, func(c clientcore.Client) error {
+	return x.iterateClients(ctx, "AnnounceIntermediateTrust", func(ctx context.Context, c clientcore.Client) error {
 		return c.AnnounceIntermediateTrust(ctx, epoch, trust, prm)
 	})
 }
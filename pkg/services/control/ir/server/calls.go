@@ -120,3 +120,25 @@ func (s *Server) NotarySign(_ context.Context, req *control.NotarySignRequest) (
 
 	return resp, nil
 }
+
+// ProcessorQueues returns states of the event queues of the IR processors.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) ProcessorQueues(_ context.Context, req *control.ProcessorQueuesRequest) (*control.ProcessorQueuesResponse, error) {
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	resp := new(control.ProcessorQueuesResponse)
+
+	body := new(control.ProcessorQueuesResponse_Body)
+	resp.SetBody(body)
+
+	body.SetQueues(s.prm.queueInspector.ProcessorQueues())
+
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -26,3 +26,10 @@ type NotaryManager interface {
 	// SignNotary must sign an existing notary transaction with the given hash.
 	SignNotary(hash util.Uint256) error
 }
+
+// QueueInspector is component interface for inspecting event queues
+// of the IR processors.
+type QueueInspector interface {
+	// ProcessorQueues must return current states of all processor event queues.
+	ProcessorQueues() []*control.ProcessorQueue
+}
@@ -11,6 +11,8 @@ type Prm struct {
 
 	healthChecker HealthChecker
 	notaryManager NotaryManager
+
+	queueInspector QueueInspector
 }
 
 // SetPrivateKey sets private key to sign responses.
@@ -29,3 +31,9 @@ func (x *Prm) SetHealthChecker(hc HealthChecker) {
 func (x *Prm) SetNetworkManager(nm NotaryManager) {
 	x.notaryManager = nm
 }
+
+// SetQueueInspector sets QueueInspector to dump states
+// of the processor event queues.
+func (x *Prm) SetQueueInspector(qi QueueInspector) {
+	x.queueInspector = qi
+}
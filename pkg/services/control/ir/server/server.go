@@ -25,7 +25,8 @@ func panicOnPrmValue(n string, v any) {
 // Panics if:
 //   - parameterized private key is nil;
 //   - parameterized HealthChecker is nil;
-//   - parameterized NotaryManager is nil.
+//   - parameterized NotaryManager is nil;
+//   - parameterized QueueInspector is nil.
 //
 // Forms white list from all keys specified via
 // WithAllowedKeys option and a public key of
@@ -37,6 +38,8 @@ func New(prm Prm, opts ...Option) *Server {
 		panicOnPrmValue("health checker", prm.healthChecker)
 	case prm.notaryManager == nil:
 		panicOnPrmValue("notary manager", prm.notaryManager)
+	case prm.queueInspector == nil:
+		panicOnPrmValue("queue inspector", prm.queueInspector)
 	}
 
 	// compute optional parameters
@@ -97,3 +97,24 @@ func (x *NotarySignResponse) SetBody(v *NotarySignResponse_Body) {
 		x.Body = v
 	}
 }
+
+// SetBody sets processor queues request body.
+func (x *ProcessorQueuesRequest) SetBody(v *ProcessorQueuesRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetQueues sets states of the IR processor event queues.
+func (x *ProcessorQueuesResponse_Body) SetQueues(v []*ProcessorQueue) {
+	if x != nil {
+		x.Queues = v
+	}
+}
+
+// SetBody sets processor queues response body.
+func (x *ProcessorQueuesResponse) SetBody(v *ProcessorQueuesResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
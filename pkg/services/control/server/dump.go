@@ -6,7 +6,10 @@ import (
 	"os"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -25,13 +28,26 @@ func (s *Server) DumpShard(_ context.Context, req *control.DumpShardRequest) (*c
 
 	shardID := shard.NewIDFromBytes(req.GetBody().GetShard_ID())
 
+	v := dump.Latest
+	if fv := req.GetBody().GetFormatVersion(); fv != 0 {
+		if fv > uint32(dump.Latest) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unsupported dump format version %d", fv))
+		}
+		v = dump.Version(fv)
+	}
+
+	flt, err := objectFilterFromProto(req.GetBody().GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	f, err := os.Create(req.GetBody().GetFilepath())
 	if err != nil {
 		return nil, fmt.Errorf("can't open destination file: %w", err)
 	}
 	defer f.Close()
 
-	err = s.storage.DumpShard(shardID, f, req.GetBody().GetIgnoreErrors())
+	err = s.storage.DumpShard(shardID, f, v, flt, req.GetBody().GetIgnoreErrors())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -45,3 +61,31 @@ func (s *Server) DumpShard(_ context.Context, req *control.DumpShardRequest) (*c
 	}
 	return resp, nil
 }
+
+func objectFilterFromProto(f *control.ObjectFilter) (dump.Filter, error) {
+	var res dump.Filter
+	if f == nil {
+		return res, nil
+	}
+
+	res.Containers = make([]cid.ID, 0, len(f.GetContainer_ID()))
+	for i, b := range f.GetContainer_ID() {
+		id, err := cid.DecodeBytes(b)
+		if err != nil {
+			return res, fmt.Errorf("invalid container ID #%d: %w", i, err)
+		}
+		res.Containers = append(res.Containers, id)
+	}
+
+	res.Types = make([]object.Type, 0, len(f.GetObjectType()))
+	for _, t := range f.GetObjectType() {
+		res.Types = append(res.Types, object.Type(t))
+	}
+
+	res.FromEpoch, res.ToEpoch = f.GetFromEpoch(), f.GetToEpoch()
+	if res.ToEpoch != 0 && res.FromEpoch > res.ToEpoch {
+		return res, fmt.Errorf("invalid epoch range [%d, %d]", res.FromEpoch, res.ToEpoch)
+	}
+
+	return res, nil
+}
@@ -8,6 +8,7 @@ import (
 	"slices"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
@@ -99,3 +100,125 @@ type replicatorResult struct {
 func (r *replicatorResult) SubmitSuccessfulReplication(_ netmap.NodeInfo) {
 	r.count++
 }
+
+func (s *Server) StartShardEvacuation(_ context.Context, req *control.StartShardEvacuationRequest) (*control.StartShardEvacuationResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// check availability
+	err = s.ready()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.storage.StartEvacuation(s.getShardIDList(req.GetBody().GetShard_ID()), req.GetBody().GetIgnoreErrors(), s.replicate)
+	if err != nil {
+		if errors.Is(err, engine.ErrEvacuationInProgress) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StartShardEvacuationResponse{
+		Body: &control.StartShardEvacuationResponse_Body{
+			Id: id,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) StopShardEvacuation(_ context.Context, req *control.StopShardEvacuationRequest) (*control.StopShardEvacuationResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// check availability
+	err = s.ready()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.storage.StopEvacuation()
+	if err != nil {
+		if errors.Is(err, engine.ErrNoEvacuationInProgress) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StopShardEvacuationResponse{
+		Body: &control.StopShardEvacuationResponse_Body{},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) GetShardEvacuationStatus(_ context.Context, req *control.GetShardEvacuationStatusRequest) (*control.GetShardEvacuationStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// check availability
+	err = s.ready()
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := s.storage.EvacuationStatus()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	body := &control.GetShardEvacuationStatusResponse_Body{
+		Id:        st.ID,
+		Evacuated: st.Evacuated,
+		Skipped:   st.Skipped,
+		Error:     st.Error,
+	}
+
+	switch st.State {
+	case engine.EvacuationRunning:
+		body.Status = control.EvacuationStatus_EVACUATION_RUNNING
+	case engine.EvacuationCompleted:
+		body.Status = control.EvacuationStatus_EVACUATION_COMPLETED
+	case engine.EvacuationStopped:
+		body.Status = control.EvacuationStatus_EVACUATION_STOPPED
+	case engine.EvacuationFailed:
+		body.Status = control.EvacuationStatus_EVACUATION_FAILED
+	default:
+		body.Status = control.EvacuationStatus_EVACUATION_STATUS_UNDEFINED
+	}
+
+	if !st.StartedAt.IsZero() {
+		body.StartedAt = st.StartedAt.Unix()
+	}
+	if !st.FinishedAt.IsZero() {
+		body.FinishedAt = st.FinishedAt.Unix()
+	}
+
+	body.Shard_ID = make([][]byte, 0, len(st.Shards))
+	for _, id := range st.Shards {
+		body.Shard_ID = append(body.Shard_ID, *id)
+	}
+
+	resp := &control.GetShardEvacuationStatusResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -25,6 +25,7 @@ func (s *Server) HealthCheck(_ context.Context, req *control.HealthCheckRequest)
 
 	body.SetNetmapStatus(s.healthChecker.NetmapStatus())
 	body.SetHealthStatus(s.healthChecker.HealthStatus())
+	body.SetFsChainStatus(s.healthChecker.FSChainStatus())
 
 	// sign the response
 	if err := SignMessage(s.key, resp); err != nil {
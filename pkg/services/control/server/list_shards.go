@@ -58,6 +58,14 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 
 		si.SetMode(m)
 		si.SetErrorCount(sh.ErrorCount)
+		if sh.Resync.InProgress {
+			si.Resync = &control.ResyncInfo{
+				InProgress:      true,
+				DonePartitions:  sh.Resync.DonePartitions,
+				TotalPartitions: sh.Resync.TotalPartitions,
+				Objects:         sh.Resync.Objects,
+			}
+		}
 
 		shardInfos = append(shardInfos, si)
 	}
@@ -0,0 +1,73 @@
+package control
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) RebalanceShards(req *control.RebalanceShardsRequest, stream control.ControlService_RebalanceShardsServer) error {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// check availability
+	err := s.ready()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	var sendErr error
+	send := func(st engine.RebalanceStatus) {
+		if sendErr != nil {
+			return
+		}
+
+		resp := &control.RebalanceShardsResponse{
+			Body: &control.RebalanceShardsResponse_Body{
+				Processed: st.Processed,
+				Moved:     st.Moved,
+				Failed:    st.Failed,
+			},
+		}
+		if st.Shard != nil {
+			resp.Body.Shard_ID = *st.Shard
+		}
+
+		if sendErr = SignMessage(s.key, resp); sendErr == nil {
+			sendErr = stream.Send(resp)
+		}
+		if sendErr != nil {
+			// there is no one to report the progress to, the rest is
+			// processed by the next call
+			cancel()
+		}
+	}
+
+	st, err := s.storage.Rebalance(ctx, s.getShardIDList(req.GetBody().GetShard_ID()), req.GetBody().GetRateLimit(), send)
+	if sendErr != nil {
+		return status.Error(codes.Internal, sendErr.Error())
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return status.Error(codes.Canceled, err.Error())
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	// final status is always sent
+	send(st)
+	if sendErr != nil {
+		return status.Error(codes.Internal, sendErr.Error())
+	}
+
+	return nil
+}
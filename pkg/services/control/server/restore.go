@@ -24,13 +24,18 @@ func (s *Server) RestoreShard(_ context.Context, req *control.RestoreShardReques
 
 	shardID := shard.NewIDFromBytes(req.GetBody().GetShard_ID())
 
+	flt, err := objectFilterFromProto(req.GetBody().GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	f, err := os.Open(req.GetBody().GetFilepath())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	defer f.Close()
 
-	err = s.storage.RestoreShard(shardID, f, req.GetBody().GetIgnoreErrors())
+	err = s.storage.RestoreShard(shardID, f, flt, req.GetBody().GetIgnoreErrors())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
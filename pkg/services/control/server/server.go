@@ -8,6 +8,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 )
@@ -37,6 +38,9 @@ type HealthChecker interface {
 	// If status can not be calculated for any reason,
 	// control.HealthStatus_HEALTH_STATUS_UNDEFINED should be returned.
 	HealthStatus() control.HealthStatus
+
+	// FSChainStatus must return current status of the node connection to the FS chain.
+	FSChainStatus() *control.FSChainStatus
 }
 
 // NodeState is an interface of storage node network state.
@@ -47,6 +51,17 @@ type NodeState interface {
 	SetNetmapStatus(st control.NetmapStatus) error
 }
 
+// ShardManager is an interface of storage node component managing the set of
+// storage engine shards at runtime. Changes must be kept after node restart.
+type ShardManager interface {
+	// AttachShard opens a new shard described by the given configuration in
+	// YAML format and adds it to the storage engine.
+	AttachShard(config []byte) (*shard.ID, error)
+
+	// DetachShard removes the shard from the storage engine.
+	DetachShard(id *shard.ID) error
+}
+
 // Option of the Server's constructor.
 type Option func(*cfg)
 
@@ -65,6 +80,8 @@ type cfg struct {
 
 	nodeState NodeState
 
+	shards ShardManager
+
 	storage *engine.StorageEngine
 }
 
@@ -86,7 +103,7 @@ func New(key *ecdsa.PrivateKey, authorizedKeys [][]byte, healthChecker HealthChe
 
 // MarkReady marks server available. Before this call none of the other calls
 // are available except for the health checks.
-func (s *Server) MarkReady(e *engine.StorageEngine, nm netmap.Source, c container.Source, r *replicator.Replicator, st NodeState) {
+func (s *Server) MarkReady(e *engine.StorageEngine, nm netmap.Source, c container.Source, r *replicator.Replicator, st NodeState, sm ShardManager) {
 	panicOnNil := func(name string, service any) {
 		if service == nil {
 			panic(fmt.Sprintf("'%s' is nil", name))
@@ -98,12 +115,14 @@ func (s *Server) MarkReady(e *engine.StorageEngine, nm netmap.Source, c containe
 	panicOnNil("container source", c)
 	panicOnNil("replicator", r)
 	panicOnNil("node state", st)
+	panicOnNil("shard manager", sm)
 
 	s.storage = e
 	s.netMapSrc = nm
 	s.cnrSrc = c
 	s.replicator = r
 	s.nodeState = st
+	s.shards = sm
 
 	s.available.Store(true)
 }
@@ -0,0 +1,46 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AttachShard adds a new shard with the requested configuration to the
+// storage engine.
+func (s *Server) AttachShard(_ context.Context, req *control.AttachShardRequest) (*control.AttachShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// check availability
+	err = s.ready()
+	if err != nil {
+		return nil, err
+	}
+
+	config := req.GetBody().GetConfig()
+	if len(config) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing shard configuration")
+	}
+
+	id, err := s.shards.AttachShard(config)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.AttachShardResponse{
+		Body: &control.AttachShardResponse_Body{
+			Shard_ID: *id,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
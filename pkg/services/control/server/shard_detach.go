@@ -0,0 +1,106 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DetachShard removes the requested shard from the storage engine. If
+// requested, objects of the shard are evacuated first: the shard is moved to
+// read-only mode and its objects are moved to other shards or replicated to
+// other nodes.
+func (s *Server) DetachShard(_ context.Context, req *control.DetachShardRequest) (*control.DetachShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// check availability
+	err = s.ready()
+	if err != nil {
+		return nil, err
+	}
+
+	rawID := req.GetBody().GetShard_ID()
+	if len(rawID) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing shard ID")
+	}
+
+	var (
+		id    = shard.NewIDFromBytes(rawID)
+		count int
+	)
+
+	if req.GetBody().GetEvacuate() {
+		count, err = s.evacuateForDetach(id)
+		if err != nil {
+			return nil, detachShardStatus(err)
+		}
+	}
+
+	err = s.shards.DetachShard(id)
+	if err != nil {
+		return nil, detachShardStatus(err)
+	}
+
+	resp := &control.DetachShardResponse{
+		Body: &control.DetachShardResponse_Body{
+			Evacuated: uint32(count),
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// evacuateForDetach moves the shard to read-only mode if needed and evacuates
+// all its objects. Returns the number of evacuated objects.
+func (s *Server) evacuateForDetach(id *shard.ID) (int, error) {
+	var (
+		found bool
+		info  = s.storage.DumpInfo()
+	)
+	for i := range info.Shards {
+		if info.Shards[i].ID.String() != id.String() {
+			continue
+		}
+
+		found = true
+		if !info.Shards[i].Mode.ReadOnly() {
+			err := s.storage.SetShardMode(id, mode.ReadOnly, false)
+			if err != nil {
+				return 0, fmt.Errorf("could not move shard to read-only mode: %w", err)
+			}
+		}
+		break
+	}
+	if !found {
+		return 0, fmt.Errorf("shard %s: %w", id, engine.ErrShardNotFound)
+	}
+
+	count, err := s.storage.Evacuate([]*shard.ID{id}, false, s.replicate)
+	if err != nil {
+		return count, fmt.Errorf("could not evacuate shard: %w", err)
+	}
+
+	return count, nil
+}
+
+// detachShardStatus converts shard detaching error to the gRPC status.
+func detachShardStatus(err error) error {
+	if errors.Is(err, engine.ErrShardNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,52 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RunShardGC runs GC cycle on the requested shards and returns its statistics.
+func (s *Server) RunShardGC(_ context.Context, req *control.RunShardGCRequest) (*control.RunShardGCResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// check availability
+	err = s.ready()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := s.getShardIDList(req.GetBody().GetShard_ID())
+	body := &control.RunShardGCResponse_Body{
+		Shards: make([]*control.RunShardGCResponse_Body_Shard, 0, len(ids)),
+	}
+
+	for _, shardID := range ids {
+		res, err := s.storage.RunShardGC(shardID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "shard %s: %v", shardID, err)
+		}
+
+		body.Shards = append(body.Shards, &control.RunShardGCResponse_Body_Shard{
+			Shard_ID:          *shardID,
+			RemovedGarbage:    res.RemovedGarbage,
+			ExpiredObjects:    res.ExpiredObjects,
+			ExpiredLocks:      res.ExpiredLocks,
+			DroppedTombstones: res.DroppedTombstones,
+			DurationMs:        uint64(res.Duration.Milliseconds()),
+		})
+	}
+
+	resp := &control.RunShardGCResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,55 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RunShardScrub checks and repairs metabases of the requested shards and
+// returns scrub statistics.
+func (s *Server) RunShardScrub(ctx context.Context, req *control.RunShardScrubRequest) (*control.RunShardScrubResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// check availability
+	err = s.ready()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := s.getShardIDList(req.GetBody().GetShard_ID())
+	body := &control.RunShardScrubResponse_Body{
+		Shards: make([]*control.RunShardScrubResponse_Body_Shard, 0, len(ids)),
+	}
+
+	for _, shardID := range ids {
+		res, err := s.storage.RunShardScrub(ctx, shardID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "shard %s: %v", shardID, err)
+		}
+
+		body.Shards = append(body.Shards, &control.RunShardScrubResponse_Body_Shard{
+			Shard_ID:            *shardID,
+			CheckedObjects:      res.CheckedObjects,
+			CheckedBlobs:        res.CheckedBlobs,
+			MissingBlobs:        res.MissingBlobs,
+			MissingRecords:      res.MissingRecords,
+			WrongCounters:       res.WrongCounters,
+			WrongContainerSizes: res.WrongContainerSizes,
+			DurationMs:          uint64(res.Duration.Milliseconds()),
+		})
+	}
+
+	resp := &control.RunShardScrubResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -21,6 +21,13 @@ func (x *HealthCheckResponse_Body) SetHealthStatus(v HealthStatus) {
 	}
 }
 
+// SetFsChainStatus sets status of the storage node connection to the FS chain.
+func (x *HealthCheckResponse_Body) SetFsChainStatus(v *FSChainStatus) {
+	if x != nil {
+		x.FsChainStatus = v
+	}
+}
+
 // SetBody sets health check response body.
 func (x *HealthCheckResponse) SetBody(v *HealthCheckResponse_Body) {
 	if x != nil {
@@ -137,6 +144,16 @@ func (x *DumpShardRequest_Body) SetIgnoreErrors(ignore bool) {
 	x.IgnoreErrors = ignore
 }
 
+// SetFormatVersion sets dump format version for the dump shard request.
+func (x *DumpShardRequest_Body) SetFormatVersion(v uint32) {
+	x.FormatVersion = v
+}
+
+// SetFilter sets object filter for the dump shard request.
+func (x *DumpShardRequest_Body) SetFilter(f *ObjectFilter) {
+	x.Filter = f
+}
+
 // SetBody sets request body.
 func (x *DumpShardRequest) SetBody(v *DumpShardRequest_Body) {
 	if x != nil {
@@ -166,6 +183,11 @@ func (x *RestoreShardRequest_Body) SetIgnoreErrors(ignore bool) {
 	x.IgnoreErrors = ignore
 }
 
+// SetFilter sets object filter for the restore shard request.
+func (x *RestoreShardRequest_Body) SetFilter(f *ObjectFilter) {
+	x.Filter = f
+}
+
 // SetBody sets request body.
 func (x *RestoreShardRequest) SetBody(v *RestoreShardRequest_Body) {
 	if x != nil {
@@ -24,13 +24,21 @@ func generateHealthCheckResponseBody() *control.HealthCheckResponse_Body {
 	body := new(control.HealthCheckResponse_Body)
 	body.SetNetmapStatus(control.NetmapStatus_ONLINE)
 	body.SetHealthStatus(control.HealthStatus_SHUTTING_DOWN)
+	body.SetFsChainStatus(&control.FSChainStatus{
+		Connected:   true,
+		Endpoint:    "ws://localhost:30333/ws",
+		BlockHeight: 42,
+	})
 
 	return body
 }
 
 func equalHealthCheckResponseBodies(b1, b2 *control.HealthCheckResponse_Body) bool {
 	return b1.GetNetmapStatus() == b2.GetNetmapStatus() &&
-		b1.GetHealthStatus() == b2.GetHealthStatus()
+		b1.GetHealthStatus() == b2.GetHealthStatus() &&
+		b1.GetFsChainStatus().GetConnected() == b2.GetFsChainStatus().GetConnected() &&
+		b1.GetFsChainStatus().GetEndpoint() == b2.GetFsChainStatus().GetEndpoint() &&
+		b1.GetFsChainStatus().GetBlockHeight() == b2.GetFsChainStatus().GetBlockHeight()
 }
 
 func TestSetNetmapStatusRequest_Body_StableMarshal(t *testing.T) {
@@ -82,7 +90,8 @@ func equalListShardResponseBodies(b1, b2 *control.ListShardsResponse_Body) bool
 		if b1.Shards[i].GetMetabasePath() != b2.Shards[i].GetMetabasePath() ||
 			b1.Shards[i].GetWritecachePath() != b2.Shards[i].GetWritecachePath() ||
 			!bytes.Equal(b1.Shards[i].GetShard_ID(), b2.Shards[i].GetShard_ID()) ||
-			!compareBlobstorInfo(info1, info2) {
+			!compareBlobstorInfo(info1, info2) ||
+			!compareResyncInfo(b1.Shards[i].GetResync(), b2.Shards[i].GetResync()) {
 			return false
 		}
 	}
@@ -108,6 +117,13 @@ func compareBlobstorInfo(a, b *control.BlobstorInfo) bool {
 	return true
 }
 
+func compareResyncInfo(a, b *control.ResyncInfo) bool {
+	return a.GetInProgress() == b.GetInProgress() &&
+		a.GetDonePartitions() == b.GetDonePartitions() &&
+		a.GetTotalPartitions() == b.GetTotalPartitions() &&
+		a.GetObjects() == b.GetObjects()
+}
+
 func generateListShardsResponseBody() *control.ListShardsResponse_Body {
 	body := new(control.ListShardsResponse_Body)
 	body.SetShards([]*control.ShardInfo{
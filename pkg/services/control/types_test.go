@@ -138,6 +138,7 @@ func generateShardInfo(id int) *control.ShardInfo {
 	si.SetMetabasePath(filepath.Join(path, "meta"))
 	si.Blobstor = &control.BlobstorInfo{Type: fstree.Type, Path: filepath.Join(path, "fstree")}
 	si.SetWriteCachePath(filepath.Join(path, "writecache"))
+	si.Resync = &control.ResyncInfo{InProgress: true, DonePartitions: uint64(id), TotalPartitions: 16, Objects: 1000}
 
 	return si
 }
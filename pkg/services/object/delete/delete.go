@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -21,6 +23,8 @@ func (s *Service) Delete(ctx context.Context, prm Prm) error {
 		}
 	}
 
+	ctx, span := tracing.StartChild(ctx, "deletesvc.Delete", attribute.Stringer("address", prm.addr))
+
 	exec := &execCtx{
 		svc: s,
 		ctx: ctx,
@@ -31,6 +35,8 @@ func (s *Service) Delete(ctx context.Context, prm Prm) error {
 
 	exec.execute()
 
+	tracing.End(span, exec.statusError.err)
+
 	return exec.statusError.err
 }
 
@@ -0,0 +1,238 @@
+package getsvc
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-node/internal/ec"
+	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ecPartSource provides access to erasure-coded parts of objects stored on
+// the container nodes.
+type ecPartSource interface {
+	// searchParts returns IDs of erasure-coded parts of the referenced object
+	// stored on the given node. If idx is non-negative, only parts with this
+	// index are searched.
+	searchParts(ctx context.Context, node netmap.NodeInfo, parent oid.Address, idx int) ([]oid.ID, error)
+	// getObject reads the referenced object from the given node.
+	getObject(ctx context.Context, node netmap.NodeInfo, addr oid.Address) (*objectSDK.Object, error)
+}
+
+// SearchECParts returns IDs of erasure-coded parts of the referenced object
+// stored on the given container node. If idx is non-negative, only parts with
+// this index are searched. Both local and remote nodes are supported.
+func (s *Service) SearchECParts(ctx context.Context, node netmap.NodeInfo, parent oid.Address, idx int) ([]oid.ID, error) {
+	return s.ecParts.searchParts(ctx, node, parent, idx)
+}
+
+// executeEC tries to serve the request using erasure-coded parts of the
+// requested object if its container has erasure coding rule. HEAD requires
+// any part, while GET and GETRANGE restore the object from data parts. It is
+// called when the object is not found in full on the container nodes. Returns
+// false if parts are not available, the result of the usual container lookup
+// is kept then.
+func (exec *execCtx) executeEC() bool {
+	if exec.isLocal() || exec.svc.cnrSrc == nil {
+		return false
+	}
+
+	cnr, err := exec.svc.cnrSrc.Get(exec.containerID())
+	if err != nil {
+		exec.log.Debug("failed to read container to check erasure coding rule", zap.Error(err))
+		return false
+	}
+
+	rule, ok, err := ec.RuleFromContainer(cnr)
+	if err != nil {
+		exec.log.Debug("invalid erasure coding rule in container", zap.Error(err))
+		return false
+	}
+	if !ok {
+		return false
+	}
+
+	nodeLists, _, err := exec.svc.neoFSNet.GetNodesForObject(exec.address())
+	if err != nil {
+		exec.log.Debug("failed to list storage nodes for the object", zap.Error(err))
+		return false
+	}
+
+	exec.log.Debug("trying to restore object from erasure-coded parts...", zap.Stringer("rule", rule))
+
+	required := rule.DataPartNum
+	if exec.headOnly() {
+		required = 1
+	}
+
+	parts, ok := exec.collectECParts(rule, ec.PartNodes(nodeLists), required)
+	if !ok {
+		return false
+	}
+
+	if exec.headOnly() {
+		for i := range parts {
+			if parts[i] == nil {
+				continue
+			}
+
+			hdr, _, err := ec.DecodePartPayload(*parts[i])
+			if err == nil {
+				err = hdr.VerifyID()
+			}
+			if err != nil {
+				exec.log.Debug("invalid parent header in erasure-coded part", zap.Int("part", i), zap.Error(err))
+				return false
+			}
+
+			exec.collectedObject = &hdr
+			exec.writeCollectedObject()
+			return true
+		}
+	}
+
+	obj, err := ec.JoinParts(rule, parts)
+	if err != nil {
+		exec.log.Debug("failed to restore object from erasure-coded parts", zap.Error(err))
+		return false
+	}
+
+	if rng := exec.ctxRange(); rng != nil {
+		payload := obj.Payload()
+		from := rng.GetOffset()
+		ln := rng.GetLength()
+		if ln == 0 {
+			ln = obj.PayloadSize()
+		}
+		to := from + ln
+
+		if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
+			exec.status = statusAPIResponse
+			exec.err = new(apistatus.ObjectOutOfRange)
+			return true
+		}
+
+		obj = payloadOnlyObject(payload[from:to])
+	}
+
+	exec.collectedObject = obj
+	exec.writeCollectedObject()
+
+	return true
+}
+
+// collectECParts reads at least required parts of the requested object from
+// the given nodes sorted by [ec.PartNodes]. Reserve nodes are visited only if
+// primary part holders have at least one part, otherwise the object is
+// considered not erasure-coded.
+func (exec *execCtx) collectECParts(rule ec.Rule, nodes []netmap.NodeInfo, required int) ([]*objectSDK.Object, bool) {
+	ctx := exec.context()
+	parts := make([]*objectSDK.Object, rule.TotalPartNum())
+	var found int
+
+	for i := range nodes {
+		if i == rule.TotalPartNum() && found == 0 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			exec.log.Debug("interrupt erasure-coded parts collection by context", zap.Error(ctx.Err()))
+			return nil, false
+		default:
+		}
+
+		l := exec.log.With(zap.String("node", netmap.StringifyPublicKey(nodes[i])))
+
+		ids, err := exec.svc.ecParts.searchParts(ctx, nodes[i], exec.address(), -1)
+		if err != nil {
+			l.Debug("failed to search for erasure-coded parts on the node", zap.Error(err))
+			continue
+		}
+
+		for _, id := range ids {
+			var addr oid.Address
+			addr.SetContainer(exec.containerID())
+			addr.SetObject(id)
+
+			part, err := exec.svc.ecParts.getObject(ctx, nodes[i], addr)
+			if err != nil {
+				l.Debug("failed to get erasure-coded part from the node", zap.Stringer("part", id), zap.Error(err))
+				continue
+			}
+
+			info, ok, err := ec.GetPartInfo(*part)
+			if err != nil || !ok || info.Parent != exec.address().Object() || info.Rule != rule {
+				l.Debug("unexpected object in erasure-coded part search result", zap.Stringer("part", id), zap.Error(err))
+				continue
+			}
+
+			if parts[info.Index] != nil {
+				continue
+			}
+
+			parts[info.Index] = part
+			if found++; found == required {
+				return parts, true
+			}
+		}
+	}
+
+	if found > 0 {
+		exec.log.Debug("not enough erasure-coded parts of the object",
+			zap.Int("found", found), zap.Int("required", required))
+	}
+
+	return nil, false
+}
+
+type ecPartsWrapper struct {
+	nodeObjects
+}
+
+func (w *ecPartsWrapper) searchParts(ctx context.Context, node netmap.NodeInfo, parent oid.Address, idx int) ([]oid.ID, error) {
+	var fs objectSDK.SearchFilters
+	fs.AddFilter(ec.AttributeParent, parent.Object().EncodeToString(), objectSDK.MatchStringEqual)
+	if idx >= 0 {
+		fs.AddFilter(ec.AttributePartIdx, strconv.Itoa(idx), objectSDK.MatchStringEqual)
+	}
+
+	if w.neoFSNet.IsLocalNodePublicKey(node.PublicKey()) {
+		addrs, err := w.engine.Select(ctx, parent.Container(), fs)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]oid.ID, len(addrs))
+		for i := range addrs {
+			ids[i] = addrs[i].Object()
+		}
+
+		return ids, nil
+	}
+
+	c, key, err := w.remoteClient(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.SearchObjectsPrm
+	prm.SetContext(ctx)
+	prm.SetClient(c)
+	prm.SetPrivateKey(key)
+	prm.SetTTL(1)
+	prm.SetContainerID(parent.Container())
+	prm.SetFilters(fs)
+
+	res, err := internalclient.SearchObjects(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.IDList(), nil
+}
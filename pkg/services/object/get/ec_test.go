@@ -0,0 +1,205 @@
+package getsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/internal/ec"
+	"github.com/nspcc-dev/neofs-node/internal/testutil"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testContainers map[cid.ID]container.Container
+
+func (x testContainers) Get(id cid.ID) (container.Container, error) {
+	cnr, ok := x[id]
+	if !ok {
+		return container.Container{}, apistatus.ErrContainerNotFound
+	}
+	return cnr, nil
+}
+
+// testECParts stores erasure-coded parts per node public key.
+type testECParts map[string][]objectSDK.Object
+
+func (x testECParts) searchParts(_ context.Context, node netmap.NodeInfo, parent oid.Address, idx int) ([]oid.ID, error) {
+	var res []oid.ID
+	for _, part := range x[string(node.PublicKey())] {
+		if info, ok, _ := ec.GetPartInfo(part); ok && info.Parent == parent.Object() && (idx < 0 || info.Index == idx) {
+			res = append(res, part.GetID())
+		}
+	}
+	return res, nil
+}
+
+func (x testECParts) getObject(_ context.Context, node netmap.NodeInfo, addr oid.Address) (*objectSDK.Object, error) {
+	for _, part := range x[string(node.PublicKey())] {
+		if part.GetID() == addr.Object() {
+			return &part, nil
+		}
+	}
+	return nil, apistatus.ErrObjectNotFound
+}
+
+// countingECParts counts erasure-coded part searches.
+type countingECParts struct {
+	ecPartSource
+	searches int
+}
+
+func (x *countingECParts) searchParts(ctx context.Context, node netmap.NodeInfo, parent oid.Address, idx int) ([]oid.ID, error) {
+	x.searches++
+	return x.ecPartSource.searchParts(ctx, node, parent, idx)
+}
+
+func TestGetEC(t *testing.T) {
+	ctx := context.Background()
+	rule := ec.Rule{DataPartNum: 2, ParityPartNum: 2}
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(netmaptest.PlacementPolicy())
+	cnr.SetAttribute(ec.ContainerAttributeRule, rule.String())
+	idCnr := cid.NewFromMarshalledContainer(cnr.Marshal())
+
+	payload := testutil.RandByteSlice(1024)
+	var obj objectSDK.Object
+	obj.SetContainerID(idCnr)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	usr := usertest.User()
+	obj.SetOwner(usr.UserID())
+	require.NoError(t, obj.SetVerificationFields(usr))
+
+	var addr oid.Address
+	addr.SetContainer(idCnr)
+	addr.SetObject(obj.GetID())
+
+	// 4 primary part holders and 1 reserve
+	ns, as := testNodeMatrix(t, []int{5})
+
+	encoded, err := ec.Encode(rule, payload)
+	require.NoError(t, err)
+
+	// part #0 is lost, part #1 is moved to the reserve node
+	parts := make(testECParts)
+	for i := 1; i < len(encoded); i++ {
+		part, err := ec.FormObjectForPart(usertest.User(), obj, rule, i, encoded[i], 1, false)
+		require.NoError(t, err)
+		holder := ns[0][i]
+		if i == 1 {
+			holder = ns[0][4]
+		}
+		parts[string(holder.PublicKey())] = append(parts[string(holder.PublicKey())], part)
+	}
+
+	newSvc := func(parts ecPartSource) *Service {
+		svc := &Service{cfg: new(cfg)}
+		svc.log = test.NewLogger(false)
+		svc.localStorage = newTestStorage()
+		svc.assembly = true
+		svc.cnrSrc = testContainers{idCnr: cnr}
+		svc.ecParts = parts
+		svc.neoFSNet = &testNeoFS{
+			c: cnr,
+			b: &testPlacementBuilder{
+				vectors: map[string][][]netmap.NodeInfo{
+					addr.EncodeToString(): ns,
+				},
+			},
+		}
+		svc.clientCache = &testClientCache{clients: make(map[string]*testClient)}
+		return svc
+	}
+
+	common := new(util.CommonPrm).WithLocalOnly(false)
+
+	t.Run("GET", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+		var p Prm
+		p.SetObjectWriter(w)
+		p.common = common
+		p.WithAddress(addr)
+
+		require.NoError(t, newSvc(parts).Get(ctx, p))
+		require.Equal(t, &obj, w.Object())
+	})
+
+	t.Run("HEAD", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+		var p HeadPrm
+		p.SetHeaderWriter(w)
+		p.common = common
+		p.WithAddress(addr)
+
+		require.NoError(t, newSvc(parts).Head(ctx, p))
+		require.Equal(t, obj.CutPayload(), w.Object())
+	})
+
+	t.Run("RANGE", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+		var p RangePrm
+		p.SetChunkWriter(w)
+		p.common = common
+		p.WithAddress(addr)
+		rng := objectSDK.NewRange()
+		rng.SetOffset(100)
+		rng.SetLength(200)
+		p.SetRange(rng)
+
+		require.NoError(t, newSvc(parts).GetRange(ctx, p))
+		require.Equal(t, payload[100:300], w.Object().Payload())
+
+		rng.SetOffset(1000)
+		require.ErrorIs(t, newSvc(parts).GetRange(ctx, p), apistatus.ErrObjectOutOfRange)
+	})
+
+	t.Run("not enough parts", func(t *testing.T) {
+		parts := testECParts{
+			string(ns[0][3].PublicKey()): parts[string(ns[0][3].PublicKey())],
+		}
+
+		var p Prm
+		p.SetObjectWriter(NewSimpleObjectWriter())
+		p.common = common
+		p.WithAddress(addr)
+
+		require.ErrorIs(t, newSvc(parts).Get(ctx, p), apistatus.ErrObjectNotFound)
+	})
+
+	t.Run("full copy", func(t *testing.T) {
+		counter := &countingECParts{ecPartSource: parts}
+		svc := newSvc(counter)
+
+		c := newTestClient()
+		c.addResult(addr, &obj, nil)
+		svc.clientCache.(*testClientCache).clients[as[0][2]] = c
+		for i := range as[0] {
+			if i != 2 {
+				cl := newTestClient()
+				cl.addResult(addr, nil, errors.New("any error"))
+				svc.clientCache.(*testClientCache).clients[as[0][i]] = cl
+			}
+		}
+
+		w := NewSimpleObjectWriter()
+		var p Prm
+		p.SetObjectWriter(w)
+		p.common = common
+		p.WithAddress(addr)
+
+		require.NoError(t, svc.Get(ctx, p))
+		require.Equal(t, &obj, w.Object())
+		require.Zero(t, counter.searches)
+	})
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -89,8 +92,20 @@ func (s *Service) get(ctx context.Context, prm commonPrm, opts ...execOption) st
 		exec.setLogger(s.log)
 	}
 
+	op := "getsvc.Get"
+	switch {
+	case exec.headOnly():
+		op = "getsvc.Head"
+	case exec.ctxRange() != nil:
+		op = "getsvc.GetRange"
+	}
+	var span trace.Span
+	exec.ctx, span = tracing.StartChild(ctx, op, attribute.Stringer("address", exec.address()))
+
 	exec.execute() //nolint:contextcheck // It is in fact passed via execCtx
 
+	tracing.End(span, exec.statusError.err)
+
 	return exec.statusError
 }
 
@@ -125,6 +140,11 @@ func (exec *execCtx) analyzeStatus(execCnr bool) {
 
 		if execCnr {
 			exec.executeOnContainer()
+			if exec.status != statusOK && exec.status != statusVIRTUAL && exec.status != statusAPIResponse {
+				// objects of the erasure-coded containers are not stored in
+				// full when their parts are saved
+				exec.executeEC()
+			}
 			exec.analyzeStatus(false)
 		}
 	}
@@ -0,0 +1,84 @@
+package getsvc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
+	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// GetObjectFromNode reads the referenced object from the given container node
+// without forwarding the request. Both local and remote nodes are supported.
+func (s *Service) GetObjectFromNode(ctx context.Context, node netmap.NodeInfo, addr oid.Address) (*objectSDK.Object, error) {
+	return s.ecParts.getObject(ctx, node, addr)
+}
+
+// nodeObjects provides access to objects stored on the particular local or
+// remote node.
+type nodeObjects struct {
+	neoFSNet NeoFSNetwork
+	engine   *engine.StorageEngine
+	cache    ClientConstructor
+	keyStore *util.KeyStorage
+}
+
+func (w *nodeObjects) getObject(ctx context.Context, node netmap.NodeInfo, addr oid.Address) (*objectSDK.Object, error) {
+	if w.neoFSNet.IsLocalNodePublicKey(node.PublicKey()) {
+		return w.engine.Get(ctx, addr)
+	}
+
+	c, key, err := w.remoteClient(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.GetObjectPrm
+	prm.SetContext(ctx)
+	prm.SetClient(c)
+	prm.SetPrivateKey(key)
+	prm.SetTTL(1)
+	prm.SetAddress(addr)
+
+	res, err := internalclient.GetObject(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Object(), nil
+}
+
+func (w *nodeObjects) remoteClient(node netmap.NodeInfo) (coreclient.MultiAddressClient, *ecdsa.PrivateKey, error) {
+	if w.cache == nil || w.keyStore == nil {
+		return nil, nil, errors.New("remote object access is not configured")
+	}
+
+	var endpoints network.AddressGroup
+	if err := endpoints.FromIterator(network.NodeEndpointsIterator(node)); err != nil {
+		return nil, nil, fmt.Errorf("decode network endpoints: %w", err)
+	}
+
+	var info coreclient.NodeInfo
+	info.SetAddressGroup(endpoints)
+	info.SetPublicKey(node.PublicKey())
+
+	c, err := w.cache.Get(info)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get client: %w", err)
+	}
+
+	key, err := w.keyStore.GetKey(nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get local node's private key: %w", err)
+	}
+
+	return c, key, nil
+}
@@ -2,6 +2,7 @@ package getsvc
 
 import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	netmapsdk "github.com/nspcc-dev/neofs-sdk-go/netmap"
@@ -58,6 +59,10 @@ type cfg struct {
 	}
 
 	keyStore *util.KeyStorage
+
+	cnrSrc container.Source
+
+	ecParts ecPartSource
 }
 
 func defaultCfg() *cfg {
@@ -66,6 +71,7 @@ func defaultCfg() *cfg {
 		log:          zap.L(),
 		localStorage: new(storageEngineWrapper),
 		clientCache:  new(clientCacheWrapper),
+		ecParts:      new(ecPartsWrapper),
 	}
 }
 
@@ -78,6 +84,10 @@ func New(neoFSNet NeoFSNetwork, opts ...Option) *Service {
 		opts[i](c)
 	}
 
+	if w, ok := c.ecParts.(*ecPartsWrapper); ok {
+		w.neoFSNet = neoFSNet
+	}
+
 	return &Service{
 		cfg:      c,
 		neoFSNet: neoFSNet,
@@ -103,6 +113,7 @@ func WithoutAssembly() Option {
 func WithLocalStorageEngine(e *engine.StorageEngine) Option {
 	return func(c *cfg) {
 		c.localStorage.(*storageEngineWrapper).engine = e
+		c.ecParts.(*ecPartsWrapper).engine = e
 	}
 }
 
@@ -114,6 +125,7 @@ type ClientConstructor interface {
 func WithClientConstructor(v ClientConstructor) Option {
 	return func(c *cfg) {
 		c.clientCache.(*clientCacheWrapper).cache = v
+		c.ecParts.(*ecPartsWrapper).cache = v
 	}
 }
 
@@ -122,5 +134,15 @@ func WithClientConstructor(v ClientConstructor) Option {
 func WithKeyStorage(store *util.KeyStorage) Option {
 	return func(c *cfg) {
 		c.keyStore = store
+		c.ecParts.(*ecPartsWrapper).keyStore = store
+	}
+}
+
+// WithContainerSource returns option to set source of containers. Containers
+// are used to check whether objects are erasure-coded, if source is not set,
+// objects are always treated as fully replicated.
+func WithContainerSource(src container.Source) Option {
+	return func(c *cfg) {
+		c.cnrSrc = src
 	}
 }
@@ -199,7 +199,7 @@ func (c *clientWrapper) get(exec *execCtx, key *ecdsa.PrivateKey) (*object.Objec
 
 func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 	if exec.headOnly() {
-		r, err := e.engine.Head(exec.address(), exec.isRaw())
+		r, err := e.engine.Head(exec.context(), exec.address(), exec.isRaw())
 		if err != nil {
 			return nil, err
 		}
@@ -208,7 +208,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 	}
 
 	if rng := exec.ctxRange(); rng != nil {
-		r, err := e.engine.GetRange(exec.address(), rng.GetOffset(), rng.GetLength())
+		r, err := e.engine.GetRange(exec.context(), exec.address(), rng.GetOffset(), rng.GetLength())
 		if err != nil {
 			return nil, err
 		}
@@ -219,7 +219,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		return o, nil
 	}
 
-	return e.engine.Get(exec.address())
+	return e.engine.Get(exec.context(), exec.address())
 }
 
 func (w *partWriter) WriteChunk(p []byte) error {
@@ -9,6 +9,7 @@ import (
 	"io"
 
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
@@ -147,7 +148,7 @@ func GetObject(prm GetObjectPrm) (*GetObjectRes, error) {
 		prm.cliPrm.MarkLocal()
 	}
 
-	prm.cliPrm.WithXHeaders(prm.xHeaders...)
+	prm.cliPrm.WithXHeaders(tracing.InjectXHeaders(prm.ctx, prm.xHeaders)...)
 
 	obj, rdr, err := prm.cli.ObjectGetInit(prm.ctx, prm.cnr, prm.obj, prm.signer, prm.cliPrm)
 	if err != nil {
@@ -228,7 +229,7 @@ func HeadObject(prm HeadObjectPrm) (*HeadObjectRes, error) {
 		prm.cliPrm.WithBearerToken(*prm.tokenBearer)
 	}
 
-	prm.cliPrm.WithXHeaders(prm.xHeaders...)
+	prm.cliPrm.WithXHeaders(tracing.InjectXHeaders(prm.ctx, prm.xHeaders)...)
 
 	hdr, err := prm.cli.ObjectHead(prm.ctx, prm.cnr, prm.obj, prm.signer, prm.cliPrm)
 	if err != nil {
@@ -316,7 +317,7 @@ func PayloadRange(prm PayloadRangePrm) (*PayloadRangeRes, error) {
 		prm.cliPrm.WithBearerToken(*prm.tokenBearer)
 	}
 
-	prm.cliPrm.WithXHeaders(prm.xHeaders...)
+	prm.cliPrm.WithXHeaders(tracing.InjectXHeaders(prm.ctx, prm.xHeaders)...)
 
 	rdr, err := prm.cli.ObjectRangeInit(prm.ctx, prm.cnr, prm.obj, prm.offset, prm.ln, prm.signer, prm.cliPrm)
 	if err != nil {
@@ -388,7 +389,7 @@ func PutObject(prm PutObjectPrm) (*PutObjectRes, error) {
 		prmCli.WithBearerToken(*prm.tokenBearer)
 	}
 
-	prmCli.WithXHeaders(prm.xHeaders...)
+	prmCli.WithXHeaders(tracing.InjectXHeaders(prm.ctx, prm.xHeaders)...)
 
 	w, err := prm.cli.ObjectPutInit(prm.ctx, *prm.obj, prm.signer, prmCli)
 	if err != nil {
@@ -457,7 +458,7 @@ func SearchObjects(prm SearchObjectsPrm) (*SearchObjectsRes, error) {
 		prm.cliPrm.WithBearerToken(*prm.tokenBearer)
 	}
 
-	prm.cliPrm.WithXHeaders(prm.xHeaders...)
+	prm.cliPrm.WithXHeaders(tracing.InjectXHeaders(prm.ctx, prm.xHeaders)...)
 
 	rdr, err := prm.cli.ObjectSearchInit(prm.ctx, prm.cid, prm.signer, prm.cliPrm)
 	if err != nil {
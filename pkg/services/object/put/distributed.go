@@ -11,6 +11,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/nspcc-dev/neofs-node/internal/ec"
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -23,6 +24,7 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"go.uber.org/zap"
 )
 
@@ -63,6 +65,11 @@ type distributedTarget struct {
 	keyStorage        *svcutil.KeyStorage
 
 	localOnly bool
+
+	// erasure coding rule of the container, nil if disabled
+	ecRule        *ec.Rule
+	ecSigner      user.Signer
+	ecHomomorphic bool
 }
 
 type nodeDesc struct {
@@ -149,11 +156,18 @@ func (t *distributedTarget) Close() (oid.ID, error) {
 		}
 	}
 
+	id := t.obj.GetID()
+	if t.ecEnabled() {
+		if err := t.saveECParts(); err != nil {
+			return oid.ID{}, err
+		}
+		return id, nil
+	}
+
 	if t.localNodeInContainer && t.metainfoConsistencyAttr != "" {
 		t.objSharedMeta = t.encodeCurrentObjectMetadata()
 	}
 
-	id := t.obj.GetID()
 	var err error
 	if t.localOnly {
 		var l = t.placementIterator.log.With(zap.Stringer("oid", t.obj.GetID()))
@@ -307,7 +321,7 @@ func (t *distributedTarget) sendObject(node nodeDesc) error {
 }
 
 func (t *distributedTarget) writeObjectLocally() error {
-	if err := putObjectLocally(t.localStorage, t.obj, t.objMeta, &t.encodedObject); err != nil {
+	if err := putObjectLocally(t.opCtx, t.localStorage, t.obj, t.objMeta, &t.encodedObject); err != nil {
 		return err
 	}
 
@@ -0,0 +1,131 @@
+package putsvc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nspcc-dev/neofs-node/internal/ec"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.uber.org/zap"
+)
+
+var errECPartPut = errors.New("erasure-coded part objects can not be put directly")
+
+// ecEnabled checks whether current object must be stored as erasure-coded
+// parts instead of full replicas. Only regular objects are encoded, other types
+// are required in full by the nodes to be processed. Nodes outside the
+// container store the object as is, container nodes' policers convert it then.
+func (t *distributedTarget) ecEnabled() bool {
+	return t.ecRule != nil && !t.localOnly && t.localNodeInContainer &&
+		t.placementIterator.linearReplNum == 0 && t.obj.Type() == objectSDK.TypeRegular
+}
+
+// saveECParts encodes payload of the current object into parts according to
+// the container's rule and saves them on the container nodes. Part with index
+// i goes to the i-th node from [ec.PartNodes], nodes following the primary ones
+// are used for failover. All parts must be saved for the operation to succeed.
+func (t *distributedTarget) saveECParts() error {
+	rule := *t.ecRule
+	id := t.obj.GetID()
+	l := t.placementIterator.log.With(zap.Stringer("oid", id), zap.Stringer("rule", rule))
+
+	nodeLists, err := t.placementIterator.containerNodes.SortForObject(id)
+	if err != nil {
+		return fmt.Errorf("sort container nodes for the object: %w", err)
+	}
+
+	nodes := ec.PartNodes(nodeLists)
+	if len(nodes) < rule.TotalPartNum() {
+		return errIncompletePut{singleErr: errNotEnoughNodes{required: uint(rule.TotalPartNum()), left: uint(len(nodes))}}
+	}
+
+	payloadParts, err := ec.Encode(rule, t.obj.Payload())
+	if err != nil {
+		return fmt.Errorf("encode payload: %w", err)
+	}
+
+	var (
+		wg          sync.WaitGroup
+		lastRespErr atomic.Value
+		failed      atomic.Bool
+		nextReserve atomic.Int64
+	)
+	nextReserve.Store(int64(rule.TotalPartNum()))
+
+	savePart := func(idx int) {
+		defer wg.Done()
+
+		part, err := ec.FormObjectForPart(t.ecSigner, *t.obj, rule, idx, payloadParts[idx], t.fsState.CurrentEpoch(), t.ecHomomorphic)
+		if err != nil {
+			lastRespErr.Store(fmt.Errorf("form object for part #%d: %w", idx, err))
+			failed.Store(true)
+			return
+		}
+
+		for nodeIdx := idx; nodeIdx < len(nodes); nodeIdx = int(nextReserve.Add(1) - 1) {
+			if err = t.sendECPart(nodes[nodeIdx], part); err == nil {
+				return
+			}
+
+			lastRespErr.Store(err)
+			l.Info("failed to save erasure-coded part on the container node, trying the next one",
+				zap.Int("part", idx), zap.String("node", netmap.StringifyPublicKey(nodes[nodeIdx])), zap.Error(err))
+		}
+
+		failed.Store(true)
+	}
+
+	for i := range payloadParts {
+		wg.Add(1)
+		if err := t.placementIterator.remotePool.Submit(func() { savePart(i) }); err != nil {
+			wg.Done()
+			svcutil.LogWorkerPoolError(l, "PUT", fmt.Errorf("submit next job to save an object part to the worker pool: %w", err))
+			failed.Store(true)
+		}
+	}
+	wg.Wait()
+
+	if failed.Load() {
+		err = errors.New("not all erasure-coded parts were saved")
+		if e, _ := lastRespErr.Load().(error); e != nil {
+			err = fmt.Errorf("%w (last node error: %w)", err, e)
+		}
+		return errIncompletePut{singleErr: err}
+	}
+
+	return nil
+}
+
+// sendECPart saves part object on the given container node.
+func (t *distributedTarget) sendECPart(node netmap.NodeInfo, part objectSDK.Object) error {
+	if t.placementIterator.neoFSNet.IsLocalNodePublicKey(node.PublicKey()) {
+		if err := putObjectLocally(t.opCtx, t.localStorage, &part, object.ContentMeta{}, nil); err != nil {
+			return fmt.Errorf("write part locally: %w", err)
+		}
+		return nil
+	}
+
+	info, err := t.placementIterator.convertNodeInfo(node)
+	if err != nil {
+		return fmt.Errorf("decode network endpoints: %w", err)
+	}
+
+	payload := part.Payload()
+	enc, err := encodeReplicateRequestWithoutPayload(t.localNodeSigner, *part.CutPayload(), len(payload), false)
+	if err != nil {
+		return fmt.Errorf("encode part into binary: %w", err)
+	}
+	defer putPayload(enc.b)
+
+	enc.b = append(enc.b, payload...)
+	if _, err = t.transport.SendReplicationRequestToNode(t.opCtx, enc.b, info); err != nil {
+		return fmt.Errorf("replicate part to remote node (key=%x): %w", node.PublicKey(), err)
+	}
+
+	return nil
+}
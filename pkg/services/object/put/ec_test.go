@@ -0,0 +1,197 @@
+package putsvc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/internal/ec"
+	"github.com/nspcc-dev/neofs-node/internal/testutil"
+	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type testEpochState uint64
+
+func (x testEpochState) CurrentEpoch() uint64         { return uint64(x) }
+func (x testEpochState) CurrentBlock() uint32         { panic("unimplemented") }
+func (x testEpochState) CurrentEpochDuration() uint64 { panic("unimplemented") }
+
+type testECTransport struct {
+	mtx     sync.Mutex
+	failKey []byte
+	objs    map[string]object.Object
+}
+
+func (x *testECTransport) SendReplicationRequestToNode(_ context.Context, b []byte, node client.NodeInfo) ([]byte, error) {
+	if bytes.Equal(node.PublicKey(), x.failKey) {
+		return nil, errors.New("any node error")
+	}
+
+	var req protoobject.ReplicateRequest
+	if err := proto.Unmarshal(b, &req); err != nil {
+		return nil, err
+	}
+
+	var obj object.Object
+	if err := obj.FromProtoMessage(req.Object); err != nil {
+		return nil, err
+	}
+
+	x.mtx.Lock()
+	x.objs[string(node.PublicKey())] = obj
+	x.mtx.Unlock()
+
+	return nil, nil
+}
+
+type testLocalStorage struct {
+	ObjectStorage
+	objs []object.Object
+}
+
+func (x *testLocalStorage) Put(_ context.Context, obj *object.Object, _ []byte) error {
+	x.objs = append(x.objs, *obj)
+	return nil
+}
+
+func TestDistributedTarget_SaveECParts(t *testing.T) {
+	rule := ec.Rule{DataPartNum: 3, ParityPartNum: 2}
+
+	payload := testutil.RandByteSlice(4096)
+	var obj object.Object
+	obj.SetContainerID(cidtest.ID())
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	usr := usertest.User()
+	obj.SetOwner(usr.UserID())
+	require.NoError(t, obj.SetVerificationFields(usr))
+
+	// nodes: [A B C] [C D E F], B is local, D fails
+	// expected part holders: A B C E F
+	cnrNodes := allocNodes([]uint{3, 4})
+	cnrNodes[1][0].SetPublicKey(cnrNodes[0][2].PublicKey())
+	expHolders := [][]byte{
+		cnrNodes[0][0].PublicKey(), cnrNodes[0][1].PublicKey(), cnrNodes[0][2].PublicKey(),
+		cnrNodes[1][2].PublicKey(), cnrNodes[1][3].PublicKey(),
+	}
+
+	transport := &testECTransport{failKey: cnrNodes[1][1].PublicKey(), objs: make(map[string]object.Object)}
+	var local testLocalStorage
+	signer := usertest.User()
+
+	target := &distributedTarget{
+		placementIterator: placementIterator{
+			log:        zap.NewNop(),
+			neoFSNet:   testNetwork{localPubKey: cnrNodes[0][1].PublicKey()},
+			remotePool: new(testWorkerPool),
+			containerNodes: testContainerNodes{
+				objID:    obj.GetID(),
+				cnrNodes: cnrNodes,
+			},
+		},
+		obj:                  &obj,
+		fsState:              testEpochState(10),
+		localNodeInContainer: true,
+		localNodeSigner:      neofscryptotest.Signer(),
+		localStorage:         &local,
+		transport:            transport,
+		ecRule:               &rule,
+		ecSigner:             signer,
+	}
+	require.True(t, target.ecEnabled())
+	require.NoError(t, target.saveECParts())
+
+	require.Len(t, local.objs, 1)
+	require.Len(t, transport.objs, rule.TotalPartNum()-1)
+
+	parts := make([]*object.Object, rule.TotalPartNum())
+	for i, pub := range expHolders {
+		var part object.Object
+		if i == 1 {
+			part = local.objs[0]
+		} else {
+			var ok bool
+			part, ok = transport.objs[string(pub)]
+			require.True(t, ok, i)
+		}
+
+		info, ok, err := ec.GetPartInfo(part)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, obj.GetID(), info.Parent)
+		require.Equal(t, rule, info.Rule)
+		require.Equal(t, signer.UserID(), part.Owner())
+		require.EqualValues(t, 10, part.CreationEpoch())
+		require.NoError(t, part.VerifyID())
+		parts[info.Index] = &part
+	}
+
+	// failed node was replaced by the reserve one, but indices are kept
+	for i := range parts {
+		require.NotNil(t, parts[i], i)
+	}
+
+	res, err := ec.JoinParts(rule, parts)
+	require.NoError(t, err)
+	require.Equal(t, obj, *res)
+
+	t.Run("not enough nodes", func(t *testing.T) {
+		target.placementIterator.containerNodes = testContainerNodes{
+			objID:    obj.GetID(),
+			cnrNodes: cnrNodes[:1],
+		}
+		var e errIncompletePut
+		require.ErrorAs(t, target.saveECParts(), &e)
+	})
+
+	t.Run("reserve nodes exhausted", func(t *testing.T) {
+		target.placementIterator.containerNodes = testContainerNodes{
+			objID:    obj.GetID(),
+			cnrNodes: [][]netmap.NodeInfo{cnrNodes[0][:2]},
+		}
+		rule := ec.Rule{DataPartNum: 1, ParityPartNum: 1}
+		target.ecRule = &rule
+		transport.failKey = cnrNodes[0][0].PublicKey()
+		var e errIncompletePut
+		require.ErrorAs(t, target.saveECParts(), &e)
+		require.ErrorContains(t, e, "any node error")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		for _, tc := range []struct {
+			name string
+			f    func(*distributedTarget)
+		}{
+			{name: "no rule", f: func(t *distributedTarget) { t.ecRule = nil }},
+			{name: "local only", f: func(t *distributedTarget) { t.localOnly = true }},
+			{name: "outside container", f: func(t *distributedTarget) { t.localNodeInContainer = false }},
+			{name: "copies number", f: func(t *distributedTarget) { t.placementIterator.linearReplNum = 2 }},
+			{name: "tombstone", f: func(t *distributedTarget) {
+				var o object.Object
+				o.SetType(object.TypeTombstone)
+				t.obj = &o
+			}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				target := &distributedTarget{
+					obj:                  &obj,
+					localNodeInContainer: true,
+					ecRule:               &rule,
+				}
+				tc.f(target)
+				require.False(t, target.ecEnabled())
+			})
+		}
+	})
+}
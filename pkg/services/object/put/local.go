@@ -2,6 +2,7 @@ package putsvc
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -20,7 +21,7 @@ type ObjectStorage interface {
 	//
 	// Optional objBin parameter carries object encoded in a canonical NeoFS binary
 	// format.
-	Put(obj *object.Object, objBin []byte) error
+	Put(ctx context.Context, obj *object.Object, objBin []byte) error
 	// Delete must delete passed objects
 	// and return any appeared error.
 	Delete(tombstone oid.Address, tombExpiration uint64, toDelete []oid.ID) error
@@ -31,7 +32,7 @@ type ObjectStorage interface {
 	IsLocked(oid.Address) (bool, error)
 }
 
-func putObjectLocally(storage ObjectStorage, obj *object.Object, meta objectCore.ContentMeta, enc *encodedObject) error {
+func putObjectLocally(ctx context.Context, storage ObjectStorage, obj *object.Object, meta objectCore.ContentMeta, enc *encodedObject) error {
 	switch obj.Type() {
 	case object.TypeTombstone:
 		exp, err := objectCore.Expiration(*obj)
@@ -57,7 +58,7 @@ func putObjectLocally(storage ObjectStorage, obj *object.Object, meta objectCore
 		objBin = enc.b[enc.hdrOff:]
 	}
 
-	if err := storage.Put(obj, objBin); err != nil {
+	if err := storage.Put(ctx, obj, objBin); err != nil {
 		return fmt.Errorf("could not put object to local storage: %w", err)
 	}
 
@@ -67,7 +68,7 @@ func putObjectLocally(storage ObjectStorage, obj *object.Object, meta objectCore
 // ValidateAndStoreObjectLocally checks format of given object and, if it's
 // correct, stores it in the underlying local object storage. Serves operation
 // similar to local-only [Service.Put] one.
-func (p *Service) ValidateAndStoreObjectLocally(obj object.Object) error {
+func (p *Service) ValidateAndStoreObjectLocally(ctx context.Context, obj object.Object) error {
 	cnrID := obj.GetContainerID()
 	if cnrID.IsZero() {
 		return errors.New("missing container ID")
@@ -143,5 +144,5 @@ func (p *Service) ValidateAndStoreObjectLocally(obj object.Object) error {
 		}
 	}
 
-	return putObjectLocally(p.localStore, &obj, objMeta, nil)
+	return putObjectLocally(ctx, p.localStore, &obj, objMeta, nil)
 }
@@ -1,11 +1,13 @@
 package putsvc
 
 import (
+	"github.com/nspcc-dev/neofs-node/internal/ec"
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
 
 type PutInitPrm struct {
@@ -23,6 +25,11 @@ type PutInitPrm struct {
 	localNodeInContainer bool
 	localSignerRFC6979   neofscrypto.Signer
 	localNodeSigner      neofscrypto.Signer
+
+	ecRule   *ec.Rule
+	ecSigner user.Signer
+
+	quotas []quotaCheck
 }
 
 type PutChunkPrm struct {
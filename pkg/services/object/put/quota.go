@@ -0,0 +1,136 @@
+package putsvc
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/internal/quota"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.uber.org/zap"
+)
+
+// QuotaUsage provides used storage space to check quotas.
+type QuotaUsage interface {
+	// ContainerUsage returns number of bytes used by the container in the
+	// network.
+	ContainerUsage(cid.ID) (uint64, error)
+	// OwnerQuota returns quota of the user along with the number of bytes
+	// used by all containers of the user in the network. Quota of the user is
+	// the strictest one set in the attributes of all user containers.
+	OwnerQuota(user.ID) (quota.Limits, uint64, error)
+}
+
+// QuotaMetrics registers quota check results.
+type QuotaMetrics interface {
+	// IncSoftQuotaExceeded increments number of objects exceeding soft quota
+	// of the given type (container or owner).
+	IncSoftQuotaExceeded(quotaType string)
+}
+
+// Types of quotas used in metrics and logs.
+const (
+	quotaTypeContainer = "container"
+	quotaTypeOwner     = "owner"
+)
+
+// quotaCheck is a quota with the space used at the start of the PUT.
+type quotaCheck struct {
+	typ     string
+	limits  quota.Limits
+	used    uint64
+	subject zap.Field
+}
+
+// quotaChecks returns quotas of the container and its owner along with the
+// current space usage. Container quota is set in its attributes, owner quota
+// is provided by [QuotaUsage] for all containers of the owner. Returns nil if
+// there are no quotas to check.
+func (p *Streamer) quotaChecks(idCnr cid.ID, cnr container.Container) ([]quotaCheck, error) {
+	if p.quotaUsage == nil {
+		return nil, nil
+	}
+
+	cnrLimits, _, err := quota.FromContainer(cnr)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []quotaCheck
+
+	if !cnrLimits.IsZero() {
+		used, err := p.quotaUsage.ContainerUsage(idCnr)
+		if err != nil {
+			return nil, fmt.Errorf("get container space usage: %w", err)
+		}
+
+		res = append(res, quotaCheck{typ: quotaTypeContainer, limits: cnrLimits, used: used, subject: zap.Stringer("container", idCnr)})
+	}
+
+	owner := cnr.Owner()
+
+	ownerLimits, used, err := p.quotaUsage.OwnerQuota(owner)
+	if err != nil {
+		return nil, fmt.Errorf("get owner quota: %w", err)
+	}
+
+	if !ownerLimits.IsZero() {
+		res = append(res, quotaCheck{typ: quotaTypeOwner, limits: ownerLimits, used: used, subject: zap.Stringer("owner", owner)})
+	}
+
+	return res, nil
+}
+
+// quotaTarget checks the streamed object against quotas. Object exceeding
+// hard limit is rejected with [svcutil.QuotaExceeded] error as soon as its
+// payload exceeds it, soft limit violation is logged and counted on close.
+// Declared payload size is checked in advance if set.
+type quotaTarget struct {
+	internal.Target
+
+	log     *zap.Logger
+	metrics QuotaMetrics
+	checks  []quotaCheck
+	written uint64
+}
+
+func (t *quotaTarget) WriteHeader(hdr *object.Object) error {
+	if err := t.checkHard(hdr.PayloadSize()); err != nil {
+		return err
+	}
+	return t.Target.WriteHeader(hdr)
+}
+
+func (t *quotaTarget) Write(chunk []byte) (int, error) {
+	t.written += uint64(len(chunk))
+	if err := t.checkHard(t.written); err != nil {
+		return 0, err
+	}
+	return t.Target.Write(chunk)
+}
+
+func (t *quotaTarget) Close() (oid.ID, error) {
+	for _, c := range t.checks {
+		if c.limits.Check(c.used, t.written) == quota.SoftExceeded {
+			t.log.Warn("soft storage quota exceeded", zap.String("type", c.typ), c.subject,
+				zap.Uint64("used", c.used), zap.Uint64("size", t.written), zap.Uint64("limit", c.limits.Soft))
+			if t.metrics != nil {
+				t.metrics.IncSoftQuotaExceeded(c.typ)
+			}
+		}
+	}
+	return t.Target.Close()
+}
+
+func (t *quotaTarget) checkHard(size uint64) error {
+	for _, c := range t.checks {
+		if c.limits.Check(c.used, size) == quota.HardExceeded {
+			return svcutil.NewQuotaExceeded(fmt.Sprintf("%s hard quota exceeded: used %d, limit %d", c.typ, c.used, c.limits.Hard))
+		}
+	}
+	return nil
+}
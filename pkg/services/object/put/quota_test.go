@@ -0,0 +1,169 @@
+package putsvc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/internal/quota"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testQuotaUsage struct {
+	containers  map[cid.ID]uint64
+	owners      map[user.ID]uint64
+	ownerLimits quota.Limits
+	err         error
+}
+
+func (x testQuotaUsage) ContainerUsage(id cid.ID) (uint64, error) {
+	return x.containers[id], x.err
+}
+
+func (x testQuotaUsage) OwnerQuota(id user.ID) (quota.Limits, uint64, error) {
+	return x.ownerLimits, x.owners[id], x.err
+}
+
+type testQuotaMetrics map[string]int
+
+func (x testQuotaMetrics) IncSoftQuotaExceeded(typ string) {
+	x[typ]++
+}
+
+type testTarget struct {
+	hdr     *object.Object
+	payload []byte
+	closed  bool
+}
+
+func (x *testTarget) WriteHeader(hdr *object.Object) error {
+	x.hdr = hdr
+	return nil
+}
+
+func (x *testTarget) Write(p []byte) (int, error) {
+	x.payload = append(x.payload, p...)
+	return len(p), nil
+}
+
+func (x *testTarget) Close() (oid.ID, error) {
+	x.closed = true
+	return oid.ID{}, nil
+}
+
+func TestStreamer_QuotaChecks(t *testing.T) {
+	idCnr := cidtest.ID()
+	owner := usertest.ID()
+
+	var cnr container.Container
+	cnr.SetOwner(owner)
+	cnr.SetAttribute(quota.AttributeContainerSoft, "100")
+	cnr.SetAttribute(quota.AttributeContainerHard, "200")
+
+	newStreamer := func(usage QuotaUsage, metrics QuotaMetrics) *Streamer {
+		return &Streamer{cfg: &cfg{
+			log:          zap.NewNop(),
+			quotaUsage:   usage,
+			quotaMetrics: metrics,
+		}}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		checks, err := newStreamer(nil, nil).quotaChecks(idCnr, cnr)
+		require.NoError(t, err)
+		require.Empty(t, checks)
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		var cnr container.Container
+		// owner quota is taken from all owner containers, not the current one
+		cnr.SetAttribute(quota.AttributeOwnerHard, "2000")
+		checks, err := newStreamer(testQuotaUsage{}, nil).quotaChecks(idCnr, cnr)
+		require.NoError(t, err)
+		require.Empty(t, checks)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		var cnr container.Container
+		cnr.SetAttribute(quota.AttributeContainerHard, "not a number")
+		_, err := newStreamer(testQuotaUsage{}, nil).quotaChecks(idCnr, cnr)
+		require.Error(t, err)
+	})
+
+	t.Run("usage failure", func(t *testing.T) {
+		s := newStreamer(testQuotaUsage{err: errors.New("any error")}, nil)
+		_, err := s.quotaChecks(idCnr, cnr)
+		require.ErrorContains(t, err, "any error")
+	})
+
+	for _, tc := range []struct {
+		name       string
+		cnrUsed    uint64
+		ownerUsed  uint64
+		size       uint64
+		hard       bool
+		expMetrics testQuotaMetrics
+	}{
+		{name: "within limits", cnrUsed: 50, ownerUsed: 500, size: 50, expMetrics: testQuotaMetrics{}},
+		{name: "container soft", cnrUsed: 50, ownerUsed: 500, size: 51, expMetrics: testQuotaMetrics{"container": 1}},
+		{name: "owner soft", cnrUsed: 50, ownerUsed: 990, size: 11, expMetrics: testQuotaMetrics{"owner": 1}},
+		{name: "both soft", cnrUsed: 150, ownerUsed: 1500, size: 1, expMetrics: testQuotaMetrics{"container": 1, "owner": 1}},
+		{name: "container hard", cnrUsed: 150, ownerUsed: 500, size: 51, hard: true, expMetrics: testQuotaMetrics{}},
+		{name: "owner hard", cnrUsed: 50, ownerUsed: 1990, size: 11, hard: true, expMetrics: testQuotaMetrics{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, declared := range []bool{false, true} {
+				metrics := make(testQuotaMetrics)
+				s := newStreamer(testQuotaUsage{
+					containers:  map[cid.ID]uint64{idCnr: tc.cnrUsed},
+					owners:      map[user.ID]uint64{owner: tc.ownerUsed},
+					ownerLimits: quota.Limits{Soft: 1000, Hard: 2000},
+				}, metrics)
+
+				checks, err := s.quotaChecks(idCnr, cnr)
+				require.NoError(t, err)
+
+				next := new(testTarget)
+				target := &quotaTarget{Target: next, log: s.log, metrics: s.quotaMetrics, checks: checks}
+
+				var hdr object.Object
+				if declared {
+					hdr.SetPayloadSize(tc.size)
+				}
+
+				err = target.WriteHeader(&hdr)
+				if err == nil {
+					// payload is streamed in two chunks
+					if _, err = target.Write(make([]byte, tc.size/2)); err == nil {
+						if _, err = target.Write(make([]byte, tc.size-tc.size/2)); err == nil {
+							_, err = target.Close()
+						}
+					}
+				}
+
+				if !tc.hard {
+					require.NoError(t, err)
+					require.True(t, next.closed)
+				} else {
+					require.ErrorAs(t, err, new(svcutil.QuotaExceeded))
+					st := svcutil.ToStatus(fmt.Errorf("wrapped: %w", err))
+					require.EqualValues(t, svcutil.StatusQuotaExceeded, st.Code)
+					require.False(t, next.closed)
+					if declared {
+						require.Nil(t, next.hdr)
+					}
+				}
+				require.Equal(t, tc.expMetrics, metrics)
+			}
+		})
+	}
+}
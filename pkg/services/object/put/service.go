@@ -109,6 +109,9 @@ type cfg struct {
 	cnrClient *chaincontainer.Client
 
 	metaSvc *meta.Meta
+
+	quotaUsage   QuotaUsage
+	quotaMetrics QuotaMetrics
 }
 
 func defaultCfg() *cfg {
@@ -223,3 +226,18 @@ func WithNetworkMagic(m uint32) Option {
 		c.networkMagic = m
 	}
 }
+
+// WithQuotaUsage returns option to check storage quotas set in container
+// attributes against given space usage. Quotas are not checked by default.
+func WithQuotaUsage(v QuotaUsage) Option {
+	return func(c *cfg) {
+		c.quotaUsage = v
+	}
+}
+
+// WithQuotaMetrics returns option to register quota check results.
+func WithQuotaMetrics(v QuotaMetrics) Option {
+	return func(c *cfg) {
+		c.quotaMetrics = v
+	}
+}
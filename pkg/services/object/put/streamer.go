@@ -5,19 +5,25 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/nspcc-dev/neofs-node/internal/ec"
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Streamer struct {
 	*cfg
 
 	ctx context.Context
+	// span of the whole put operation, started by Init and finished by Close
+	// or the first failure.
+	span trace.Span
 
 	target internal.Target
 
@@ -34,17 +40,38 @@ var errNotInit = errors.New("stream not initialized")
 var errInitRecall = errors.New("init recall")
 
 func (p *Streamer) Init(prm *PutInitPrm) error {
+	if p.span == nil {
+		p.ctx, p.span = tracing.StartChild(p.ctx, "putsvc.Put")
+	}
+
 	// initialize destination target
 	if err := p.initTarget(prm); err != nil {
-		return fmt.Errorf("(%T) could not initialize object target: %w", p, err)
+		return p.endSpan(fmt.Errorf("(%T) could not initialize object target: %w", p, err))
+	}
+
+	if len(prm.quotas) > 0 {
+		p.target = &quotaTarget{
+			Target:  p.target,
+			log:     p.log,
+			metrics: p.quotaMetrics,
+			checks:  prm.quotas,
+		}
 	}
 
 	if err := p.target.WriteHeader(prm.hdr); err != nil {
-		return fmt.Errorf("(%T) could not write header to target: %w", p, err)
+		return p.endSpan(fmt.Errorf("(%T) could not write header to target: %w", p, err))
 	}
 	return nil
 }
 
+// endSpan finishes the operation span with err and returns err.
+func (p *Streamer) endSpan(err error) error {
+	if p.span != nil {
+		tracing.End(p.span, err)
+	}
+	return err
+}
+
 // MaxObjectSize returns maximum payload size for the streaming session.
 //
 // Must be called after the successful Init.
@@ -70,6 +97,10 @@ func (p *Streamer) initTarget(prm *PutInitPrm) error {
 
 	homomorphicChecksumRequired := !prm.cnr.IsHomomorphicHashingDisabled()
 
+	if ec.IsPart(*prm.hdr) {
+		return errECPartPut
+	}
+
 	if prm.hdr.Signature() != nil {
 		p.relay = prm.relay
 
@@ -162,6 +193,11 @@ func (p *Streamer) preparePrm(prm *PutInitPrm) error {
 		return fmt.Errorf("(%T) could not get container by ID: %w", p, err)
 	}
 
+	prm.quotas, err = p.quotaChecks(idCnr, prm.cnr)
+	if err != nil {
+		return fmt.Errorf("check storage quotas: %w", err)
+	}
+
 	prm.containerNodes, err = p.neoFSNet.GetContainerNodes(idCnr)
 	if err != nil {
 		return fmt.Errorf("select storage nodes for the container: %w", err)
@@ -183,6 +219,15 @@ nextSet:
 	prm.localNodeSigner = (*neofsecdsa.Signer)(localNodeKey)
 	prm.localSignerRFC6979 = (*neofsecdsa.SignerRFC6979)(localNodeKey)
 
+	ecRule, ok, err := ec.RuleFromContainer(prm.cnr)
+	if err != nil {
+		return err
+	}
+	if ok {
+		prm.ecRule = &ecRule
+		prm.ecSigner = user.NewAutoIDSigner(*localNodeKey)
+	}
+
 	return nil
 }
 
@@ -231,6 +276,9 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) internal.Target {
 		metainfoConsistencyAttr: metaAttribute(prm.cnr),
 		metaSigner:              prm.localSignerRFC6979,
 		localOnly:               localOnly,
+		ecRule:                  prm.ecRule,
+		ecSigner:                prm.ecSigner,
+		ecHomomorphic:           !prm.cnr.IsHomomorphicHashingDisabled(),
 	}
 }
 
@@ -240,7 +288,7 @@ func (p *Streamer) SendChunk(prm *PutChunkPrm) error {
 	}
 
 	if _, err := p.target.Write(prm.chunk); err != nil {
-		return fmt.Errorf("(%T) could not write payload chunk to target: %w", p, err)
+		return p.endSpan(fmt.Errorf("(%T) could not write payload chunk to target: %w", p, err))
 	}
 
 	return nil
@@ -252,7 +300,7 @@ func (p *Streamer) Close() (*PutResponse, error) {
 	}
 
 	id, err := p.target.Close()
-	if err != nil {
+	if err = p.endSpan(err); err != nil {
 		return nil, fmt.Errorf("(%T) could not close object target: %w", p, err)
 	}
 
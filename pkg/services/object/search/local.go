@@ -1,11 +1,13 @@
 package searchsvc
 
 import (
+	"context"
+
 	"go.uber.org/zap"
 )
 
-func (exec *execCtx) executeLocal() {
-	ids, err := exec.svc.localStorage.search(exec)
+func (exec *execCtx) executeLocal(ctx context.Context) {
+	ids, err := exec.svc.localStorage.search(ctx, exec)
 
 	if err != nil {
 		exec.status = statusUndefined
@@ -6,7 +6,9 @@ import (
 	"math/big"
 
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -32,8 +34,12 @@ func (s *Service) Search(ctx context.Context, prm Prm) error {
 
 	exec.setLogger(s.log)
 
+	ctx, span := tracing.StartChild(ctx, "searchsvc.Search", attribute.Stringer("container", exec.containerID()))
+
 	exec.execute(ctx)
 
+	tracing.End(span, exec.statusError.err)
+
 	return exec.statusError.err
 }
 
@@ -41,7 +47,7 @@ func (exec *execCtx) execute(ctx context.Context) {
 	exec.log.Debug("serving request...")
 
 	// perform local operation
-	exec.executeLocal()
+	exec.executeLocal(ctx)
 
 	exec.analyzeStatus(ctx, true)
 }
@@ -106,7 +106,7 @@ func (c *testClientCache) get(info clientcore.NodeInfo) (searchClient, error) {
 	return v, nil
 }
 
-func (ts *testStorage) search(exec *execCtx) ([]oid.ID, error) {
+func (ts *testStorage) search(_ context.Context, exec *execCtx) ([]oid.ID, error) {
 	v, ok := ts.items[exec.containerID().EncodeToString()]
 	if !ok {
 		return nil, nil
@@ -48,7 +48,7 @@ type cfg struct {
 	log *zap.Logger
 
 	localStorage interface {
-		search(*execCtx) ([]oid.ID, error)
+		search(context.Context, *execCtx) ([]oid.ID, error)
 	}
 
 	clientConstructor interface {
@@ -108,8 +108,8 @@ func (c *clientWrapper) searchObjects(ctx context.Context, exec *execCtx, info c
 	return res.IDList(), nil
 }
 
-func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
-	r, err := e.storage.Select(exec.containerID(), exec.searchFilters())
+func (e *storageEngineWrapper) search(ctx context.Context, exec *execCtx) ([]oid.ID, error) {
+	r, err := e.storage.Select(ctx, exec.containerID(), exec.searchFilters())
 	if err != nil {
 		return nil, err
 	}
@@ -30,6 +30,7 @@ import (
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	objutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	sdkclient "github.com/nspcc-dev/neofs-sdk-go/client"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -134,11 +135,11 @@ type Storage interface {
 	// VerifyAndStoreObjectLocally checks whether given object has correct format
 	// and, if so, saves it in the Storage. StoreObject is called only when local
 	// node complies with the container's storage policy.
-	VerifyAndStoreObjectLocally(object.Object) error
+	VerifyAndStoreObjectLocally(context.Context, object.Object) error
 
 	// SearchObjects selects up to count container's objects from the given
 	// container matching the specified filters.
-	SearchObjects(_ cid.ID, _ []objectcore.SearchFilter, attrs []string, cursor *objectcore.SearchCursor, count uint16) ([]sdkclient.SearchResultItem, []byte, error)
+	SearchObjects(_ context.Context, _ cid.ID, _ []objectcore.SearchFilter, attrs []string, cursor *objectcore.SearchCursor, count uint16) ([]sdkclient.SearchResultItem, []byte, error)
 }
 
 // ACLInfoExtractor is the interface that allows to fetch data required for ACL
@@ -315,6 +316,7 @@ func (x *putStream) resignRequest(req *protoobject.PutRequest) (*protoobject.Put
 		Ttl:    meta.GetTtl() - 1,
 		Origin: meta,
 	}
+	tracing.InjectMetaHeader(x.ctx, req.MetaHeader)
 	var err error
 	req.VerifyHeader, err = neofscrypto.SignRequestWithBuffer(neofsecdsa.Signer(x.signer), req, nil)
 	if err != nil {
@@ -412,19 +414,25 @@ func (x *putStream) close() (*protoobject.PutResponse, error) {
 
 func (s *Server) Put(gStream protoobject.ObjectService_PutServer) error {
 	t := time.Now()
-	stream, err := s.handlers.Put(gStream.Context())
-
-	defer func() { s.pushOpExecResult(stat.MethodObjectPut, err, t) }()
+	// trace context is carried by the meta header, so the span is started
+	// after the first message
+	req, recvErr := gStream.Recv()
+	ctx, span := tracing.StartServer(gStream.Context(), "ObjectService.Put", req.GetMetaHeader())
+	stream, err := s.handlers.Put(ctx)
+
+	defer func() {
+		tracing.End(span, err)
+		s.pushOpExecResult(stat.MethodObjectPut, err, t)
+	}()
 	if err != nil {
 		return err
 	}
 
-	var req *protoobject.PutRequest
 	var resp *protoobject.PutResponse
 
-	ps := newIntermediatePutStream(s.signer, stream, gStream.Context())
-	for {
-		if req, err = gStream.Recv(); err != nil {
+	ps := newIntermediatePutStream(s.signer, stream, ctx)
+	for err = recvErr; ; req, err = gStream.Recv() {
+		if err != nil {
 			if errors.Is(err, io.EOF) {
 				resp, err = ps.close()
 				if err != nil {
@@ -500,6 +508,8 @@ func (s *Server) Delete(ctx context.Context, req *protoobject.DeleteRequest) (*p
 		t   = time.Now()
 	)
 	defer func() { s.pushOpExecResult(stat.MethodObjectDelete, err, t) }()
+	ctx, span := tracing.StartServer(ctx, "ObjectService.Delete", req.GetMetaHeader())
+	defer func() { tracing.End(span, err) }()
 
 	if err = icrypto.VerifyRequestSignaturesN3(req, s.fsChain); err != nil {
 		return s.makeStatusDeleteResponse(err), nil
@@ -581,6 +591,8 @@ func (s *Server) Head(ctx context.Context, req *protoobject.HeadRequest) (*proto
 		t   = time.Now()
 	)
 	defer func() { s.pushOpExecResult(stat.MethodObjectHead, err, t) }()
+	ctx, span := tracing.StartServer(ctx, "ObjectService.Head", req.GetMetaHeader())
+	defer func() { tracing.End(span, err) }()
 
 	needSignResp := needSignGetResponse(req)
 
@@ -685,6 +697,7 @@ func convertHeadPrm(signer ecdsa.PrivateKey, req *protoobject.HeadRequest, resp
 				Ttl:    meta.GetTtl() - 1,
 				Origin: meta,
 			}
+			tracing.InjectMetaHeader(ctx, req.MetaHeader)
 			req.VerifyHeader, err = neofscrypto.SignRequestWithBuffer(neofsecdsa.Signer(signer), req, nil)
 		})
 		if err != nil {
@@ -777,6 +790,8 @@ func (s *Server) GetRangeHash(ctx context.Context, req *protoobject.GetRangeHash
 		t   = time.Now()
 	)
 	defer func() { s.pushOpExecResult(stat.MethodObjectHash, err, t) }()
+	ctx, span := tracing.StartServer(ctx, "ObjectService.GetRangeHash", req.GetMetaHeader())
+	defer func() { tracing.End(span, err) }()
 	if err = icrypto.VerifyRequestSignaturesN3(req, s.fsChain); err != nil {
 		return s.makeStatusHashResponse(err), nil
 	}
@@ -886,6 +901,7 @@ func convertHashPrm(signer ecdsa.PrivateKey, ss sessions, req *protoobject.GetRa
 				Ttl:     meta.GetTtl() - 1,
 				Origin:  meta,
 			}
+			tracing.InjectMetaHeader(ctx, req.MetaHeader)
 			req.VerifyHeader, err = neofscrypto.SignRequestWithBuffer(neofsecdsa.Signer(signer), req, nil)
 		})
 		if err != nil {
@@ -994,6 +1010,8 @@ func (s *Server) Get(req *protoobject.GetRequest, gStream protoobject.ObjectServ
 		t   = time.Now()
 	)
 	defer func() { s.pushOpExecResult(stat.MethodObjectGet, err, t) }()
+	ctx, span := tracing.StartServer(gStream.Context(), "ObjectService.Get", req.GetMetaHeader())
+	defer func() { tracing.End(span, err) }()
 
 	needSignResp := needSignGetResponse(req)
 
@@ -1028,7 +1046,7 @@ func (s *Server) Get(req *protoobject.GetRequest, gStream protoobject.ObjectServ
 	if err != nil {
 		return s.sendStatusGetResponse(gStream, err, needSignResp)
 	}
-	err = s.handlers.Get(gStream.Context(), p)
+	err = s.handlers.Get(ctx, p)
 	if err != nil {
 		return s.sendStatusGetResponse(gStream, err, needSignResp)
 	}
@@ -1084,6 +1102,7 @@ func convertGetPrm(signer ecdsa.PrivateKey, req *protoobject.GetRequest, stream
 				Ttl:    meta.GetTtl() - 1,
 				Origin: meta,
 			}
+			tracing.InjectMetaHeader(ctx, req.MetaHeader)
 			req.VerifyHeader, err = neofscrypto.SignRequestWithBuffer(neofsecdsa.Signer(signer), req, nil)
 		})
 		if err != nil {
@@ -1273,6 +1292,8 @@ func (s *Server) GetRange(req *protoobject.GetRangeRequest, gStream protoobject.
 		t   = time.Now()
 	)
 	defer func() { s.pushOpExecResult(stat.MethodObjectRange, err, t) }()
+	ctx, span := tracing.StartServer(gStream.Context(), "ObjectService.GetRange", req.GetMetaHeader())
+	defer func() { tracing.End(span, err) }()
 	if err = icrypto.VerifyRequestSignaturesN3(req, s.fsChain); err != nil {
 		return s.sendStatusRangeResponse(gStream, err)
 	}
@@ -1303,7 +1324,7 @@ func (s *Server) GetRange(req *protoobject.GetRangeRequest, gStream protoobject.
 	if err != nil {
 		return s.sendStatusRangeResponse(gStream, err)
 	}
-	err = s.handlers.GetRange(gStream.Context(), p)
+	err = s.handlers.GetRange(ctx, p)
 	if err != nil {
 		return s.sendStatusRangeResponse(gStream, err)
 	}
@@ -1366,6 +1387,7 @@ func convertRangePrm(signer ecdsa.PrivateKey, req *protoobject.GetRangeRequest,
 				Ttl:    meta.GetTtl() - 1,
 				Origin: meta,
 			}
+			tracing.InjectMetaHeader(ctx, req.MetaHeader)
 			req.VerifyHeader, err = neofscrypto.SignRequestWithBuffer(neofsecdsa.Signer(signer), req, nil)
 		})
 		if err != nil {
@@ -1493,6 +1515,8 @@ func (s *Server) Search(req *protoobject.SearchRequest, gStream protoobject.Obje
 		t   = time.Now()
 	)
 	defer func() { s.pushOpExecResult(stat.MethodObjectSearch, err, t) }()
+	ctx, span := tracing.StartServer(gStream.Context(), "ObjectService.Search", req.GetMetaHeader())
+	defer func() { tracing.End(span, err) }()
 	if err = icrypto.VerifyRequestSignaturesN3(req, s.fsChain); err != nil {
 		return s.sendStatusSearchResponse(gStream, err)
 	}
@@ -1515,7 +1539,7 @@ func (s *Server) Search(req *protoobject.SearchRequest, gStream protoobject.Obje
 		return s.sendStatusSearchResponse(gStream, err)
 	}
 
-	p, err := convertSearchPrm(gStream.Context(), s.signer, req, &searchStream{
+	p, err := convertSearchPrm(ctx, s.signer, req, &searchStream{
 		base:    gStream,
 		srv:     s,
 		reqInfo: reqInfo,
@@ -1523,7 +1547,7 @@ func (s *Server) Search(req *protoobject.SearchRequest, gStream protoobject.Obje
 	if err != nil {
 		return s.sendStatusSearchResponse(gStream, err)
 	}
-	err = s.handlers.Search(gStream.Context(), p)
+	err = s.handlers.Search(ctx, p)
 	if err != nil {
 		return s.sendStatusSearchResponse(gStream, err)
 	}
@@ -1579,6 +1603,7 @@ func convertSearchPrm(ctx context.Context, signer ecdsa.PrivateKey, req *protoob
 				Ttl:    meta.GetTtl() - 1,
 				Origin: meta,
 			}
+			tracing.InjectMetaHeader(ctx, req.MetaHeader)
 			req.VerifyHeader, err = neofscrypto.SignRequestWithBuffer(neofsecdsa.Signer(signer), req, nil)
 		})
 		if err != nil {
@@ -1634,7 +1659,10 @@ func searchOnRemoteNode(ctx context.Context, conn *grpc.ClientConn, nodePub []by
 }
 
 // Replicate serves neo.fs.v2.object.ObjectService/Replicate RPC.
-func (s *Server) Replicate(_ context.Context, req *protoobject.ReplicateRequest) (*protoobject.ReplicateResponse, error) {
+func (s *Server) Replicate(ctx context.Context, req *protoobject.ReplicateRequest) (*protoobject.ReplicateResponse, error) {
+	ctx, span := tracing.StartServer(ctx, "ObjectService.Replicate", nil)
+	defer span.End()
+
 	if req.Object == nil {
 		return &protoobject.ReplicateResponse{Status: &protostatus.Status{
 			Code: codeInternal, Message: "binary object field is missing/empty",
@@ -1781,7 +1809,7 @@ func (s *Server) Replicate(_ context.Context, req *protoobject.ReplicateRequest)
 		}}, nil
 	}
 
-	err = s.storage.VerifyAndStoreObjectLocally(*obj)
+	err = s.storage.VerifyAndStoreObjectLocally(ctx, *obj)
 	if err != nil {
 		return &protoobject.ReplicateResponse{Status: &protostatus.Status{
 			Code:    codeInternal,
@@ -1820,6 +1848,8 @@ func (s *Server) SearchV2(ctx context.Context, req *protoobject.SearchV2Request)
 		t   = time.Now()
 	)
 	defer s.pushOpExecResult(stat.MethodObjectSearchV2, err, t)
+	ctx, span := tracing.StartServer(ctx, "ObjectService.SearchV2", req.GetMetaHeader())
+	defer func() { tracing.End(span, err) }()
 	if err = icrypto.VerifyRequestSignaturesN3(req, s.fsChain); err != nil {
 		return s.makeStatusSearchResponse(err), nil
 	}
@@ -1975,7 +2005,7 @@ func (s *Server) ProcessSearch(ctx context.Context, req *protoobject.SearchV2Req
 	count := uint16(body.Count) // legit according to the limit
 	switch {
 	case ttl == 1:
-		if res, newCursor, err = s.storage.SearchObjects(cID, ofs, attrs, cursor, count); err != nil {
+		if res, newCursor, err = s.storage.SearchObjects(ctx, cID, ofs, attrs, cursor, count); err != nil {
 			return nil, nil, err
 		}
 	case handleWithMetaService:
@@ -2007,7 +2037,7 @@ func (s *Server) ProcessSearch(ctx context.Context, req *protoobject.SearchV2Req
 				wg.Add(1)
 				go func() {
 					defer wg.Done()
-					if set, crsr, err := s.storage.SearchObjects(cID, ofs, attrs, cursor, count); err == nil {
+					if set, crsr, err := s.storage.SearchObjects(ctx, cID, ofs, attrs, cursor, count); err == nil {
 						add(set, crsr != nil)
 					} // TODO: else log error
 				}()
@@ -2015,6 +2045,7 @@ func (s *Server) ProcessSearch(ctx context.Context, req *protoobject.SearchV2Req
 			}
 			if !signed {
 				req.MetaHeader = &protosession.RequestMetaHeader{Ttl: 1, Origin: req.MetaHeader}
+				tracing.InjectMetaHeader(ctx, req.MetaHeader)
 				if req.VerifyHeader, err = neofscrypto.SignRequestWithBuffer[*protoobject.SearchV2Request_Body](neofsecdsa.Signer(s.signer), req, nil); err != nil {
 					resErr = fmt.Errorf("sign request: %w", err)
 					return false
@@ -104,10 +104,10 @@ func (c *noCallTestFSChain) InvokeContainedScript(*transaction.Transaction, *blo
 
 type noCallTestStorage struct{}
 
-func (noCallTestStorage) SearchObjects(cid.ID, []objectcore.SearchFilter, []string, *objectcore.SearchCursor, uint16) ([]client.SearchResultItem, []byte, error) {
+func (noCallTestStorage) SearchObjects(context.Context, cid.ID, []objectcore.SearchFilter, []string, *objectcore.SearchCursor, uint16) ([]client.SearchResultItem, []byte, error) {
 	panic("must not be called")
 }
-func (noCallTestStorage) VerifyAndStoreObjectLocally(object.Object) error {
+func (noCallTestStorage) VerifyAndStoreObjectLocally(context.Context, object.Object) error {
 	panic("must not be called")
 }
 func (noCallTestStorage) GetSessionPrivateKey(user.ID, uuid.UUID) (ecdsa.PrivateKey, error) {
@@ -267,7 +267,7 @@ func newTestStorage(t testing.TB, obj *protoobject.Object) *testStorage {
 	return &testStorage{t: t, obj: obj}
 }
 
-func (x *testStorage) VerifyAndStoreObjectLocally(obj object.Object) error {
+func (x *testStorage) VerifyAndStoreObjectLocally(_ context.Context, obj object.Object) error {
 	require.Equal(x.t, x.obj, obj.ProtoMessage())
 	return x.storeErr
 }
@@ -620,11 +620,11 @@ func (nopFSChain) LocalNodeUnderMaintenance() bool { return false }
 
 type nopStorage struct{}
 
-func (nopStorage) VerifyAndStoreObjectLocally(object.Object) error { return nil }
+func (nopStorage) VerifyAndStoreObjectLocally(context.Context, object.Object) error { return nil }
 func (nopStorage) GetSessionPrivateKey(user.ID, uuid.UUID) (ecdsa.PrivateKey, error) {
 	return ecdsa.PrivateKey{}, apistatus.ErrSessionTokenNotFound
 }
-func (nopStorage) SearchObjects(cid.ID, []objectcore.SearchFilter, []string, *objectcore.SearchCursor, uint16) ([]client.SearchResultItem, []byte, error) {
+func (nopStorage) SearchObjects(context.Context, cid.ID, []objectcore.SearchFilter, []string, *objectcore.SearchCursor, uint16) ([]client.SearchResultItem, []byte, error) {
 	return nil, nil, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/nspcc-dev/neofs-node/internal/ec"
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
@@ -80,6 +81,8 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 	idCnr := addr.Container()
 	idObj := addr.Object()
 
+	p.metrics.IncPolicerCheckedObjects()
+
 	cnr, err := p.cnrSrc.Get(idCnr)
 	if err != nil {
 		p.log.Error("could not get container",
@@ -101,6 +104,23 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 
 	policy := cnr.PlacementPolicy()
 
+	if addrWithType.Type == object.TypeRegular && p.ecParts != nil {
+		rule, ok, err := ec.RuleFromContainer(cnr)
+		if err != nil {
+			p.log.Error("invalid erasure coding rule in container",
+				zap.Stringer("cid", idCnr),
+				zap.Error(err),
+			)
+
+			return
+		}
+
+		if ok {
+			p.processECObject(ctx, rule, policy, addr)
+			return
+		}
+	}
+
 	nn, err := p.placementBuilder.BuildPlacement(idCnr, &idObj, policy)
 	if err != nil {
 		p.log.Error("could not build placement vector for object",
@@ -171,7 +191,7 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 			)
 		}
 
-		p.cbRedundantCopy(addr)
+		p.removeRedundantCopy(addr)
 	}
 }
 
@@ -288,6 +308,7 @@ func (p *Policer) processNodes(ctx context.Context, plc *processPlacementContext
 	}
 
 	if shortage > 0 {
+		p.metrics.IncPolicerShortage()
 		p.log.Debug("shortage of object copies detected",
 			zap.Stringer("object", plc.object.Address),
 			zap.Uint32("shortage", shortage),
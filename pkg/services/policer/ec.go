@@ -0,0 +1,339 @@
+package policer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/internal/ec"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ECPartSource provides access to erasure-coded parts of objects stored on the
+// container nodes.
+type ECPartSource interface {
+	// SearchECParts returns IDs of erasure-coded parts of the referenced object
+	// stored on the given container node. If idx is non-negative, only parts
+	// with this index are searched.
+	SearchECParts(ctx context.Context, node netmap.NodeInfo, parent oid.Address, idx int) ([]oid.ID, error)
+	// GetObjectFromNode reads the referenced object from the given container
+	// node.
+	GetObjectFromNode(ctx context.Context, node netmap.NodeInfo, addr oid.Address) (*object.Object, error)
+}
+
+// processECObject checks erasure-coded placement of the local object from the
+// container with the given rule. Each part i is expected to be stored on the
+// i-th node from [ec.PartNodes] for the original object:
+//   - full local copy is encoded into parts which are saved in the container,
+//     then the copy is removed;
+//   - part stored not on its designated node is moved there;
+//   - designated holder of part i restores part i+1 (cyclically) if it is
+//     missing, so all parts are checked by some node.
+//
+// Placement is checked by the object header, payload is read only when the
+// object needs to be encoded or moved.
+func (p *Policer) processECObject(ctx context.Context, rule ec.Rule, policy netmap.PlacementPolicy, addr oid.Address) {
+	l := p.log.With(zap.Stringer("object", addr), zap.Stringer("rule", rule))
+
+	hdr, err := p.jobQueue.localStorage.Head(ctx, addr, true)
+	if err != nil {
+		l.Error("could not get local object header to check erasure-coded placement", zap.Error(err))
+		return
+	}
+
+	info, isPart, err := ec.GetPartInfo(*hdr)
+	if err != nil {
+		l.Error("invalid erasure-coded part object", zap.Error(err))
+		return
+	}
+
+	parent := addr
+	if isPart {
+		if info.Rule != rule {
+			l.Error("erasure coding rule of the part differs from the container one",
+				zap.Stringer("part rule", info.Rule))
+			return
+		}
+		parent.SetObject(info.Parent)
+	}
+
+	parentID := parent.Object()
+	nn, err := p.placementBuilder.BuildPlacement(parent.Container(), &parentID, policy)
+	if err != nil {
+		l.Error("could not build placement vector for object", zap.Error(err))
+		return
+	}
+
+	nodes := ec.PartNodes(nn)
+	if len(nodes) < rule.TotalPartNum() {
+		l.Error("not enough container nodes to store erasure-coded parts",
+			zap.Int("nodes", len(nodes)), zap.Int("parts", rule.TotalPartNum()))
+		return
+	}
+
+	if !isPart {
+		p.processECFullCopy(ctx, l, rule, nodes, addr)
+		return
+	}
+
+	if p.netmapKeys.IsLocalKey(nodes[info.Index].PublicKey()) {
+		p.checkNextECPart(ctx, l, addr, info, nodes, parent)
+		return
+	}
+
+	has, err := p.hasECPart(ctx, nodes[info.Index], parent, info.Index)
+	if err != nil {
+		l.Info("could not check erasure-coded part on the designated node", zap.Int("part", info.Index), zap.Error(err))
+		return
+	}
+
+	if !has {
+		part, err := p.jobQueue.localStorage.Get(ctx, addr)
+		if err != nil {
+			l.Error("could not get local erasure-coded part to move it", zap.Error(err))
+			return
+		}
+		if !p.sendECPart(ctx, part, nodes[info.Index]) {
+			return
+		}
+	}
+
+	l.Info("erasure-coded part is stored on its designated node, removing local copy...", zap.Int("part", info.Index))
+	p.removeRedundantCopy(addr)
+}
+
+// processECFullCopy saves missing erasure-coded parts of the local full object
+// copy. The copy is removed when all parts are stored. Nodes outside the
+// container cannot save parts, they hold the copy until parts appear.
+func (p *Policer) processECFullCopy(ctx context.Context, l *zap.Logger, rule ec.Rule, nodes []netmap.NodeInfo, addr oid.Address) {
+	var localNodeInContainer bool
+	for i := range nodes {
+		if localNodeInContainer = p.netmapKeys.IsLocalKey(nodes[i].PublicKey()); localNodeInContainer {
+			break
+		}
+	}
+
+	var obj *object.Object
+	var encoded [][]byte
+	var stored = true
+	for i := range rule.TotalPartNum() {
+		has, err := p.hasECPart(ctx, nodes[i], addr, i)
+		if err != nil {
+			l.Info("could not check erasure-coded part on the designated node", zap.Int("part", i), zap.Error(err))
+			stored = false
+			continue
+		}
+		if has {
+			continue
+		}
+
+		if !localNodeInContainer || p.signer == nil {
+			stored = false
+			continue
+		}
+
+		if encoded == nil {
+			if obj, err = p.jobQueue.localStorage.Get(ctx, addr); err != nil {
+				l.Error("could not get local object to encode it", zap.Error(err))
+				return
+			}
+			if encoded, err = ec.Encode(rule, obj.Payload()); err != nil {
+				l.Error("could not encode object payload", zap.Error(err))
+				return
+			}
+		}
+
+		part, err := p.formECPart(*obj, rule, i, encoded[i])
+		if err != nil {
+			l.Error("could not form erasure-coded part object", zap.Int("part", i), zap.Error(err))
+			return
+		}
+
+		if !p.saveECPart(ctx, l, &part, nodes[i]) {
+			stored = false
+		}
+	}
+
+	if !stored {
+		l.Info("not all erasure-coded parts of the object are stored, holding the full copy...")
+		return
+	}
+
+	l.Info("all erasure-coded parts of the object are stored, removing the full copy...")
+	p.removeRedundantCopy(addr)
+}
+
+// checkNextECPart restores the part following the local one on its designated
+// node if it is missing.
+func (p *Policer) checkNextECPart(ctx context.Context, l *zap.Logger, addr oid.Address, info ec.PartInfo, nodes []netmap.NodeInfo, parent oid.Address) {
+	idx := (info.Index + 1) % info.Rule.TotalPartNum()
+
+	has, err := p.hasECPart(ctx, nodes[idx], parent, idx)
+	if err != nil {
+		l.Info("could not check erasure-coded part on the designated node", zap.Int("part", idx), zap.Error(err))
+		return
+	}
+	if has {
+		return
+	}
+
+	if p.signer == nil {
+		return
+	}
+
+	p.metrics.IncPolicerShortage()
+	l.Info("erasure-coded part is missing, restoring...", zap.Int("part", idx))
+
+	local, err := p.jobQueue.localStorage.Get(ctx, addr)
+	if err != nil {
+		l.Error("could not get local erasure-coded part", zap.Error(err))
+		return
+	}
+
+	hdr, _, err := ec.DecodePartPayload(*local)
+	if err != nil {
+		l.Error("invalid parent header in erasure-coded part", zap.Error(err))
+		return
+	}
+
+	parts := p.collectECParts(ctx, l, info.Rule, nodes, parent)
+	if parts == nil {
+		return
+	}
+
+	shards := make([][]byte, len(parts))
+	for i := range parts {
+		if parts[i] == nil {
+			continue
+		}
+		if _, shards[i], err = ec.DecodePartPayload(*parts[i]); err != nil {
+			l.Error("invalid erasure-coded part", zap.Int("part", i), zap.Error(err))
+			return
+		}
+	}
+
+	if err = ec.Reconstruct(info.Rule, shards); err != nil {
+		l.Error("could not restore erasure-coded part", zap.Int("part", idx), zap.Error(err))
+		return
+	}
+
+	part, err := p.formECPart(hdr, info.Rule, idx, shards[idx])
+	if err != nil {
+		l.Error("could not form erasure-coded part object", zap.Int("part", idx), zap.Error(err))
+		return
+	}
+
+	if p.saveECPart(ctx, l, &part, nodes[idx]) {
+		l.Info("erasure-coded part successfully restored", zap.Int("part", idx))
+	}
+}
+
+// collectECParts reads data parts of the referenced object from the container
+// nodes. Returns nil if there are not enough parts.
+func (p *Policer) collectECParts(ctx context.Context, l *zap.Logger, rule ec.Rule, nodes []netmap.NodeInfo, parent oid.Address) []*object.Object {
+	parts := make([]*object.Object, rule.TotalPartNum())
+	var found int
+
+	for i := range nodes {
+		ids, err := p.ecParts.SearchECParts(ctx, nodes[i], parent, -1)
+		if err != nil {
+			l.Debug("could not search for erasure-coded parts on the node",
+				zap.String("node", netmap.StringifyPublicKey(nodes[i])), zap.Error(err))
+			continue
+		}
+
+		for _, id := range ids {
+			var addr oid.Address
+			addr.SetContainer(parent.Container())
+			addr.SetObject(id)
+
+			part, err := p.ecParts.GetObjectFromNode(ctx, nodes[i], addr)
+			if err != nil {
+				l.Debug("could not get erasure-coded part from the node",
+					zap.String("node", netmap.StringifyPublicKey(nodes[i])), zap.Stringer("part", id), zap.Error(err))
+				continue
+			}
+
+			info, ok, err := ec.GetPartInfo(*part)
+			if err != nil || !ok || info.Parent != parent.Object() || info.Rule != rule || parts[info.Index] != nil {
+				continue
+			}
+
+			parts[info.Index] = part
+			if found++; found == rule.DataPartNum {
+				return parts
+			}
+		}
+	}
+
+	l.Info("not enough erasure-coded parts to restore missing one",
+		zap.Int("found", found), zap.Int("required", rule.DataPartNum))
+
+	return nil
+}
+
+func (p *Policer) hasECPart(ctx context.Context, node netmap.NodeInfo, parent oid.Address, idx int) (bool, error) {
+	if p.ecParts == nil {
+		return false, errors.New("erasure-coded parts source is not configured")
+	}
+
+	p.cfg.RLock()
+	headTimeout := p.headTimeout
+	p.cfg.RUnlock()
+
+	callCtx, cancel := context.WithTimeout(ctx, headTimeout)
+	defer cancel()
+
+	ids, err := p.ecParts.SearchECParts(callCtx, node, parent, idx)
+	if err != nil {
+		return false, err
+	}
+
+	return len(ids) > 0, nil
+}
+
+func (p *Policer) formECPart(parent object.Object, rule ec.Rule, idx int, part []byte) (object.Object, error) {
+	cnr, err := p.cnrSrc.Get(parent.GetContainerID())
+	if err != nil {
+		return object.Object{}, fmt.Errorf("get container: %w", err)
+	}
+
+	var epoch uint64
+	if p.netState != nil {
+		epoch = p.netState.CurrentEpoch()
+	}
+
+	return ec.FormObjectForPart(p.signer, parent, rule, idx, part, epoch, !cnr.IsHomomorphicHashingDisabled())
+}
+
+// saveECPart stores part object on the given node which can be local.
+func (p *Policer) saveECPart(ctx context.Context, l *zap.Logger, part *object.Object, node netmap.NodeInfo) bool {
+	if p.netmapKeys.IsLocalKey(node.PublicKey()) {
+		if err := p.jobQueue.localStorage.Put(ctx, part, nil); err != nil {
+			l.Error("could not save erasure-coded part locally", zap.Error(err))
+			return false
+		}
+		return true
+	}
+
+	return p.sendECPart(ctx, part, node)
+}
+
+// sendECPart replicates part object to the given remote node.
+func (p *Policer) sendECPart(ctx context.Context, part *object.Object, node netmap.NodeInfo) bool {
+	var task replicator.Task
+	task.SetObjectAddress(objectcore.AddressOf(part))
+	task.SetObject(part)
+	task.SetNodes([]netmap.NodeInfo{node})
+	task.SetCopiesNumber(1)
+
+	res := newNodeCache()
+	p.replicator.HandleTask(ctx, task, res)
+
+	return res.atLeastOneHolder()
+}
@@ -0,0 +1,322 @@
+package policer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/internal/ec"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testECNetwork emulates container nodes storing objects. It serves as local
+// storage of the node with localKey, erasure-coded parts source and
+// replicator.
+type testECNetwork struct {
+	mtx      sync.Mutex
+	localKey []byte
+	objs     map[string]map[oid.Address]*object.Object
+	removed  []oid.Address
+	sent     int
+	// gets counts local objects read with payload.
+	gets int
+}
+
+func newTestECNetwork(local netmap.NodeInfo) *testECNetwork {
+	return &testECNetwork{
+		localKey: local.PublicKey(),
+		objs:     make(map[string]map[oid.Address]*object.Object),
+	}
+}
+
+func (x *testECNetwork) store(node []byte, obj *object.Object) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	m := x.objs[string(node)]
+	if m == nil {
+		m = make(map[oid.Address]*object.Object)
+		x.objs[string(node)] = m
+	}
+	m[objectcore.AddressOf(obj)] = obj
+}
+
+func (x *testECNetwork) stored(node []byte) []*object.Object {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	res := make([]*object.Object, 0, len(x.objs[string(node)]))
+	for _, obj := range x.objs[string(node)] {
+		res = append(res, obj)
+	}
+	return res
+}
+
+func (x *testECNetwork) IsLocalKey(key []byte) bool { return bytes.Equal(key, x.localKey) }
+
+func (x *testECNetwork) ListWithCursor(uint32, *engine.Cursor) ([]objectcore.AddressWithType, *engine.Cursor, error) {
+	return nil, nil, engine.ErrEndOfListing
+}
+
+func (x *testECNetwork) Get(_ context.Context, addr oid.Address) (*object.Object, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	obj, ok := x.objs[string(x.localKey)][addr]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	x.gets++
+	return obj, nil
+}
+
+func (x *testECNetwork) Head(_ context.Context, addr oid.Address, _ bool) (*object.Object, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	obj, ok := x.objs[string(x.localKey)][addr]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return obj.CutPayload(), nil
+}
+
+func (x *testECNetwork) Put(_ context.Context, obj *object.Object, _ []byte) error {
+	x.store(x.localKey, obj)
+	return nil
+}
+
+func (x *testECNetwork) Delete(addr oid.Address) error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	delete(x.objs[string(x.localKey)], addr)
+	return nil
+}
+
+func (x *testECNetwork) CorruptedObjects() []oid.Address { return nil }
+
+func (x *testECNetwork) ForgetCorruptedObject(oid.Address) {}
+
+func (x *testECNetwork) SearchECParts(_ context.Context, node netmap.NodeInfo, parent oid.Address, idx int) ([]oid.ID, error) {
+	var res []oid.ID
+	for _, obj := range x.stored(node.PublicKey()) {
+		info, ok, err := ec.GetPartInfo(*obj)
+		if err != nil || !ok || info.Parent != parent.Object() || idx >= 0 && info.Index != idx {
+			continue
+		}
+		res = append(res, obj.GetID())
+	}
+	return res, nil
+}
+
+func (x *testECNetwork) GetObjectFromNode(_ context.Context, node netmap.NodeInfo, addr oid.Address) (*object.Object, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	obj, ok := x.objs[string(node.PublicKey())][addr]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return obj, nil
+}
+
+func (x *testECNetwork) HandleTask(_ context.Context, task replicator.Task, res replicator.TaskResult) {
+	x.mtx.Lock()
+	x.sent++
+	x.mtx.Unlock()
+
+	for _, node := range task.Nodes() {
+		x.store(node.PublicKey(), task.Object())
+		res.SubmitSuccessfulReplication(node)
+	}
+}
+
+func (x *testECNetwork) removeRedundantCopy(addr oid.Address) {
+	x.mtx.Lock()
+	x.removed = append(x.removed, addr)
+	x.mtx.Unlock()
+}
+
+type testPlacement []netmap.NodeInfo
+
+func (x testPlacement) BuildPlacement(cid.ID, *oid.ID, netmap.PlacementPolicy) ([][]netmap.NodeInfo, error) {
+	return [][]netmap.NodeInfo{x}, nil
+}
+
+type testContainers struct{}
+
+func (testContainers) Get(cid.ID) (container.Container, error) { return container.Container{}, nil }
+
+func newTestECPolicer(nodes []netmap.NodeInfo, network *testECNetwork) *Policer {
+	p := New(
+		WithLogger(zap.NewNop()),
+		WithHeadTimeout(time.Minute),
+		WithContainerSource(testContainers{}),
+		WithPlacementBuilder(testPlacement(nodes)),
+		WithNetmapKeys(network),
+		WithECPartSource(network),
+		WithRedundantCopyCallback(network.removeRedundantCopy),
+		WithSigner(usertest.User()),
+	)
+	p.jobQueue.localStorage = network
+	p.replicator = network
+	return p
+}
+
+func testECObject(t *testing.T) *object.Object {
+	var obj object.Object
+	obj.SetContainerID(cidtest.ID())
+	obj.SetOwner(usertest.ID())
+	obj.SetPayload([]byte("Lorem ipsum dolor sit amet, consectetur adipiscing elit"))
+	obj.SetPayloadSize(uint64(len(obj.Payload())))
+	obj.CalculateAndSetPayloadChecksum()
+	require.NoError(t, obj.SetIDWithSignature(neofscryptotest.Signer()))
+	return &obj
+}
+
+func testECParts(t *testing.T, rule ec.Rule, obj *object.Object) []*object.Object {
+	encoded, err := ec.Encode(rule, obj.Payload())
+	require.NoError(t, err)
+
+	signer := usertest.User()
+	res := make([]*object.Object, len(encoded))
+	for i := range encoded {
+		part, err := ec.FormObjectForPart(signer, *obj, rule, i, encoded[i], 1, false)
+		require.NoError(t, err)
+		res[i] = &part
+	}
+	return res
+}
+
+// requireECPart checks that the node stores idx-th part of the object with
+// the expected payload.
+func requireECPart(t *testing.T, network *testECNetwork, node netmap.NodeInfo, parent oid.Address, idx int, expected []byte) {
+	for _, obj := range network.stored(node.PublicKey()) {
+		info, ok, err := ec.GetPartInfo(*obj)
+		if err != nil || !ok || info.Parent != parent.Object() || info.Index != idx {
+			continue
+		}
+		_, payload, err := ec.DecodePartPayload(*obj)
+		require.NoError(t, err)
+		require.Equal(t, expected, payload)
+		return
+	}
+	t.Fatalf("part #%d is not stored on the node", idx)
+}
+
+func TestPolicer_processECObject(t *testing.T) {
+	ctx := context.Background()
+	rule := ec.Rule{DataPartNum: 2, ParityPartNum: 1}
+	nodes := []netmap.NodeInfo{netmaptest.NodeInfo(), netmaptest.NodeInfo(), netmaptest.NodeInfo(), netmaptest.NodeInfo()}
+	for i := range nodes {
+		nodes[i].SetPublicKey(neofscryptotest.Signer().PublicKeyBytes)
+	}
+
+	obj := testECObject(t)
+	addr := objectcore.AddressOf(obj)
+	parts := testECParts(t, rule, obj)
+
+	encoded, err := ec.Encode(rule, obj.Payload())
+	require.NoError(t, err)
+
+	t.Run("full copy", func(t *testing.T) {
+		network := newTestECNetwork(nodes[0])
+		network.store(nodes[0].PublicKey(), obj)
+
+		newTestECPolicer(nodes, network).processECObject(ctx, rule, netmap.PlacementPolicy{}, addr)
+
+		for i := range rule.TotalPartNum() {
+			requireECPart(t, network, nodes[i], addr, i, encoded[i])
+		}
+		require.Equal(t, []oid.Address{addr}, network.removed)
+
+		t.Run("parts stored", func(t *testing.T) {
+			network := newTestECNetwork(nodes[0])
+			network.store(nodes[0].PublicKey(), obj)
+			for i := range parts {
+				network.store(nodes[i].PublicKey(), parts[i])
+			}
+
+			newTestECPolicer(nodes, network).processECObject(ctx, rule, netmap.PlacementPolicy{}, addr)
+
+			require.Zero(t, network.sent)
+			require.Zero(t, network.gets)
+			require.Equal(t, []oid.Address{addr}, network.removed)
+		})
+
+		t.Run("outside container", func(t *testing.T) {
+			network := newTestECNetwork(netmaptest.NodeInfo())
+			network.store(network.localKey, obj)
+
+			newTestECPolicer(nodes, network).processECObject(ctx, rule, netmap.PlacementPolicy{}, addr)
+
+			require.Zero(t, network.sent)
+			require.Empty(t, network.removed)
+		})
+	})
+
+	t.Run("misplaced part", func(t *testing.T) {
+		partAddr := objectcore.AddressOf(parts[1])
+
+		network := newTestECNetwork(nodes[3])
+		network.store(nodes[3].PublicKey(), parts[1])
+
+		newTestECPolicer(nodes, network).processECObject(ctx, rule, netmap.PlacementPolicy{}, partAddr)
+
+		requireECPart(t, network, nodes[1], addr, 1, encoded[1])
+		require.Equal(t, 1, network.sent)
+		require.Equal(t, []oid.Address{partAddr}, network.removed)
+	})
+
+	t.Run("redundant part", func(t *testing.T) {
+		partAddr := objectcore.AddressOf(parts[1])
+
+		network := newTestECNetwork(nodes[3])
+		network.store(nodes[3].PublicKey(), parts[1])
+		network.store(nodes[1].PublicKey(), parts[1])
+
+		newTestECPolicer(nodes, network).processECObject(ctx, rule, netmap.PlacementPolicy{}, partAddr)
+
+		require.Zero(t, network.sent)
+		require.Zero(t, network.gets)
+		require.Equal(t, []oid.Address{partAddr}, network.removed)
+	})
+
+	t.Run("missing part", func(t *testing.T) {
+		network := newTestECNetwork(nodes[0])
+		network.store(nodes[0].PublicKey(), parts[0])
+		network.store(nodes[2].PublicKey(), parts[2])
+
+		newTestECPolicer(nodes, network).processECObject(ctx, rule, netmap.PlacementPolicy{}, objectcore.AddressOf(parts[0]))
+
+		requireECPart(t, network, nodes[1], addr, 1, encoded[1])
+		require.Empty(t, network.removed)
+
+		t.Run("not enough parts", func(t *testing.T) {
+			network := newTestECNetwork(nodes[0])
+			network.store(nodes[0].PublicKey(), parts[0])
+
+			newTestECPolicer(nodes, network).processECObject(ctx, rule, netmap.PlacementPolicy{}, objectcore.AddressOf(parts[0]))
+
+			require.Empty(t, network.stored(nodes[1].PublicKey()))
+			require.Zero(t, network.sent)
+		})
+	})
+}
@@ -0,0 +1,37 @@
+package policer
+
+import "time"
+
+// MetricRegister tracks Policer's work.
+type MetricRegister interface {
+	// IncPolicerCheckedObjects increments number of objects checked for the
+	// storage policy compliance.
+	IncPolicerCheckedObjects()
+	// IncPolicerShortage increments number of detected shortages of object
+	// copies or erasure-coded parts.
+	IncPolicerShortage()
+	// IncPolicerRedundantCopies increments number of redundant local object
+	// copies passed for removal.
+	IncPolicerRedundantCopies()
+	// SetPolicerQueueLength sets number of listed objects waiting to be
+	// submitted for the check.
+	SetPolicerQueueLength(int)
+	// SetPolicerObjectsInWork sets number of objects being checked.
+	SetPolicerObjectsInWork(int)
+	// SetPolicerPassObjects sets number of objects submitted for the check
+	// during the current pass over the local storage.
+	SetPolicerPassObjects(uint64)
+	// ObservePolicerPassDuration registers duration of the complete pass over
+	// the local storage.
+	ObservePolicerPassDuration(time.Duration)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) IncPolicerCheckedObjects()                {}
+func (noopMetrics) IncPolicerShortage()                      {}
+func (noopMetrics) IncPolicerRedundantCopies()               {}
+func (noopMetrics) SetPolicerQueueLength(int)                {}
+func (noopMetrics) SetPolicerObjectsInWork(int)              {}
+func (noopMetrics) SetPolicerPassObjects(uint64)             {}
+func (noopMetrics) ObservePolicerPassDuration(time.Duration) {}
@@ -1,6 +1,7 @@
 package policer
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
 )
@@ -46,6 +48,19 @@ func (oiw *objectsInWork) add(addr oid.Address) {
 	oiw.m.Unlock()
 }
 
+func (oiw *objectsInWork) len() int {
+	oiw.m.RLock()
+	defer oiw.m.RUnlock()
+
+	return len(oiw.objs)
+}
+
+// taskHandler handles replication tasks. It is implemented by
+// [replicator.Replicator].
+type taskHandler interface {
+	HandleTask(ctx context.Context, task replicator.Task, res replicator.TaskResult)
+}
+
 // Policer represents the utility that verifies
 // compliance with the object storage policy.
 type Policer struct {
@@ -89,7 +104,7 @@ type cfg struct {
 
 	netmapKeys netmap.AnnouncedKeys
 
-	replicator *replicator.Replicator
+	replicator taskHandler
 
 	cbRedundantCopy RedundantCopyCallback
 
@@ -100,6 +115,16 @@ type cfg struct {
 	rebalanceFreq time.Duration
 
 	network Network
+
+	ecParts ECPartSource
+
+	replicas ReplicaSource
+
+	signer user.Signer
+
+	netState netmap.State
+
+	metrics MetricRegister
 }
 
 func defaultCfg() *cfg {
@@ -108,6 +133,7 @@ func defaultCfg() *cfg {
 		batchSize:     10,
 		rebalanceFreq: 1 * time.Second,
 		repCooldown:   1 * time.Second,
+		metrics:       noopMetrics{},
 	}
 }
 
@@ -240,3 +266,49 @@ func WithObjectBatchSize(s uint32) Option {
 		c.batchSize = s
 	}
 }
+
+// WithECPartSource returns option to set source of erasure-coded object parts
+// stored on the container nodes. Without it, objects from containers with
+// erasure coding rule are not checked.
+func WithECPartSource(v ECPartSource) Option {
+	return func(c *cfg) {
+		c.ecParts = v
+	}
+}
+
+// WithReplicaSource returns option to set source of object replicas stored
+// on the container nodes. Without it, corrupted local objects are not
+// restored.
+func WithReplicaSource(v ReplicaSource) Option {
+	return func(c *cfg) {
+		c.replicas = v
+	}
+}
+
+// WithSigner returns option to set signer of the erasure-coded part objects
+// formed by Policer. Without it, missing parts are not restored.
+func WithSigner(v user.Signer) Option {
+	return func(c *cfg) {
+		c.signer = v
+	}
+}
+
+// WithNetworkState returns option to set source of the current NeoFS epoch.
+func WithNetworkState(v netmap.State) Option {
+	return func(c *cfg) {
+		c.netState = v
+	}
+}
+
+// WithMetrics returns option to set metrics register of Policer.
+func WithMetrics(m MetricRegister) Option {
+	return func(c *cfg) {
+		c.metrics = m
+	}
+}
+
+// removeRedundantCopy passes redundant local object copy to the callback.
+func (p *Policer) removeRedundantCopy(addr oid.Address) {
+	p.metrics.IncPolicerRedundantCopies()
+	p.cbRedundantCopy(addr)
+}
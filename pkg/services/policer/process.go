@@ -7,6 +7,7 @@ import (
 
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
 	"go.uber.org/zap"
 )
 
@@ -16,7 +17,8 @@ func (p *Policer) Run(ctx context.Context) {
 	}()
 
 	go p.poolCapacityWorker(ctx)
-	p.shardPolicyWorker(ctx)
+	// local storage operations of the policer are background ones
+	p.shardPolicyWorker(iosched.WithClass(ctx, iosched.Replication))
 }
 
 func (p *Policer) shardPolicyWorker(ctx context.Context) {
@@ -26,9 +28,11 @@ func (p *Policer) shardPolicyWorker(ctx context.Context) {
 	p.cfg.RUnlock()
 
 	var (
-		addrs  []objectcore.AddressWithType
-		cursor *engine.Cursor
-		err    error
+		addrs      []objectcore.AddressWithType
+		cursor     *engine.Cursor
+		err        error
+		passStart  = time.Now()
+		passObjNum uint64
 	)
 
 	t := time.NewTimer(repCooldown)
@@ -43,7 +47,14 @@ func (p *Policer) shardPolicyWorker(ctx context.Context) {
 		addrs, cursor, err = p.jobQueue.Select(cursor, batchSize)
 		if err != nil {
 			if errors.Is(err, engine.ErrEndOfListing) {
+				p.metrics.ObservePolicerPassDuration(time.Since(passStart))
+				passObjNum = 0
+				p.metrics.SetPolicerPassObjects(passObjNum)
+
+				p.restoreCorruptedObjects(ctx)
+
 				time.Sleep(time.Second) // finished whole cycle, sleep a bit
+				passStart = time.Now()
 				continue
 			}
 			p.log.Warn("failure at object select for replication", zap.Error(err))
@@ -55,6 +66,7 @@ func (p *Policer) shardPolicyWorker(ctx context.Context) {
 				return
 			default:
 				addr := addrs[i]
+				p.metrics.SetPolicerQueueLength(len(addrs) - i)
 				if p.objsInWork.inWork(addr.Address) {
 					// do not process an object
 					// that is in work
@@ -63,16 +75,23 @@ func (p *Policer) shardPolicyWorker(ctx context.Context) {
 
 				err = p.taskPool.Submit(func() {
 					p.objsInWork.add(addr.Address)
+					p.metrics.SetPolicerObjectsInWork(p.objsInWork.len())
 
 					p.processObject(ctx, addr)
 
 					p.objsInWork.remove(addr.Address)
+					p.metrics.SetPolicerObjectsInWork(p.objsInWork.len())
 				})
 				if err != nil {
 					p.log.Warn("pool submission", zap.Error(err))
+					continue
 				}
+
+				passObjNum++
+				p.metrics.SetPolicerPassObjects(passObjNum)
 			}
 		}
+		p.metrics.SetPolicerQueueLength(0)
 
 		select {
 		case <-ctx.Done():
@@ -1,14 +1,29 @@
 package policer
 
 import (
+	"context"
 	"fmt"
 
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
+// localStorage is the local object storage processed by Policer. It is
+// implemented by [engine.StorageEngine].
+type localStorage interface {
+	ListWithCursor(count uint32, cursor *engine.Cursor) ([]objectcore.AddressWithType, *engine.Cursor, error)
+	Get(ctx context.Context, addr oid.Address) (*object.Object, error)
+	Head(ctx context.Context, addr oid.Address, raw bool) (*object.Object, error)
+	Put(ctx context.Context, obj *object.Object, objBin []byte) error
+	Delete(addr oid.Address) error
+	CorruptedObjects() []oid.Address
+	ForgetCorruptedObject(addr oid.Address)
+}
+
 type jobQueue struct {
-	localStorage *engine.StorageEngine
+	localStorage localStorage
 }
 
 func (q *jobQueue) Select(cursor *engine.Cursor, count uint32) ([]objectcore.AddressWithType, *engine.Cursor, error) {
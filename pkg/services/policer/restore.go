@@ -0,0 +1,135 @@
+package policer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/internal/ec"
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ReplicaSource provides access to object replicas stored on the container
+// nodes.
+type ReplicaSource interface {
+	// GetObjectFromNode reads the referenced object from the given container
+	// node.
+	GetObjectFromNode(ctx context.Context, node netmap.NodeInfo, addr oid.Address) (*object.Object, error)
+}
+
+// restoreCorruptedObjects replaces corrupted objects removed from the local
+// storage with the healthy replicas stored on the other container nodes.
+func (p *Policer) restoreCorruptedObjects(ctx context.Context) {
+	if p.replicas == nil {
+		return
+	}
+
+	for _, addr := range p.jobQueue.localStorage.CorruptedObjects() {
+		if ctx.Err() != nil {
+			return
+		}
+		if p.objsInWork.inWork(addr) {
+			continue
+		}
+
+		err := p.taskPool.Submit(func() {
+			p.objsInWork.add(addr)
+			p.restoreObject(ctx, addr)
+			p.objsInWork.remove(addr)
+		})
+		if err != nil {
+			p.log.Warn("pool submission", zap.Error(err))
+			return
+		}
+	}
+}
+
+// restoreObject fetches the corrupted object from the other container nodes
+// and saves it to the local storage. Objects not needed on the local node
+// anymore are not restored.
+func (p *Policer) restoreObject(ctx context.Context, addr oid.Address) {
+	l := p.log.With(zap.Stringer("object", addr))
+
+	cnr, err := p.cnrSrc.Get(addr.Container())
+	if err != nil {
+		if container.IsErrNotFound(err) {
+			p.jobQueue.localStorage.ForgetCorruptedObject(addr)
+			return
+		}
+		l.Error("could not get container to restore corrupted object", zap.Error(err))
+		return
+	}
+
+	// each erasure-coded part is restored by the holder of the previous one
+	if _, ok, _ := ec.RuleFromContainer(cnr); ok {
+		p.jobQueue.localStorage.ForgetCorruptedObject(addr)
+		return
+	}
+
+	id := addr.Object()
+	nn, err := p.placementBuilder.BuildPlacement(addr.Container(), &id, cnr.PlacementPolicy())
+	if err != nil {
+		l.Error("could not build placement vector for object", zap.Error(err))
+		return
+	}
+
+	var (
+		local bool
+		nodes = make([]netmap.NodeInfo, 0, len(nn))
+	)
+	for i := range nn {
+		for j := range nn[i] {
+			if p.netmapKeys.IsLocalKey(nn[i][j].PublicKey()) {
+				local = true
+				continue
+			}
+			nodes = append(nodes, nn[i][j])
+		}
+	}
+
+	if !local {
+		l.Info("corrupted object is not stored on the local node anymore, skip restoring")
+		p.jobQueue.localStorage.ForgetCorruptedObject(addr)
+		return
+	}
+
+	for i := range nodes {
+		obj, err := p.replicas.GetObjectFromNode(ctx, nodes[i], addr)
+		if err == nil {
+			err = verifyReplica(addr, obj)
+		}
+		if err != nil {
+			l.Debug("could not get healthy object replica", zap.String("node", netmap.StringifyPublicKey(nodes[i])), zap.Error(err))
+			continue
+		}
+
+		err = p.jobQueue.localStorage.Put(ctx, obj, nil)
+		if err != nil {
+			l.Error("could not save restored object", zap.Error(err))
+			return
+		}
+
+		l.Info("corrupted object restored from the remote replica", zap.String("node", netmap.StringifyPublicKey(nodes[i])))
+		p.jobQueue.localStorage.ForgetCorruptedObject(addr)
+		return
+	}
+
+	l.Warn("could not restore corrupted object, no healthy replica available, will retry")
+}
+
+func verifyReplica(addr oid.Address, obj *object.Object) error {
+	if obj.GetContainerID() != addr.Container() || obj.GetID() != addr.Object() {
+		return errors.New("object address mismatch")
+	}
+	if err := obj.VerifyID(); err != nil {
+		return fmt.Errorf("verify ID: %w", err)
+	}
+	if err := obj.VerifyPayloadChecksum(); err != nil {
+		return fmt.Errorf("verify payload checksum: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package policer
+
+import (
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyReplica(t *testing.T) {
+	var obj object.Object
+	obj.SetContainerID(cidtest.ID())
+	obj.SetOwner(usertest.ID())
+	obj.SetPayload([]byte("payload"))
+	obj.CalculateAndSetPayloadChecksum()
+	require.NoError(t, obj.SetIDWithSignature(neofscryptotest.Signer()))
+
+	addr := objectcore.AddressOf(&obj)
+	require.NoError(t, verifyReplica(addr, &obj))
+
+	t.Run("other object", func(t *testing.T) {
+		other := addr
+		other.SetObject(oidtest.ID())
+		require.Error(t, verifyReplica(other, &obj))
+	})
+
+	t.Run("corrupted payload", func(t *testing.T) {
+		corrupted := obj
+		corrupted.SetPayload([]byte("corrupted"))
+		require.Error(t, verifyReplica(addr, &corrupted))
+	})
+
+	t.Run("corrupted header", func(t *testing.T) {
+		corrupted := obj
+		corrupted.SetOwner(usertest.ID())
+		require.Error(t, verifyReplica(addr, &corrupted))
+	})
+}
@@ -0,0 +1,21 @@
+package replicator
+
+// MetricRegister tracks Replicator's work. Nodes are identified by their
+// hex-encoded public keys.
+type MetricRegister interface {
+	// IncReplicationAttempts increments number of attempts to replicate an
+	// object to the node.
+	IncReplicationAttempts(node string)
+	// IncReplicationSuccesses increments number of objects successfully
+	// replicated to the node.
+	IncReplicationSuccesses(node string)
+	// IncReplicationFailures increments number of failed attempts to
+	// replicate an object to the node.
+	IncReplicationFailures(node string)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) IncReplicationAttempts(string)  {}
+func (noopMetrics) IncReplicationSuccesses(string) {}
+func (noopMetrics) IncReplicationFailures(string)  {}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"io"
 
+	"github.com/nspcc-dev/neofs-node/internal/ec"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/iosched"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
@@ -41,15 +43,22 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 	var err error
 	var prm *putsvc.RemotePutPrm
 	var stream io.ReadSeeker
-	binReplication := task.obj == nil
+	// erasure-coded parts are formed by the policer and have no local copy,
+	// but they are replicated in binary form like stored objects
+	binReplication := task.obj == nil || isECPart(task.obj)
 	if binReplication {
-		b, err := p.localStorage.GetBytes(task.addr)
-		if err != nil {
-			p.log.Error("could not get object from local storage",
-				zap.Stringer("object", task.addr),
-				zap.Error(err))
-
-			return
+		var b []byte
+		if task.obj != nil {
+			b = task.obj.Marshal()
+		} else {
+			b, err = p.localStorage.GetBytes(iosched.WithClass(ctx, iosched.Replication), task.addr)
+			if err != nil {
+				p.log.Error("could not get object from local storage",
+					zap.Stringer("object", task.addr),
+					zap.Error(err))
+
+				return
+			}
 		}
 		stream = bytes.NewReader(b)
 		if len(task.nodes) > 1 {
@@ -66,11 +75,14 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 		default:
 		}
 
+		node := netmap.StringifyPublicKey(task.nodes[i])
 		log := p.log.With(
-			zap.String("node", netmap.StringifyPublicKey(task.nodes[i])),
+			zap.String("node", node),
 			zap.Stringer("object", task.addr),
 		)
 
+		p.metrics.IncReplicationAttempts(node)
+
 		callCtx, cancel := context.WithTimeout(ctx, p.putTimeout)
 
 		if binReplication {
@@ -84,10 +96,12 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 		cancel()
 
 		if err != nil {
+			p.metrics.IncReplicationFailures(node)
 			log.Error("could not replicate object",
 				zap.Error(err),
 			)
 		} else {
+			p.metrics.IncReplicationSuccesses(node)
 			log.Debug("object successfully replicated")
 
 			task.quantity--
@@ -96,3 +110,8 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 		}
 	}
 }
+
+func isECPart(obj *object.Object) bool {
+	_, ok, err := ec.GetPartInfo(*obj)
+	return err == nil && ok
+}
@@ -25,10 +25,14 @@ type cfg struct {
 	remoteSender *putsvc.RemoteSender
 
 	localStorage *engine.StorageEngine
+
+	metrics MetricRegister
 }
 
 func defaultCfg() *cfg {
-	return &cfg{}
+	return &cfg{
+		metrics: noopMetrics{},
+	}
 }
 
 // New creates, initializes and returns Replicator instance.
@@ -73,3 +77,10 @@ func WithLocalStorage(v *engine.StorageEngine) Option {
 		c.localStorage = v
 	}
 }
+
+// WithMetrics returns option to set metrics register of Replicator.
+func WithMetrics(m MetricRegister) Option {
+	return func(c *cfg) {
+		c.metrics = m
+	}
+}
@@ -37,5 +37,8 @@ func ToStatus(err error) *protostatus.Status {
 	for e := errors.Unwrap(err); e != nil; e = errors.Unwrap(err) {
 		err = e
 	}
+	if st, ok := err.(statusError); ok {
+		return st.status()
+	}
 	return apistatus.FromError(err)
 }
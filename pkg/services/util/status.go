@@ -0,0 +1,38 @@
+package util
+
+import (
+	protostatus "github.com/nspcc-dev/neofs-sdk-go/proto/status"
+)
+
+// StatusQuotaExceeded is a code of the object section status returned for
+// requests rejected because of exceeded hard storage quota. The status is
+// specific to the node, clients unaware of it treat it as an unrecognized one.
+const StatusQuotaExceeded = 2054
+
+// statusError is an error with NeoFS API status unknown to SDK.
+type statusError interface {
+	error
+	status() *protostatus.Status
+}
+
+// QuotaExceeded describes failure status of the object operation exceeding
+// hard storage quota.
+type QuotaExceeded struct {
+	msg string
+}
+
+// NewQuotaExceeded constructs QuotaExceeded status with the given message.
+func NewQuotaExceeded(msg string) QuotaExceeded {
+	return QuotaExceeded{msg: msg}
+}
+
+func (x QuotaExceeded) Error() string {
+	return "status: code = QUOTA_EXCEEDED message = " + x.msg
+}
+
+func (x QuotaExceeded) status() *protostatus.Status {
+	return &protostatus.Status{
+		Code:    StatusQuotaExceeded,
+		Message: x.msg,
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Package tracing provides OpenTelemetry tracing of the storage node operations.
+
+Spans are started with [Start] and reported to the exporter configured by
+[Setup]. Without [Setup] spans are not recorded, but trace context is still
+passed through, so a node without tracing does not break traces of the other
+nodes.
+
+Trace context is transmitted between the nodes in the request X-headers
+[XHeaderTraceParent] and [XHeaderTraceState] in W3C Trace Context format.
+*/
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/nspcc-dev/neofs-node"
+
+// Config groups tracing parameters.
+type Config struct {
+	// OTLP gRPC endpoint spans are exported to. Required.
+	Endpoint string
+	// Disables TLS of the exporter connection.
+	Insecure bool
+	// Fraction of the root spans to sample, [0, 1]. Spans with sampled
+	// remote parent are always sampled.
+	SamplingRatio float64
+
+	// Service name, e.g. "neofs-node".
+	Service string
+	// Unique identifier of the service instance, e.g. public key.
+	InstanceID string
+	// Service version.
+	Version string
+}
+
+// Setup configures global tracer provider exporting spans to the OTLP
+// endpoint. Returned function flushes remaining spans and stops exporting,
+// it must be called on application shutdown.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("missing exporter endpoint")
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exp, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(cfg.Service),
+		semconv.ServiceInstanceID(cfg.InstanceID),
+		semconv.ServiceVersion(cfg.Version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create tracing resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplingRatio))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// Start starts new span as a child of the span from ctx if any. The span
+// must be finished with [End].
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartChild is similar to [Start], but starts span only if ctx already has
+// a valid one. It is intended for internal operations that are not worth
+// tracing on their own, e.g. storage engine calls made by background routines.
+func StartChild(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	return Start(ctx, name, attrs...)
+}
+
+// End finishes the span marking it as failed if err is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,105 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return rec
+}
+
+func TestStartChild(t *testing.T) {
+	rec := setupRecorder(t)
+
+	ctx, span := tracing.StartChild(context.Background(), "orphan")
+	require.False(t, trace.SpanContextFromContext(ctx).IsValid())
+	tracing.End(span, nil)
+	require.Empty(t, rec.Ended())
+
+	ctx, parent := tracing.Start(context.Background(), "parent")
+	_, child := tracing.StartChild(ctx, "child")
+	tracing.End(child, errors.New("any error"))
+	tracing.End(parent, nil)
+
+	spans := rec.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "child", spans[0].Name())
+	require.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Equal(t, "any error", spans[0].Status().Description)
+	require.Equal(t, "parent", spans[1].Name())
+	require.Equal(t, codes.Unset, spans[1].Status().Code)
+}
+
+func TestInjectXHeaders(t *testing.T) {
+	setupRecorder(t)
+
+	hs := []string{"key", "val", tracing.XHeaderTraceParent, "garbage"}
+
+	require.Equal(t, hs, tracing.InjectXHeaders(context.Background(), hs))
+
+	ctx, span := tracing.Start(context.Background(), "op")
+	defer span.End()
+
+	res := tracing.InjectXHeaders(ctx, hs)
+	require.Equal(t, []string{"key", "val", tracing.XHeaderTraceParent, "garbage"}, hs)
+	require.Len(t, res, 4)
+	require.Equal(t, []string{"key", "val", tracing.XHeaderTraceParent}, res[:3])
+	require.Contains(t, res[3], span.SpanContext().TraceID().String())
+	require.Contains(t, res[3], span.SpanContext().SpanID().String())
+}
+
+func TestStartServer(t *testing.T) {
+	rec := setupRecorder(t)
+
+	ctx, client := tracing.Start(context.Background(), "client")
+	origin := &protosession.RequestMetaHeader{
+		XHeaders: []*protosession.XHeader{
+			{Key: "key", Value: "val"},
+			{Key: tracing.XHeaderTraceParent, Value: "garbage"},
+		},
+	}
+	tracing.InjectMetaHeader(ctx, origin)
+	require.Len(t, origin.XHeaders, 2)
+	require.Equal(t, "key", origin.XHeaders[0].Key)
+	require.Equal(t, tracing.XHeaderTraceParent, origin.XHeaders[1].Key)
+	client.End()
+
+	// trace context of the original request is used by the forwarded one
+	meta := &protosession.RequestMetaHeader{Ttl: 1, Origin: origin}
+
+	_, server := tracing.StartServer(context.Background(), "server", meta)
+	tracing.End(server, nil)
+
+	spans := rec.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "server", spans[1].Name())
+	require.Equal(t, client.SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	require.Equal(t, client.SpanContext().SpanID(), spans[1].Parent().SpanID())
+	require.True(t, spans[1].Parent().IsRemote())
+
+	t.Run("no trace context", func(t *testing.T) {
+		_, span := tracing.StartServer(context.Background(), "server", meta.Origin.Origin)
+		tracing.End(span, nil)
+
+		spans := rec.Ended()
+		require.Len(t, spans, 3)
+		require.False(t, spans[2].Parent().IsValid())
+	})
+}
@@ -0,0 +1,113 @@
+package tracing
+
+import (
+	"context"
+
+	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// X-headers carrying trace context of the request.
+const (
+	// XHeaderTraceParent is the W3C traceparent header.
+	XHeaderTraceParent = "__NEOFS__TRACEPARENT"
+	// XHeaderTraceState is the W3C tracestate header.
+	XHeaderTraceState = "__NEOFS__TRACESTATE"
+)
+
+var propagator propagation.TraceContext
+
+// carrier maps W3C header names to the X-header ones.
+type carrier map[string]string
+
+func (c carrier) Get(key string) string { return c[xHeaderKey(key)] }
+
+func (c carrier) Set(key, value string) { c[xHeaderKey(key)] = value }
+
+func (c carrier) Keys() []string { return []string{XHeaderTraceParent, XHeaderTraceState} }
+
+func xHeaderKey(key string) string {
+	switch key {
+	case "traceparent":
+		return XHeaderTraceParent
+	case "tracestate":
+		return XHeaderTraceState
+	}
+	return key
+}
+
+func isTraceXHeader(key string) bool {
+	return key == XHeaderTraceParent || key == XHeaderTraceState
+}
+
+// StartServer starts span of the request handler. Trace context is read from
+// X-headers of the request meta header including all the origin ones, the
+// closest to the sender takes precedence.
+func StartServer(ctx context.Context, name string, meta *protosession.RequestMetaHeader, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	for ; meta != nil; meta = meta.GetOrigin() {
+		c := make(carrier)
+		for _, h := range meta.GetXHeaders() {
+			if isTraceXHeader(h.GetKey()) {
+				c[h.GetKey()] = h.GetValue()
+			}
+		}
+		if len(c) == 0 {
+			continue
+		}
+		if sc := trace.SpanContextFromContext(propagator.Extract(context.Background(), c)); sc.IsValid() {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, sc)
+			break
+		}
+	}
+
+	return Start(ctx, name, append(attrs, attribute.String("rpc.system", "grpc"))...)
+}
+
+// InjectXHeaders returns X-headers as key-value pairs with trace context from
+// ctx. Trace X-headers in hs are replaced, hs itself is not modified. Returns hs
+// if ctx has no span.
+func InjectXHeaders(ctx context.Context, hs []string) []string {
+	c := make(carrier)
+	propagator.Inject(ctx, c)
+	if len(c) == 0 {
+		return hs
+	}
+
+	res := make([]string, 0, len(hs)+2*len(c))
+	for i := 0; i+1 < len(hs); i += 2 {
+		if !isTraceXHeader(hs[i]) {
+			res = append(res, hs[i], hs[i+1])
+		}
+	}
+	for _, k := range c.Keys() {
+		if v, ok := c[k]; ok {
+			res = append(res, k, v)
+		}
+	}
+	return res
+}
+
+// InjectMetaHeader adds X-headers with trace context from ctx to the meta
+// header. Existing trace X-headers are replaced.
+func InjectMetaHeader(ctx context.Context, meta *protosession.RequestMetaHeader) {
+	c := make(carrier)
+	propagator.Inject(ctx, c)
+	if len(c) == 0 {
+		return
+	}
+
+	hs := make([]*protosession.XHeader, 0, len(meta.XHeaders)+len(c))
+	for _, h := range meta.XHeaders {
+		if !isTraceXHeader(h.GetKey()) {
+			hs = append(hs, h)
+		}
+	}
+	for _, k := range c.Keys() {
+		if v, ok := c[k]; ok {
+			hs = append(hs, &protosession.XHeader{Key: k, Value: v})
+		}
+	}
+	meta.XHeaders = hs
+}